@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/System-Pulse/server-pulse/config"
+)
+
+// loadConfig reads and applies the configuration file. An invalid file is
+// fatal so that a typo never silently falls back to the defaults.
+func loadConfig() (config.Config, string) {
+	path, err := config.Path()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid configuration in %s:\n%v\n", path, err)
+		os.Exit(2)
+	}
+	cfg.Apply()
+	return cfg, path
+}
+
+// runConfig implements `server-pulse config print`.
+func runConfig(cfg config.Config, path string, args []string) int {
+	if len(args) != 1 || args[0] != "print" {
+		fmt.Fprintln(os.Stderr, "usage: server-pulse config print")
+		return 2
+	}
+
+	content, err := cfg.YAML()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "encode config: %v\n", err)
+		return 1
+	}
+	source := "defaults, no file at " + path
+	if _, err := os.Stat(path); err == nil {
+		source = path
+	}
+	fmt.Printf("# effective configuration (%s)\n%s", source, content)
+	return 0
+}
@@ -0,0 +1,333 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/network"
+	"github.com/System-Pulse/server-pulse/system/performance"
+	resource "github.com/System-Pulse/server-pulse/system/resource"
+	"github.com/System-Pulse/server-pulse/system/schedule"
+	"github.com/System-Pulse/server-pulse/system/security"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the content of config.yaml. Fields left out of the file keep
+// their default value.
+type Config struct {
+	Interval           Duration            `yaml:"interval"`
+	CollectorIntervals map[string]Duration `yaml:"collector_intervals"`
+	ReportDirectory    string              `yaml:"report_directory"`
+	Disk               DiskConfig          `yaml:"disk"`
+	Health             HealthConfig        `yaml:"health"`
+	Ports              []PortRule          `yaml:"ports"`
+	SpeedTest          SpeedTestConfig     `yaml:"speedtest"`
+}
+
+type DiskConfig struct {
+	ExcludeFstypes     []string `yaml:"exclude_fstypes,flow"`
+	ExcludeMountpoints []string `yaml:"exclude_mountpoints"`
+	InodeThreshold     float64  `yaml:"inode_threshold"`
+}
+
+type HealthConfig struct {
+	IOWaitWarning   float64 `yaml:"iowait_warning"`
+	IOWaitCritical  float64 `yaml:"iowait_critical"`
+	StealWarning    float64 `yaml:"steal_warning"`
+	StealCritical   float64 `yaml:"steal_critical"`
+	MajorFaults     uint64  `yaml:"major_faults"`
+	ContextSwitches uint64  `yaml:"context_switches"`
+}
+
+// PortRule replaces the built-in port risk table when at least one rule is
+// configured.
+type PortRule struct {
+	Ports   []int  `yaml:"ports,flow"`
+	Risk    string `yaml:"risk"`
+	Message string `yaml:"message"`
+}
+
+type SpeedTestConfig struct {
+	DownloadURL string `yaml:"download_url"`
+	UploadURL   string `yaml:"upload_url"`
+	PingURL     string `yaml:"ping_url"`
+}
+
+// Duration is a time.Duration written as "2s" or "1m30s" in YAML.
+type Duration time.Duration
+
+func (d Duration) MarshalYAML() (any, error) {
+	return time.Duration(d).String(), nil
+}
+
+func (d *Duration) UnmarshalYAML(value *yaml.Node) error {
+	parsed, err := time.ParseDuration(value.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: invalid duration %q", value.Line, value.Value)
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// Default returns the configuration used when no file exists.
+func Default() Config {
+	homeDir, _ := os.UserHomeDir()
+
+	disks := resource.DefaultDiskFilter()
+	thresholds := performance.DefaultHealthThresholds()
+	servers := network.DefaultSpeedTestServers()
+
+	var ports []PortRule
+	for _, rule := range security.DefaultPortRules() {
+		ports = append(ports, PortRule{Ports: rule.Ports, Risk: rule.Risk.String(), Message: rule.Message})
+	}
+
+	return Config{
+		Interval:           Duration(schedule.DefaultInterval),
+		CollectorIntervals: map[string]Duration{},
+		ReportDirectory:    filepath.Join(homeDir, ".server-pulse", "reports"),
+		Disk: DiskConfig{
+			ExcludeFstypes:     disks.ExcludeFstypes,
+			ExcludeMountpoints: disks.ExcludeMountpoints,
+			InodeThreshold:     resource.DefaultInodeThreshold,
+		},
+		Health: HealthConfig{
+			IOWaitWarning:   thresholds.IOWaitWarning,
+			IOWaitCritical:  thresholds.IOWaitCritical,
+			StealWarning:    thresholds.StealWarning,
+			StealCritical:   thresholds.StealCritical,
+			MajorFaults:     thresholds.MajorFaults,
+			ContextSwitches: thresholds.ContextSwitches,
+		},
+		Ports: ports,
+		SpeedTest: SpeedTestConfig{
+			DownloadURL: servers.DownloadURL,
+			UploadURL:   servers.UploadURL,
+			PingURL:     servers.PingURL,
+		},
+	}
+}
+
+// Path returns the location of the configuration file. SERVER_PULSE_CONFIG
+// takes precedence over $XDG_CONFIG_HOME/server-pulse/config.yaml.
+func Path() (string, error) {
+	if path := os.Getenv("SERVER_PULSE_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locate config directory: %w", err)
+	}
+	return filepath.Join(dir, "server-pulse", "config.yaml"), nil
+}
+
+// Load reads the configuration at path. A missing file is not an error and
+// yields the defaults.
+func Load(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Default(), nil
+	}
+	if err != nil {
+		return Config{}, fmt.Errorf("read config: %w", err)
+	}
+
+	return Parse(data)
+}
+
+// Parse decodes YAML on top of the defaults and validates the result.
+// Unknown keys are rejected so that typos do not go unnoticed.
+func Parse(data []byte) (Config, error) {
+	cfg := Default()
+	// A configured port table replaces the defaults instead of being merged
+	// into them element by element.
+	cfg.Ports = nil
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&cfg); err != nil && err != io.EOF {
+		return Config{}, err
+	}
+	if cfg.Ports == nil {
+		cfg.Ports = Default().Ports
+	}
+	if cfg.CollectorIntervals == nil {
+		cfg.CollectorIntervals = map[string]Duration{}
+	}
+
+	cfg.ReportDirectory = expandHome(cfg.ReportDirectory)
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Validate reports every invalid setting at once.
+func (c Config) Validate() error {
+	var errs []error
+	invalid := func(field, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", field, fmt.Sprintf(format, args...)))
+	}
+
+	if c.Interval <= 0 {
+		invalid("interval", "must be positive")
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.CollectorIntervals)) {
+		d := c.CollectorIntervals[name]
+		if _, err := schedule.ParseCollector(name); err != nil {
+			invalid("collector_intervals", "%v (want one of %s)", err, collectorNames())
+		} else if d <= 0 {
+			invalid("collector_intervals."+name, "must be positive")
+		}
+	}
+
+	if strings.TrimSpace(c.ReportDirectory) == "" {
+		invalid("report_directory", "must not be empty")
+	}
+
+	for _, fstype := range c.Disk.ExcludeFstypes {
+		if strings.TrimSpace(fstype) == "" {
+			invalid("disk.exclude_fstypes", "must not contain empty entries")
+		}
+	}
+	for _, pattern := range c.Disk.ExcludeMountpoints {
+		if _, err := filepath.Match(pattern, "/"); err != nil || !filepath.IsAbs(pattern) {
+			invalid("disk.exclude_mountpoints", "%q is not an absolute path pattern", pattern)
+		}
+	}
+	if c.Disk.InodeThreshold <= 0 || c.Disk.InodeThreshold > 100 {
+		invalid("disk.inode_threshold", "must be a percentage between 0 and 100, got %g", c.Disk.InodeThreshold)
+	}
+
+	h := c.Health
+	for _, p := range []struct {
+		field string
+		value float64
+	}{
+		{"health.iowait_warning", h.IOWaitWarning},
+		{"health.iowait_critical", h.IOWaitCritical},
+		{"health.steal_warning", h.StealWarning},
+		{"health.steal_critical", h.StealCritical},
+	} {
+		if p.value < 0 || p.value > 100 {
+			invalid(p.field, "must be a percentage between 0 and 100, got %g", p.value)
+		}
+	}
+	if h.IOWaitWarning > h.IOWaitCritical {
+		invalid("health.iowait_warning", "must not exceed iowait_critical")
+	}
+	if h.StealWarning > h.StealCritical {
+		invalid("health.steal_warning", "must not exceed steal_critical")
+	}
+
+	for i, rule := range c.Ports {
+		field := fmt.Sprintf("ports[%d]", i)
+		if len(rule.Ports) == 0 {
+			invalid(field, "must list at least one port")
+		}
+		for _, port := range rule.Ports {
+			if port < 1 || port > 65535 {
+				invalid(field, "port %d is out of range", port)
+			}
+		}
+		if _, err := security.ParseRiskLevel(rule.Risk); err != nil {
+			invalid(field, "%v", err)
+		}
+	}
+
+	for _, u := range []struct {
+		field string
+		raw   string
+	}{
+		{"speedtest.download_url", c.SpeedTest.DownloadURL},
+		{"speedtest.upload_url", c.SpeedTest.UploadURL},
+		{"speedtest.ping_url", c.SpeedTest.PingURL},
+	} {
+		parsed, err := url.Parse(u.raw)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			invalid(u.field, "%q is not an http(s) URL", u.raw)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Apply installs the settings consumed by the system collectors.
+func (c Config) Apply() {
+	resource.DiskExclusions = resource.DiskFilter{
+		ExcludeFstypes:     c.Disk.ExcludeFstypes,
+		ExcludeMountpoints: c.Disk.ExcludeMountpoints,
+	}
+	resource.InodeThreshold = c.Disk.InodeThreshold
+
+	performance.Thresholds = performance.HealthThresholds{
+		IOWaitWarning:   c.Health.IOWaitWarning,
+		IOWaitCritical:  c.Health.IOWaitCritical,
+		StealWarning:    c.Health.StealWarning,
+		StealCritical:   c.Health.StealCritical,
+		MajorFaults:     c.Health.MajorFaults,
+		ContextSwitches: c.Health.ContextSwitches,
+	}
+
+	rules := make([]security.PortRule, 0, len(c.Ports))
+	for _, rule := range c.Ports {
+		risk, _ := security.ParseRiskLevel(rule.Risk)
+		rules = append(rules, security.PortRule{Ports: rule.Ports, Risk: risk, Message: rule.Message})
+	}
+	security.PortRules = rules
+
+	network.SpeedTestURLs = network.SpeedTestServers{
+		DownloadURL: c.SpeedTest.DownloadURL,
+		UploadURL:   c.SpeedTest.UploadURL,
+		PingURL:     c.SpeedTest.PingURL,
+	}
+}
+
+// Schedule builds the collector schedule from the configured intervals.
+func (c Config) Schedule() schedule.Schedule {
+	intervals := make(map[schedule.Collector]time.Duration, len(c.CollectorIntervals))
+	for name, d := range c.CollectorIntervals {
+		if collector, err := schedule.ParseCollector(name); err == nil {
+			intervals[collector] = time.Duration(d)
+		}
+	}
+	return schedule.New(time.Duration(c.Interval), intervals)
+}
+
+// YAML renders the configuration in the format Load accepts.
+func (c Config) YAML() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), encoder.Close()
+}
+
+func collectorNames() string {
+	names := make([]string, len(schedule.Collectors))
+	for i, c := range schedule.Collectors {
+		names[i] = string(c)
+	}
+	return strings.Join(names, ", ")
+}
+
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	return path
+}
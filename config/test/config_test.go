@@ -0,0 +1,104 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/config"
+	"github.com/System-Pulse/server-pulse/system/schedule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.Parse([]byte(""))
+	require.NoError(t, err)
+	assert.Equal(t, config.Default(), cfg)
+}
+
+func TestParseOverrides(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.Parse([]byte(`
+interval: 5s
+collector_intervals:
+  processes: 30s
+disk:
+  exclude_fstypes: [tmpfs, nfs4]
+  exclude_mountpoints: [/mnt/*]
+health:
+  iowait_warning: 15
+ports:
+  - ports: [8080]
+    risk: warning
+    message: Development server exposed
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, config.Duration(5*time.Second), cfg.Interval)
+	assert.Equal(t, []string{"tmpfs", "nfs4"}, cfg.Disk.ExcludeFstypes)
+	assert.Equal(t, []string{"/mnt/*"}, cfg.Disk.ExcludeMountpoints)
+	assert.Equal(t, 15.0, cfg.Health.IOWaitWarning)
+	assert.Equal(t, config.Default().Health.IOWaitCritical, cfg.Health.IOWaitCritical)
+	require.Len(t, cfg.Ports, 1)
+	assert.Equal(t, []int{8080}, cfg.Ports[0].Ports)
+
+	sched := cfg.Schedule()
+	assert.Equal(t, 5*time.Second, sched.Interval)
+	assert.Equal(t, 30*time.Second, sched.IntervalFor(schedule.CollectorProcesses))
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Unknown key", "intervall: 2s", "field intervall not found"},
+		{"Bad duration", "interval: soon", `invalid duration "soon"`},
+		{"Non-positive interval", "interval: 0s", "interval: must be positive"},
+		{"Unknown collector", "collector_intervals: {gpu: 1s}", `unknown collector "gpu"`},
+		{"Relative mountpoint pattern", "disk: {exclude_mountpoints: [data/*]}", `"data/*" is not an absolute path pattern`},
+		{"Malformed mountpoint pattern", "disk: {exclude_mountpoints: [\"/mnt/[\"]}", `"/mnt/[" is not an absolute path pattern`},
+		{"Inode threshold out of range", "disk: {inode_threshold: 120}", "disk.inode_threshold: must be a percentage between 0 and 100, got 120"},
+		{"Inverted thresholds", "health: {steal_warning: 50}", "steal_warning: must not exceed steal_critical"},
+		{"Port out of range", "ports: [{ports: [70000], risk: high}]", "port 70000 is out of range"},
+		{"Unknown risk", "ports: [{ports: [22], risk: severe}]", `unknown risk level "severe"`},
+		{"Bad URL", "speedtest: {ping_url: not-a-url}", `speedtest.ping_url: "not-a-url" is not an http(s) URL`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := config.Parse([]byte(tt.input))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := config.Load(filepath.Join(t.TempDir(), "config.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, config.Default(), cfg)
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content, err := config.Default().YAML()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, content, 0644))
+
+	cfg, err := config.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, config.Default(), cfg)
+}
@@ -2,22 +2,60 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
 	"log"
+	"maps"
 	"os"
 	"time"
 
 	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/system/schedule"
 	"github.com/System-Pulse/server-pulse/utils"
 	widgets "github.com/System-Pulse/server-pulse/widgets"
+	"github.com/System-Pulse/server-pulse/widgets/commands"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
 var dockerManager *app.DockerManager
 var currentModel tea.Model
+var collectorSchedule schedule.Schedule
+var reportDirectory string
 
 func main() {
+	cfg, cfgPath := loadConfig()
+
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "config":
+			os.Exit(runConfig(cfg, cfgPath, os.Args[2:]))
+		case "report":
+			os.Exit(runReport(os.Args[2:]))
+		case "serve":
+			os.Exit(runServe(os.Args[2:]))
+		}
+	}
+
+	fs := flag.NewFlagSet("server-pulse", flag.ExitOnError)
+	interval := fs.Duration("interval", time.Duration(cfg.Interval), "refresh interval for all collectors")
+	collectorIntervals := fs.String("collector-intervals", "", "per-collector intervals, e.g. processes=10s,containers=5s")
+	fs.Parse(os.Args[1:])
+
+	intervals, err := schedule.ParseCollectorIntervals(*collectorIntervals)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if *interval <= 0 {
+		fmt.Fprintln(os.Stderr, "interval must be positive")
+		os.Exit(2)
+	}
+	collectorSchedule = cfg.Schedule()
+	collectorSchedule.Interval = *interval
+	maps.Copy(collectorSchedule.Intervals, intervals)
+	reportDirectory = cfg.ReportDirectory
+
 	if ok, err := utils.CheckDockerPermissions(); !ok {
 		fmt.Println(err)
 		os.Exit(1)
@@ -25,7 +63,6 @@ func main() {
 	defer panicExit()
 
 	// Initialize docker manager globally (non-fatal if Docker is unavailable)
-	var err error
 	dockerManager, err = app.NewDockerManager()
 	if err != nil {
 		// Docker is unavailable but the app can still run without container management
@@ -37,6 +74,10 @@ func main() {
 			break
 		}
 	}
+
+	if model, ok := currentModel.(widgets.Model); ok {
+		model.StopLogsTee()
+	}
 }
 
 func runTUI() bool {
@@ -56,10 +97,10 @@ func runTUI() bool {
 			model.ClearPendingShellExec()
 			m = model
 		} else {
-			m = widgets.InitialModelWithManager(dockerManager)
+			m = newModel()
 		}
 	} else {
-		m = widgets.InitialModelWithManager(dockerManager)
+		m = newModel()
 	}
 
 	p := tea.NewProgram(
@@ -82,7 +123,7 @@ func runTUI() bool {
 		if shellRequest := model.GetPendingShellExec(); shellRequest != nil && dockerManager != nil {
 			time.Sleep(100 * time.Millisecond)
 
-			err := dockerManager.ExecInteractiveShellAlternative(shellRequest.ContainerID)
+			err := commands.ExecInteractiveShell(dockerManager, shellRequest.ContainerID)
 			if err != nil {
 				fmt.Printf("Shell execution failed: %v\n", err)
 				fmt.Println("Press Enter to continue...")
@@ -99,6 +140,13 @@ func runTUI() bool {
 	return true
 }
 
+func newModel() widgets.Model {
+	m := widgets.InitialModelWithManager(dockerManager)
+	m.Schedule = collectorSchedule
+	m.Reporting.ReportDirectory = reportDirectory
+	return m
+}
+
 func panicExit() {
 	if r := recover(); r != nil {
 		log.Println("shutting down")
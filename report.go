@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	info "github.com/System-Pulse/server-pulse/system/informations"
+	"github.com/System-Pulse/server-pulse/system/performance"
+	proc "github.com/System-Pulse/server-pulse/system/process"
+	resource "github.com/System-Pulse/server-pulse/system/resource"
+	"github.com/System-Pulse/server-pulse/system/security"
+	"github.com/System-Pulse/server-pulse/utils"
+	"github.com/System-Pulse/server-pulse/widgets/model"
+)
+
+const (
+	exitReportError    = 1
+	exitReportCritical = 2
+)
+
+// runReport collects every data source once, renders the same report as the
+// Reporting tab and returns the process exit code.
+func runReport(args []string) int {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	format := fs.String("format", "md", "output format: md or json")
+	output := fs.String("output", "", "write the report to FILE instead of stdout")
+	domain := fs.String("domain", "", "domain used for the SSL certificate check")
+	if err := fs.Parse(args); err != nil {
+		return exitReportError
+	}
+	reportFormat, err := model.ParseReportFormat(*format)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitReportError
+	}
+
+	monitor, diagnostic, checks := collectReportData(context.Background(), *domain)
+
+	reporting := model.NewReportModel()
+	doc := reporting.BuildReport(monitor, diagnostic, checks)
+
+	var content []byte
+	switch reportFormat {
+	case model.ReportFormatJSON:
+		content, err = doc.JSON()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "encode report: %v\n", err)
+			return exitReportError
+		}
+	default:
+		content = []byte(doc.Markdown())
+	}
+
+	if *output == "" {
+		os.Stdout.Write(content)
+	} else if err := os.WriteFile(*output, content, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "write report: %v\n", err)
+		return exitReportError
+	}
+
+	if doc.Summary.Status == model.StatusCritical {
+		return exitReportCritical
+	}
+	return 0
+}
+
+// collectReportData runs the collectors once, concurrently, and assembles the
+// models BuildReport expects. Collector errors are reported on stderr and
+// leave the corresponding section empty.
+func collectReportData(ctx context.Context, domain string) (model.MonitorModel, model.DiagnosticModel, []security.SecurityCheck) {
+	var monitor model.MonitorModel
+	var diagnostic model.DiagnosticModel
+	var checks []security.SecurityCheck
+
+	if manager, err := app.NewDockerManager(); err == nil {
+		monitor.App = manager
+	}
+
+	sm := security.NewSecurityManager()
+	sm.IsRoot = utils.IsRoot()
+
+	collectors := map[string]func() error{
+		"system info": func() (err error) {
+			monitor.System, err = info.CollectSystemInfo(ctx)
+			return err
+		},
+		"cpu": func() (err error) {
+			monitor.Cpu, err = resource.CollectCPU(ctx)
+			return err
+		},
+		"memory": func() (err error) {
+			monitor.Memory, err = resource.CollectMemory(ctx)
+			return err
+		},
+		"disks": func() (err error) {
+			monitor.Disks, err = resource.CollectDisks(ctx)
+			return err
+		},
+		"processes": func() (err error) {
+			monitor.Processes, err = proc.CollectProcesses(ctx)
+			return err
+		},
+		"health metrics": func() error {
+			metrics, err := performance.CollectHealthMetrics(ctx)
+			if err != nil {
+				return err
+			}
+			diagnostic.Performance.HealthMetrics = metrics
+			diagnostic.Performance.HealthScore = performance.CalculateHealthScore(metrics)
+			return nil
+		},
+		"security checks": func() (err error) {
+			checks, err = sm.CollectSecurityChecks(ctx, domain)
+			return err
+		},
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for name, collect := range collectors {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := collect(); err != nil {
+				mu.Lock()
+				fmt.Fprintf(os.Stderr, "warning: %s: %v\n", name, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return monitor, diagnostic, checks
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/system/exporter"
+)
+
+// runServe exposes the collectors as a Prometheus /metrics endpoint and
+// returns the process exit code once interrupted.
+func runServe(args []string) int {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	listen := fs.String("listen", ":9105", "address to expose /metrics on")
+	domain := fs.String("domain", "", "domain used for the SSL certificate check")
+	securityInterval := fs.Duration("security-interval", 5*time.Minute, "how often security checks are refreshed")
+	timeout := fs.Duration("scrape-timeout", 10*time.Second, "maximum time spent collecting a scrape")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	var docker *app.DockerManager
+	if manager, err := app.NewDockerManager(); err == nil {
+		docker = manager
+	} else {
+		fmt.Fprintf(os.Stderr, "warning: container metrics disabled: %v\n", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	e := exporter.New(docker, *domain, *securityInterval)
+	fmt.Fprintf(os.Stderr, "serving metrics on %s/metrics\n", *listen)
+	if err := e.Serve(ctx, *listen, *timeout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,94 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+)
+
+// NoProject is the project of containers not started by Compose.
+const NoProject = "N/A"
+
+// projectLogTail bounds the lines read per service for merged logs.
+const projectLogTail = "1000"
+
+// ProjectOperation runs operation ("restart", "start" or "stop") on every
+// given container of a Compose project concurrently and reports all
+// failures together.
+func (dm *DockerManager) ProjectOperation(operation string, containerIDs []string) error {
+	var run func(string) error
+	switch operation {
+	case "restart":
+		run = dm.RestartContainer
+	case "start":
+		run = dm.StartContainer
+	case "stop":
+		run = dm.StopContainer
+	default:
+		return fmt.Errorf("unsupported project operation %q", operation)
+	}
+
+	errs := make([]error, len(containerIDs))
+	var wg sync.WaitGroup
+	for i, id := range containerIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = run(id)
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// GetProjectLogs returns the recent logs of every service of a project
+// within a time window, merged in time order. containers maps container IDs
+// to names.
+func (dm *DockerManager) GetProjectLogs(containers map[string]string, window LogsWindow) ([]LogLine, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	logs := make(map[string][]LogLine, len(containers))
+	for id, name := range containers {
+		lines, err := dm.containerLogs(ctx, id, container.LogsOptions{
+			ShowStdout: true,
+			ShowStderr: true,
+			Timestamps: true,
+			Since:      window.Since,
+			Until:      window.Until,
+			Tail:       projectLogTail,
+		})
+		if err != nil {
+			return nil, err
+		}
+		logs[name] = lines
+	}
+	return MergeLogLines(logs), nil
+}
+
+// SampleContainerStats takes one stats sample of each container
+// concurrently. Containers whose stats cannot be read are left out.
+func (dm *DockerManager) SampleContainerStats(ctx context.Context, containerIDs []string) map[string]ContainerStats {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	result := make(map[string]ContainerStats, len(containerIDs))
+	for _, id := range containerIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stats, err := dm.GetContainerStats(ctx, id)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			result[id] = *stats
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return result
+}
@@ -0,0 +1,243 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+	timetypes "github.com/moby/moby/api/types/time"
+)
+
+// LogLevels are the levels detected in JSON log lines, least severe first.
+var LogLevels = []string{"trace", "debug", "info", "warn", "error", "fatal"}
+
+// LogLevelRank returns the position of a level in LogLevels, -1 for lines
+// without a level.
+func LogLevelRank(level string) int {
+	for i, l := range LogLevels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// LogLine is one line of a container's output.
+type LogLine struct {
+	Time    time.Time // when the daemon received the line, zero if unknown
+	Stderr  bool
+	Service string // container the line comes from in merged project logs
+	Text    string
+	Level   string // one of LogLevels for JSON lines with a level field, empty otherwise
+}
+
+// ParseLogLine reads a line as returned with timestamps: an RFC 3339
+// timestamp, a space and the text.
+func ParseLogLine(raw string, stderr bool) LogLine {
+	line := LogLine{Stderr: stderr, Text: raw}
+	if stamp, rest, ok := strings.Cut(raw, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, stamp); err == nil {
+			line.Time, line.Text = t, rest
+		}
+	}
+	line.Level = DetectLogLevel(line.Text)
+	return line
+}
+
+// logLevelKeys are the fields holding the level in common JSON log formats
+// (logrus, zap, zerolog, pino, bunyan, structlog, ECS...).
+var logLevelKeys = []string{"level", "lvl", "severity", "log.level", "levelname", "loglevel"}
+
+// DetectLogLevel returns the level of a JSON log line, or "" when the line
+// is not a JSON object or has no recognizable level.
+func DetectLogLevel(text string) string {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "{") || !strings.HasSuffix(text, "}") {
+		return ""
+	}
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(text), &fields); err != nil {
+		return ""
+	}
+	for _, key := range logLevelKeys {
+		if level := normalizeLogLevel(fields[key]); level != "" {
+			return level
+		}
+	}
+	return ""
+}
+
+func normalizeLogLevel(value any) string {
+	switch v := value.(type) {
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "trace":
+			return "trace"
+		case "debug", "dbug":
+			return "debug"
+		case "info", "information", "informational", "notice":
+			return "info"
+		case "warn", "warning":
+			return "warn"
+		case "error", "err":
+			return "error"
+		case "fatal", "panic", "critical", "crit", "alert", "emerg", "emergency":
+			return "fatal"
+		}
+	case float64: // pino and bunyan numeric levels
+		switch {
+		case v >= 60:
+			return "fatal"
+		case v >= 50:
+			return "error"
+		case v >= 40:
+			return "warn"
+		case v >= 30:
+			return "info"
+		case v >= 20:
+			return "debug"
+		case v >= 10:
+			return "trace"
+		}
+	}
+	return ""
+}
+
+// LogsWindow limits logs to a time range. Since and Until accept what
+// "docker logs --since/--until" accepts: a duration relative to now such as
+// "30m", an RFC 3339 date or a Unix timestamp. Empty means unbounded.
+type LogsWindow struct {
+	Since string
+	Until string
+}
+
+// ParseLogsWindow parses "SINCE [UNTIL]" as typed in the logs view, where
+// SINCE may be "-" for no start. An empty string removes the window.
+func ParseLogsWindow(s string) (LogsWindow, error) {
+	fields := strings.Fields(s)
+	var window LogsWindow
+	switch len(fields) {
+	case 0:
+		return window, nil
+	case 1:
+		window.Since = fields[0]
+	case 2:
+		window.Since, window.Until = fields[0], fields[1]
+	default:
+		return LogsWindow{}, fmt.Errorf("expected a start and an optional end, got %d values", len(fields))
+	}
+	if window.Since == "-" {
+		window.Since = ""
+	}
+	return window, window.Validate()
+}
+
+// Validate checks the bounds the way the Docker client parses them.
+func (w LogsWindow) Validate() error {
+	now := time.Now()
+	if _, err := timetypes.GetTimestamp(w.Since, now); w.Since != "" && err != nil {
+		return fmt.Errorf("invalid start %q: %w", w.Since, err)
+	}
+	if _, err := timetypes.GetTimestamp(w.Until, now); w.Until != "" && err != nil {
+		return fmt.Errorf("invalid end %q: %w", w.Until, err)
+	}
+	return nil
+}
+
+func (w LogsWindow) IsZero() bool {
+	return w.Since == "" && w.Until == ""
+}
+
+func (w LogsWindow) String() string {
+	switch {
+	case w.IsZero():
+		return "all"
+	case w.Until == "":
+		return "since " + w.Since
+	case w.Since == "":
+		return "until " + w.Until
+	}
+	return w.Since + " → " + w.Until
+}
+
+// ReadLogs reads a logs stream requested with timestamps. Containers
+// without a terminal have stdout and stderr multiplexed; with one, all
+// output is sent raw as stdout.
+func ReadLogs(r io.Reader, tty bool) ([]LogLine, error) {
+	var lines []LogLine
+	err := scanLines(r, tty, func(stderr bool, text string) error {
+		lines = append(lines, ParseLogLine(text, stderr))
+		return nil
+	})
+	return lines, err
+}
+
+func (dm *DockerManager) containerTTY(ctx context.Context, containerID string) (bool, error) {
+	inspect, err := dm.Cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect container %s: %w", containerID, err)
+	}
+	return inspect.Config != nil && inspect.Config.Tty, nil
+}
+
+// GetContainerLogs returns the logs of a container within a time window.
+func (dm *DockerManager) GetContainerLogs(containerID string, window LogsWindow) ([]LogLine, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return dm.containerLogs(ctx, containerID, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Timestamps: true,
+		Since:      window.Since,
+		Until:      window.Until,
+		Tail:       "all",
+	})
+}
+
+func (dm *DockerManager) containerLogs(ctx context.Context, containerID string, options container.LogsOptions) ([]LogLine, error) {
+	tty, err := dm.containerTTY(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := dm.Cli.ContainerLogs(ctx, containerID, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs for container %s: %w", containerID, err)
+	}
+	defer logs.Close()
+
+	lines, err := ReadLogs(logs, tty)
+	if err != nil {
+		return nil, fmt.Errorf("error reading logs: %w", err)
+	}
+	return lines, nil
+}
+
+// MergeLogLines interleaves the logs of several services in time order and
+// sets the service of each line. Lines without a timestamp keep the time of
+// the line before them.
+func MergeLogLines(logs map[string][]LogLine) []LogLine {
+	var merged []LogLine
+	for service, lines := range logs {
+		var last time.Time
+		for _, line := range lines {
+			if line.Time.IsZero() {
+				line.Time = last
+			}
+			last = line.Time
+			line.Service = service
+			merged = append(merged, line)
+		}
+	}
+
+	// Sorting by service first keeps equal timestamps in a stable order.
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].Service < merged[j].Service })
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].Time.Before(merged[j].Time) })
+	return merged
+}
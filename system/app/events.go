@@ -0,0 +1,137 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/events"
+	"github.com/moby/moby/api/types/filters"
+)
+
+// ContainerEvent is a container lifecycle event reported by the daemon.
+type ContainerEvent struct {
+	Time        time.Time
+	ContainerID string // short ID
+	Name        string
+	Project     string
+	Action      string // create, start, restart, stop, die, oom, pause, unpause, destroy or health_status
+	ExitCode    string // set for die
+	Health      string // set for health_status
+}
+
+// Crashed reports whether the event is a container exiting with an error.
+func (e ContainerEvent) Crashed() bool {
+	return e.Action == string(events.ActionDie) && e.ExitCode != "" && e.ExitCode != "0"
+}
+
+// Detail describes what the event reports beyond its action.
+func (e ContainerEvent) Detail() string {
+	switch {
+	case e.Action == string(events.ActionDie):
+		return "exit code " + e.ExitCode
+	case e.Action == string(events.ActionOOM):
+		return "out of memory"
+	case e.Health != "":
+		return e.Health
+	}
+	return ""
+}
+
+// watchedActions are the container events worth showing; exec and attach
+// events, e.g. from health checks, are left out.
+var watchedActions = map[events.Action]bool{
+	events.ActionCreate:       true,
+	events.ActionStart:        true,
+	events.ActionRestart:      true,
+	events.ActionStop:         true,
+	events.ActionDie:          true,
+	events.ActionOOM:          true,
+	events.ActionPause:        true,
+	events.ActionUnPause:      true,
+	events.ActionDestroy:      true,
+	events.ActionRename:       true,
+	events.ActionHealthStatus: true,
+}
+
+// ParseEvent turns a daemon event into a ContainerEvent. It reports false
+// for events that are not about containers or not worth showing.
+func ParseEvent(msg events.Message) (ContainerEvent, bool) {
+	if msg.Type != events.ContainerEventType {
+		return ContainerEvent{}, false
+	}
+
+	action, health, _ := strings.Cut(string(msg.Action), ":")
+	if !watchedActions[events.Action(action)] {
+		return ContainerEvent{}, false
+	}
+
+	attrs := msg.Actor.Attributes
+	project := attrs["com.docker.compose.project"]
+	if project == "" {
+		project = NoProject
+	}
+	name := strings.TrimPrefix(attrs["name"], project+"-")
+	if name == "" {
+		name = "N/A"
+	}
+
+	event := ContainerEvent{
+		ContainerID: msg.Actor.ID[:min(12, len(msg.Actor.ID))],
+		Name:        name,
+		Project:     project,
+		Action:      action,
+		Health:      strings.TrimSpace(health),
+	}
+	if msg.TimeNano != 0 {
+		event.Time = time.Unix(0, msg.TimeNano)
+	} else {
+		event.Time = time.Unix(msg.Time, 0)
+	}
+	if event.Action == string(events.ActionDie) {
+		event.ExitCode = attrs["exitCode"]
+	}
+	return event, true
+}
+
+// WatchEvents subscribes to container events, starting with the ones the
+// daemon recorded since the given time. Events are delivered until ctx is
+// cancelled or the stream fails; the failure is then sent on the error
+// channel and both channels are closed.
+func (dm *DockerManager) WatchEvents(ctx context.Context, since time.Time) (<-chan ContainerEvent, <-chan error) {
+	options := events.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("type", string(events.ContainerEventType))),
+	}
+	if !since.IsZero() {
+		options.Since = fmt.Sprintf("%d.%09d", since.Unix(), since.Nanosecond())
+	}
+
+	messages, errs := dm.Cli.Events(ctx, options)
+	result := make(chan ContainerEvent)
+	failure := make(chan error, 1)
+	go func() {
+		defer close(result)
+		defer close(failure)
+		for {
+			select {
+			case msg := <-messages:
+				event, ok := ParseEvent(msg)
+				if !ok {
+					continue
+				}
+				select {
+				case result <- event:
+				case <-ctx.Done():
+					return
+				}
+			case err := <-errs:
+				if ctx.Err() == nil {
+					failure <- fmt.Errorf("docker events stream ended: %w", err)
+				}
+				return
+			}
+		}
+	}()
+	return result, failure
+}
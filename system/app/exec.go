@@ -0,0 +1,214 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/pkg/stdcopy"
+	"github.com/moby/moby/api/types/container"
+)
+
+// maxLineLength bounds a line of command or log output; longer lines are
+// split.
+const maxLineLength = 64 * 1024
+
+// SplitCommand splits a command line into arguments the way a POSIX shell
+// does for quoting: single quotes are literal, double quotes allow \" \\ \$
+// and \` escapes, and a backslash outside quotes escapes the next character.
+// Pipes, redirections and variables are not interpreted; commands needing
+// them can be run with sh -c '...'.
+func SplitCommand(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+	)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		case c == '\\':
+			if i+1 == len(line) {
+				return nil, errors.New("trailing backslash")
+			}
+			i++
+			current.WriteByte(line[i])
+			inArg = true
+		case c == '\'':
+			end := strings.IndexByte(line[i+1:], '\'')
+			if end < 0 {
+				return nil, errors.New("unterminated single quote")
+			}
+			current.WriteString(line[i+1 : i+1+end])
+			i += end + 1
+			inArg = true
+		case c == '"':
+			closed := false
+			for i++; i < len(line); i++ {
+				if line[i] == '"' {
+					closed = true
+					break
+				}
+				if line[i] == '\\' && i+1 < len(line) && strings.IndexByte("\"\\$`", line[i+1]) >= 0 {
+					i++
+				}
+				current.WriteByte(line[i])
+			}
+			if !closed {
+				return nil, errors.New("unterminated double quote")
+			}
+			inArg = true
+		default:
+			current.WriteByte(c)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	if len(args) == 0 {
+		return nil, errors.New("empty command")
+	}
+	return args, nil
+}
+
+// ExecLine is one line printed by a command run in a container.
+type ExecLine struct {
+	Stderr bool
+	Text   string
+}
+
+// ExecResult is how a command run in a container ended. Err is set when the
+// command could not be run or its output could not be read.
+type ExecResult struct {
+	ExitCode int
+	Err      error
+}
+
+// RunCommand runs a command in a container without a terminal and streams
+// its output line by line. The result channel receives one value once the
+// output is closed. Canceling ctx stops reading; the daemon has no way to
+// stop an exec, so the process itself keeps running.
+func (dm *DockerManager) RunCommand(ctx context.Context, containerID string, argv []string) (string, <-chan ExecLine, <-chan ExecResult, error) {
+	created, err := dm.Cli.ContainerExecCreate(ctx, containerID, container.ExecOptions{
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          argv,
+	})
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to create exec in container %s: %w", containerID, err)
+	}
+	resp, err := dm.Cli.ContainerExecAttach(ctx, created.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to start exec in container %s: %w", containerID, err)
+	}
+
+	lines := make(chan ExecLine)
+	result := make(chan ExecResult, 1)
+	go func() {
+		defer close(result)
+		defer close(lines)
+
+		stop := context.AfterFunc(ctx, resp.Close)
+		err := DemuxExecOutput(ctx, resp.Reader, lines)
+		stop()
+		resp.Close()
+		if ctx.Err() != nil {
+			result <- ExecResult{ExitCode: -1, Err: ctx.Err()}
+			return
+		}
+		if err != nil {
+			result <- ExecResult{ExitCode: -1, Err: fmt.Errorf("failed to read command output: %w", err)}
+			return
+		}
+
+		inspectCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		inspect, err := dm.Cli.ContainerExecInspect(inspectCtx, created.ID)
+		if err != nil {
+			result <- ExecResult{ExitCode: -1, Err: fmt.Errorf("failed to inspect exec: %w", err)}
+			return
+		}
+		result <- ExecResult{ExitCode: inspect.ExitCode}
+	}()
+	return created.ID, lines, result, nil
+}
+
+// DemuxExecOutput splits the multiplexed stdout and stderr stream of an exec
+// attached without a terminal into lines and sends them to lines, until the
+// stream ends or ctx is canceled.
+func DemuxExecOutput(ctx context.Context, r io.Reader, lines chan<- ExecLine) error {
+	return scanLines(r, false, func(stderr bool, text string) error {
+		select {
+		case lines <- ExecLine{Stderr: stderr, Text: text}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// scanLines splits the output of a container process into lines. Without a
+// terminal the daemon multiplexes stdout and stderr; with one, everything is
+// sent raw as stdout.
+func scanLines(r io.Reader, tty bool, emit func(stderr bool, text string) error) error {
+	stdout := &lineWriter{emit: func(text string) error { return emit(false, text) }}
+	stderr := &lineWriter{emit: func(text string) error { return emit(true, text) }}
+	var err error
+	if tty {
+		_, err = io.Copy(stdout, r)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, r)
+	}
+	if err == nil {
+		err = stdout.flush()
+	}
+	if err == nil {
+		err = stderr.flush()
+	}
+	return err
+}
+
+// lineWriter emits the complete lines written to it, without their line
+// ending; flush emits the last line when it has no trailing newline.
+type lineWriter struct {
+	emit func(text string) error
+	buf  []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		end, next := i, i+1
+		if i < 0 || i > maxLineLength {
+			if len(w.buf) < maxLineLength {
+				return len(p), nil
+			}
+			end, next = maxLineLength, maxLineLength
+		}
+		if err := w.emit(strings.TrimSuffix(string(w.buf[:end]), "\r")); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[next:]
+	}
+}
+
+func (w *lineWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.emit(strings.TrimSuffix(string(w.buf), "\r"))
+	w.buf = nil
+	return err
+}
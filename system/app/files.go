@@ -0,0 +1,301 @@
+package app
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+)
+
+// maxDirEntries bounds the archive entries read to list a directory. The
+// archive API streams the whole subtree, so listing "/" of a large container
+// stops early and is reported as truncated.
+const maxDirEntries = 20000
+
+// FileChange is a path added, changed or deleted in a container compared to
+// its image.
+type FileChange struct {
+	Kind string // "added", "changed" or "deleted"
+	Path string
+}
+
+// FileChanges converts a daemon filesystem diff, sorted by path.
+func FileChanges(diff []container.FilesystemChange) []FileChange {
+	changes := make([]FileChange, len(diff))
+	for i, c := range diff {
+		kind := "changed"
+		switch c.Kind {
+		case container.ChangeAdd:
+			kind = "added"
+		case container.ChangeDelete:
+			kind = "deleted"
+		}
+		changes[i] = FileChange{Kind: kind, Path: c.Path}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes
+}
+
+// GetFilesystemDiff returns the changes of a container's writable layer.
+func (dm *DockerManager) GetFilesystemDiff(containerID string) ([]FileChange, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	diff, err := dm.Cli.ContainerDiff(ctx, containerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filesystem changes of container %s: %w", containerID, err)
+	}
+	return FileChanges(diff), nil
+}
+
+// FileEntry is a file or directory inside a container.
+type FileEntry struct {
+	Name       string
+	Path       string
+	Size       int64
+	Mode       os.FileMode
+	ModTime    time.Time
+	LinkTarget string
+}
+
+func (e FileEntry) IsDir() bool {
+	return e.Mode.IsDir()
+}
+
+// DirListing is the content of a directory, directories first.
+type DirListing struct {
+	Path      string
+	Entries   []FileEntry
+	Truncated bool
+}
+
+// ListContainerDir lists a directory of a container through the archive API,
+// which works without a shell in the container and on stopped containers.
+func (dm *DockerManager) ListContainerDir(containerID, dir string) (DirListing, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	dir = path.Clean("/" + dir)
+	archive, stat, err := dm.Cli.CopyFromContainer(ctx, containerID, dir)
+	if err != nil {
+		return DirListing{}, fmt.Errorf("failed to read %s in container %s: %w", dir, containerID, err)
+	}
+	defer archive.Close()
+	if !stat.Mode.IsDir() {
+		return DirListing{}, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	listing, err := ListArchiveDir(archive, dir, maxDirEntries)
+	if errors.Is(err, context.DeadlineExceeded) {
+		listing.Truncated = true
+		err = nil
+	}
+	return listing, err
+}
+
+// ListArchiveDir reads the immediate children of dir from a tar archive of
+// it, as returned by the archive API: the first entry is the directory itself
+// and the others are named relative to its parent. Reading stops after limit
+// entries and the listing is then marked truncated.
+func ListArchiveDir(r io.Reader, dir string, limit int) (DirListing, error) {
+	listing := DirListing{Path: dir}
+	tr := tar.NewReader(r)
+	root := ""
+	for read := 0; ; read++ {
+		if read == limit {
+			listing.Truncated = true
+			break
+		}
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			sortEntries(listing.Entries)
+			return listing, err
+		}
+
+		name := path.Clean(hdr.Name)
+		if read == 0 {
+			root = name
+			continue
+		}
+		var rel string
+		switch root {
+		case ".", "/": // listing "/"
+			rel = strings.TrimPrefix(name, "/")
+		default:
+			rel = strings.TrimPrefix(name, root+"/")
+			if rel == name {
+				continue
+			}
+		}
+		if rel == "" || rel == "." || strings.Contains(rel, "/") {
+			continue
+		}
+		listing.Entries = append(listing.Entries, FileEntry{
+			Name:       rel,
+			Path:       path.Join(dir, rel),
+			Size:       hdr.Size,
+			Mode:       hdr.FileInfo().Mode(),
+			ModTime:    hdr.ModTime,
+			LinkTarget: hdr.Linkname,
+		})
+	}
+	sortEntries(listing.Entries)
+	return listing, nil
+}
+
+func sortEntries(entries []FileEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].IsDir() != entries[j].IsDir() {
+			return entries[i].IsDir()
+		}
+		return entries[i].Name < entries[j].Name
+	})
+}
+
+// CopyResult describes files copied out of a container.
+type CopyResult struct {
+	Dest  string // the copied file or directory on the host
+	Files int
+	Bytes int64
+}
+
+// CopyFromContainer copies a file or directory of a container into destDir
+// on the host, like "docker cp container:srcPath destDir".
+func (dm *DockerManager) CopyFromContainer(containerID, srcPath, destDir string) (CopyResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	archive, _, err := dm.Cli.CopyFromContainer(ctx, containerID, srcPath)
+	if err != nil {
+		return CopyResult{}, fmt.Errorf("failed to copy %s from container %s: %w", srcPath, containerID, err)
+	}
+	defer archive.Close()
+
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return CopyResult{}, fmt.Errorf("failed to create %s: %w", destDir, err)
+	}
+	return ExtractArchive(archive, destDir)
+}
+
+// ExtractArchive writes the regular files, directories and links of a tar
+// archive below destDir. Entries that would end up outside destDir, through
+// ".." or through a symlink extracted earlier, are rejected. Devices and
+// other special files are skipped.
+func ExtractArchive(r io.Reader, destDir string) (CopyResult, error) {
+	root, err := filepath.EvalSymlinks(destDir)
+	if err != nil {
+		return CopyResult{}, err
+	}
+
+	var result CopyResult
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+
+		target, err := extractPath(root, hdr.Name)
+		if err != nil {
+			return result, err
+		}
+		if result.Dest == "" {
+			result.Dest = target
+		}
+
+		mode := hdr.FileInfo().Mode()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, mode.Perm()|0o700); err != nil {
+				return result, err
+			}
+		case tar.TypeReg:
+			n, err := writeFile(target, tr, mode.Perm())
+			if err != nil {
+				return result, err
+			}
+			result.Files++
+			result.Bytes += n
+		case tar.TypeSymlink:
+			os.Remove(target)
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return result, err
+			}
+			result.Files++
+		case tar.TypeLink:
+			source, err := extractPath(root, hdr.Linkname)
+			if err != nil {
+				return result, err
+			}
+			os.Remove(target)
+			if err := os.Link(source, target); err != nil {
+				return result, err
+			}
+			result.Files++
+		}
+	}
+}
+
+// extractPath joins an archive entry name to root and creates its parent
+// directory. The deepest existing ancestor is resolved first, so that a
+// symlink extracted earlier cannot redirect later entries outside root.
+func extractPath(root, name string) (string, error) {
+	target := filepath.Join(root, filepath.FromSlash(path.Clean("/"+name)))
+	if target == root {
+		return target, nil
+	}
+
+	dir := filepath.Dir(target)
+	existing := dir
+	for {
+		if _, err := os.Lstat(existing); err == nil {
+			break
+		}
+		existing = filepath.Dir(existing)
+	}
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", err
+	}
+	if resolved != root && !strings.HasPrefix(resolved, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("archive entry %q points outside %s", name, root)
+	}
+
+	rest, err := filepath.Rel(existing, dir)
+	if err != nil {
+		return "", err
+	}
+	parent := filepath.Join(resolved, rest)
+	if err := os.MkdirAll(parent, 0o755); err != nil {
+		return "", err
+	}
+	return filepath.Join(parent, filepath.Base(target)), nil
+}
+
+func writeFile(target string, r io.Reader, perm os.FileMode) (int64, error) {
+	os.Remove(target) // do not write through a symlink
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_EXCL, perm)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
+}
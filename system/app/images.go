@@ -0,0 +1,192 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/filters"
+	"github.com/moby/moby/api/types/image"
+)
+
+const noneTag = "<none>"
+
+// Image is one tag of a local image. An image with several tags is listed
+// once per tag; dangling images have neither repository nor tag.
+type Image struct {
+	ID         string // short ID, without the "sha256:" prefix
+	FullID     string
+	Repository string
+	Tag        string
+	Size       int64
+	Created    time.Time
+	Containers []string // names of the containers created from the image
+}
+
+// Dangling reports whether the image has no repository, i.e. it was
+// superseded by a newer build or pull of the same tag.
+func (i Image) Dangling() bool {
+	return i.Repository == noneTag
+}
+
+// Ref is what remove and pull act on: repository:tag for tagged images and
+// the ID otherwise.
+func (i Image) Ref() string {
+	if i.Repository == noneTag || i.Tag == noneTag {
+		return i.ID
+	}
+	return i.Repository + ":" + i.Tag
+}
+
+// ListImages returns the local images together with the containers, running
+// or not, that use them.
+func (dm *DockerManager) ListImages(ctx context.Context) ([]Image, error) {
+	images, err := dm.Cli.ImageList(ctx, image.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images: %w", err)
+	}
+	containers, err := dm.Cli.ContainerList(ctx, container.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+	return BuildImages(images, containers), nil
+}
+
+// BuildImages turns image summaries into one Image per tag, largest first,
+// and attributes each container to the image it was created from.
+func BuildImages(images []image.Summary, containers []container.Summary) []Image {
+	usedBy := make(map[string][]string)
+	for _, cont := range containers {
+		_, name := containerNames(cont)
+		usedBy[cont.ImageID] = append(usedBy[cont.ImageID], name)
+	}
+
+	var result []Image
+	for _, img := range images {
+		id := strings.TrimPrefix(img.ID, "sha256:")
+		base := Image{
+			ID:         id[:min(12, len(id))],
+			FullID:     img.ID,
+			Size:       img.Size,
+			Created:    time.Unix(img.Created, 0),
+			Containers: usedBy[img.ID],
+		}
+		sort.Strings(base.Containers)
+
+		tags := img.RepoTags
+		if len(tags) == 0 {
+			// Images pulled by digest only have a repository digest.
+			for _, digest := range img.RepoDigests {
+				repo, _, _ := strings.Cut(digest, "@")
+				tags = append(tags, repo+":"+noneTag)
+			}
+		}
+		if len(tags) == 0 {
+			tags = []string{noneTag + ":" + noneTag}
+		}
+		for _, repoTag := range tags {
+			i := base
+			i.Repository, i.Tag = SplitRepoTag(repoTag)
+			result = append(result, i)
+		}
+	}
+
+	sort.SliceStable(result, func(a, b int) bool {
+		if result[a].Size != result[b].Size {
+			return result[a].Size > result[b].Size
+		}
+		return result[a].Ref() < result[b].Ref()
+	})
+	return result
+}
+
+// SplitRepoTag splits "registry:5000/name:tag" into the repository and the
+// tag. The colon of a registry port is not taken for a tag separator.
+func SplitRepoTag(repoTag string) (repository, tag string) {
+	i := strings.LastIndex(repoTag, ":")
+	if i < 0 || strings.Contains(repoTag[i+1:], "/") {
+		return repoTag, "latest"
+	}
+	return repoTag[:i], repoTag[i+1:]
+}
+
+// RemoveImage removes ref. Removing one tag of an image with several tags
+// only untags it; images used by containers are refused.
+func (dm *DockerManager) RemoveImage(ref string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if _, err := dm.Cli.ImageRemove(ctx, ref, image.RemoveOptions{PruneChildren: true}); err != nil {
+		return fmt.Errorf("failed to remove image %s: %w", ref, err)
+	}
+	return nil
+}
+
+// PruneDanglingImages removes untagged images that no container uses and
+// returns how many were deleted and the space reclaimed.
+func (dm *DockerManager) PruneDanglingImages() (int, uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	report, err := dm.Cli.ImagesPrune(ctx, filters.NewArgs(filters.Arg("dangling", "true")))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to prune images: %w", err)
+	}
+	deleted := 0
+	for _, d := range report.ImagesDeleted {
+		if d.Deleted != "" {
+			deleted++
+		}
+	}
+	return deleted, report.SpaceReclaimed, nil
+}
+
+// PullImage pulls ref and returns the daemon's final status, e.g.
+// "Image is up to date for nginx:latest".
+func (dm *DockerManager) PullImage(ref string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	stream, err := dm.Cli.ImagePull(ctx, ref, image.PullOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to pull image %s: %w", ref, err)
+	}
+	defer stream.Close()
+
+	status, err := ReadPullStatus(stream)
+	if err != nil {
+		return "", fmt.Errorf("failed to pull image %s: %w", ref, err)
+	}
+	return status, nil
+}
+
+// ReadPullStatus consumes a pull progress stream and returns its last
+// "Status: ..." line. Errors are reported in-band by the daemon.
+func ReadPullStatus(r io.Reader) (string, error) {
+	var status string
+	decoder := json.NewDecoder(r)
+	for {
+		var message struct {
+			Status string `json:"status"`
+			Error  string `json:"error"`
+		}
+		if err := decoder.Decode(&message); err != nil {
+			if errors.Is(err, io.EOF) {
+				return status, nil
+			}
+			return status, err
+		}
+		if message.Error != "" {
+			return status, errors.New(message.Error)
+		}
+		if s, ok := strings.CutPrefix(message.Status, "Status: "); ok {
+			status = s
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/filters"
 	"github.com/moby/moby/client"
 )
 
@@ -32,6 +33,11 @@ func (dm *DockerManager) RefreshContainers() ([]Container, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	return dm.ListContainers(ctx)
+}
+
+// ListContainers inspects every container, running or not.
+func (dm *DockerManager) ListContainers(ctx context.Context) ([]Container, error) {
 	containers, err := dm.Cli.ContainerList(ctx, container.ListOptions{All: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list containers: %w", err)
@@ -41,76 +47,172 @@ func (dm *DockerManager) RefreshContainers() ([]Container, error) {
 	var skipped int
 
 	for _, cont := range containers {
-		containerJSON, err := dm.Cli.ContainerInspect(ctx, cont.ID)
+		c, err := dm.inspectContainer(ctx, cont)
 		if err != nil {
 			skipped++
 			continue
 		}
+		result = append(result, c)
+	}
 
-		projectName := cont.Labels["com.docker.compose.project"]
-		if projectName == "" {
-			projectName = "N/A"
-		}
+	if skipped > 0 {
+		return result, fmt.Errorf("%d container(s) could not be inspected", skipped)
+	}
 
-		containerName := "N/A"
-		if len(cont.Names) > 0 {
-			containerName = strings.TrimPrefix(cont.Names[0], "/")
-			containerName = strings.TrimPrefix(containerName, projectName+"-")
-		}
+	return result, nil
+}
 
-		status := strings.Split(cont.Status, " ")[0]
-		state := cont.State
+// GetContainer inspects one container. It reports false when the container
+// no longer exists.
+func (dm *DockerManager) GetContainer(ctx context.Context, containerID string) (Container, bool, error) {
+	containers, err := dm.Cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("id", containerID)),
+	})
+	if err != nil {
+		return Container{}, false, fmt.Errorf("failed to list containers: %w", err)
+	}
+	if len(containers) == 0 {
+		return Container{}, false, nil
+	}
+	c, err := dm.inspectContainer(ctx, containers[0])
+	if err != nil {
+		return Container{}, false, err
+	}
+	return c, true, nil
+}
 
-		var portsInfo []string
-		for _, p := range cont.Ports {
-			if p.PublicPort > 0 {
-				portsInfo = append(portsInfo, fmt.Sprintf("%d:%d/%s", p.PublicPort, p.PrivatePort, p.Type))
-			} else {
-				portsInfo = append(portsInfo, fmt.Sprintf("%d/%s", p.PrivatePort, p.Type))
-			}
-		}
-		portsStr := strings.Join(portsInfo, ", ")
-		if portsStr == "" {
-			portsStr = "N/A"
-		}
+func (dm *DockerManager) inspectContainer(ctx context.Context, cont container.Summary) (Container, error) {
+	containerJSON, err := dm.Cli.ContainerInspect(ctx, cont.ID)
+	if err != nil {
+		return Container{}, fmt.Errorf("failed to inspect container %s: %w", cont.ID, err)
+	}
+
+	projectName, containerName := containerNames(cont)
+
+	status := strings.Split(cont.Status, " ")[0]
+	state := cont.State
 
-		health := "N/A"
-		if containerJSON.State.Health != nil {
-			health = string(containerJSON.State.Health.Status)
+	var portsInfo []string
+	for _, p := range cont.Ports {
+		if p.PublicPort > 0 {
+			portsInfo = append(portsInfo, fmt.Sprintf("%d:%d/%s", p.PublicPort, p.PrivatePort, p.Type))
 		} else {
-			switch state {
-			case "running":
-				health = "running"
-			case "exited":
-				health = "exited"
-			case "paused":
-				health = "paused"
-			case "created":
-				health = "created"
-			}
+			portsInfo = append(portsInfo, fmt.Sprintf("%d/%s", p.PrivatePort, p.Type))
 		}
+	}
+	portsStr := strings.Join(portsInfo, ", ")
+	if portsStr == "" {
+		portsStr = "N/A"
+	}
 
-		c := Container{
-			ID:        cont.ID[:12],
-			Name:      containerName,
-			Status:    status,
-			State:     state,
-			CreatedAt: time.Unix(cont.Created, 0).Format(time.RFC3339),
-			Project:   projectName,
-			Image:     cont.Image,
-			Command:   cont.Command,
-			Ports:     cont.Ports,
-			PortsStr:  portsStr,
-			Health:    health,
+	health := "N/A"
+	if containerJSON.State.Health != nil {
+		health = string(containerJSON.State.Health.Status)
+	} else {
+		switch state {
+		case "running":
+			health = "running"
+		case "exited":
+			health = "exited"
+		case "paused":
+			health = "paused"
+		case "created":
+			health = "created"
 		}
-		result = append(result, c)
 	}
 
-	if skipped > 0 {
-		return result, fmt.Errorf("%d container(s) could not be inspected", skipped)
+	c := Container{
+		ID:        cont.ID[:12],
+		Name:      containerName,
+		Status:    status,
+		State:     state,
+		CreatedAt: time.Unix(cont.Created, 0).Format(time.RFC3339),
+		Project:   projectName,
+		Image:     cont.Image,
+		Command:   cont.Command,
+		Ports:     cont.Ports,
+		PortsStr:  portsStr,
+		Health:    health,
 	}
+	setRunState(&c, containerJSON)
+	return c, nil
+}
 
-	return result, nil
+// setRunState copies the restart count and the outcome of the last run.
+func setRunState(c *Container, inspect container.InspectResponse) {
+	if inspect.ContainerJSONBase == nil {
+		return
+	}
+	c.RestartCount = inspect.RestartCount
+	if inspect.State == nil {
+		return
+	}
+	c.ExitCode = inspect.State.ExitCode
+	c.OOMKilled = inspect.State.OOMKilled
+	if started, err := time.Parse(time.RFC3339Nano, inspect.State.StartedAt); err == nil {
+		c.StartedAt = started
+	}
+}
+
+// NewHealthInfo converts the health state of an inspected container. It
+// returns nil for containers without a health check.
+func NewHealthInfo(health *container.Health) *HealthInfo {
+	if health == nil {
+		return nil
+	}
+	info := &HealthInfo{
+		Status:        string(health.Status),
+		FailingStreak: health.FailingStreak,
+	}
+	for _, result := range health.Log {
+		if result == nil {
+			continue
+		}
+		info.Log = append(info.Log, HealthCheckResult{
+			Start:    result.Start,
+			End:      result.End,
+			ExitCode: result.ExitCode,
+			Output:   strings.TrimSpace(result.Output),
+		})
+	}
+	if n := len(info.Log); n > 0 {
+		info.LastCheck = info.Log[n-1].End
+		info.Output = info.Log[n-1].Output
+	}
+	return info
+}
+
+// ContainerNames maps the full ID of every container, running or not, to
+// its display name. Unlike ListContainers it does not inspect each
+// container, so it is cheap enough to call on every process refresh.
+func (dm *DockerManager) ContainerNames(ctx context.Context) (map[string]string, error) {
+	containers, err := dm.Cli.ContainerList(ctx, container.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	names := make(map[string]string, len(containers))
+	for _, cont := range containers {
+		_, names[cont.ID] = containerNames(cont)
+	}
+	return names, nil
+}
+
+// containerNames returns the compose project of a container and its name
+// without the leading slash and project prefix.
+func containerNames(cont container.Summary) (project, name string) {
+	project = cont.Labels["com.docker.compose.project"]
+	if project == "" {
+		project = NoProject
+	}
+
+	name = "N/A"
+	if len(cont.Names) > 0 {
+		name = strings.TrimPrefix(cont.Names[0], "/")
+		name = strings.TrimPrefix(name, project+"-")
+	}
+	return project, name
 }
 
 func (dm *DockerManager) GetContainerDetails(containerID string) (*ContainerDetails, error) {
@@ -235,6 +337,20 @@ func (dm *DockerManager) GetContainerDetails(containerID string) (*ContainerDeta
 		}
 	}
 
+	var mounts []MountInfo
+	for _, m := range containerJSON.Mounts {
+		source := m.Source
+		if m.Type == "volume" && m.Name != "" {
+			source = m.Name
+		}
+		mounts = append(mounts, MountInfo{
+			Source:      source,
+			Destination: m.Destination,
+			Type:        string(m.Type),
+			ReadOnly:    !m.RW,
+		})
+	}
+
 	createdAt := containerJSON.Created
 	if parsedTime, err := time.Parse(time.RFC3339Nano, containerJSON.Created); err == nil {
 		createdAt = parsedTime.Format("2006-01-02 15:04:05")
@@ -264,12 +380,15 @@ func (dm *DockerManager) GetContainerDetails(containerID string) (*ContainerDeta
 		IPAddress:       strings.Join(ipAddresses, ", "),
 		Gateway:         strings.Join(gateways, ", "),
 		HealthCheck:     healthCheck,
+		Health:          NewHealthInfo(containerJSON.State.Health),
 		Uptime:          uptime,
 		Ports:           ports,
+		Mounts:          mounts,
 		NetworkSettings: containerJSON.NetworkSettings,
 		HostConfig:      containerJSON.HostConfig,
 		State:           &containerJSON.State,
 	}
+	setRunState(&details.Container, containerJSON)
 
 	return details, nil
 }
@@ -0,0 +1,273 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+)
+
+// defaultCPUPeriod is the CFS period Docker uses when none is set, in
+// microseconds.
+const defaultCPUPeriod = 100000
+
+// ContainerLimits are the resource limits and restart policy that can be
+// changed on a running container. Zero means unlimited, except for
+// MemorySwap where -1 allows unlimited swap and 0 leaves it at twice Memory.
+// The update API reads 0 as "unchanged", so only the CPU quota and the PIDs
+// limit can be lifted again once set; see ValidateChange.
+type ContainerLimits struct {
+	CPUShares     int64
+	CPUQuota      int64 // microseconds per CPUPeriod
+	CPUPeriod     int64
+	Memory        int64
+	MemorySwap    int64
+	PidsLimit     int64
+	RestartPolicy container.RestartPolicy
+
+	// NanoCPUs is set for containers started with --cpus. Docker refuses to
+	// mix it with a CFS quota, so the quota is then applied as NanoCPUs.
+	NanoCPUs bool
+}
+
+// LimitsFromHostConfig reads the current limits of an inspected container.
+func LimitsFromHostConfig(hc *container.HostConfig) ContainerLimits {
+	if hc == nil {
+		return ContainerLimits{CPUPeriod: defaultCPUPeriod}
+	}
+	limits := ContainerLimits{
+		CPUShares:     hc.CPUShares,
+		CPUQuota:      hc.CPUQuota,
+		CPUPeriod:     hc.CPUPeriod,
+		Memory:        hc.Memory,
+		MemorySwap:    hc.MemorySwap,
+		RestartPolicy: hc.RestartPolicy,
+	}
+	if limits.CPUPeriod == 0 {
+		limits.CPUPeriod = defaultCPUPeriod
+	}
+	if limits.CPUQuota < 0 { // lifted by a previous update
+		limits.CPUQuota = 0
+	}
+	if hc.NanoCPUs > 0 {
+		limits.NanoCPUs = true
+		limits.CPUQuota = hc.NanoCPUs * limits.CPUPeriod / 1e9
+	}
+	if hc.PidsLimit != nil && *hc.PidsLimit > 0 {
+		limits.PidsLimit = *hc.PidsLimit
+	}
+	if limits.RestartPolicy.Name == "" {
+		limits.RestartPolicy.Name = container.RestartPolicyDisabled
+	}
+	return limits
+}
+
+// UpdateConfig converts the limits for the Docker update API.
+func (l ContainerLimits) UpdateConfig() container.UpdateConfig {
+	// -1 lifts the quota and the PIDs limit; 0 would leave them unchanged.
+	pids := l.PidsLimit
+	if pids == 0 {
+		pids = -1
+	}
+	config := container.UpdateConfig{
+		Resources: container.Resources{
+			CPUShares:  l.CPUShares,
+			Memory:     l.Memory,
+			MemorySwap: l.MemorySwap,
+			PidsLimit:  &pids,
+		},
+		RestartPolicy: l.RestartPolicy,
+	}
+	if l.NanoCPUs {
+		config.NanoCPUs = l.CPUQuota * 1e9 / l.CPUPeriod
+	} else {
+		config.CPUQuota = l.CPUQuota
+		if config.CPUQuota == 0 {
+			config.CPUQuota = -1
+		}
+		config.CPUPeriod = l.CPUPeriod
+	}
+	return config
+}
+
+// Validate checks the limits before they are sent to the daemon.
+func (l ContainerLimits) Validate() error {
+	switch {
+	case l.CPUShares < 0:
+		return errors.New("CPU shares cannot be negative")
+	case l.CPUShares > 0 && l.CPUShares < 2:
+		return errors.New("CPU shares must be at least 2")
+	case l.CPUQuota < 0:
+		return errors.New("CPU quota cannot be negative")
+	case l.CPUQuota > 0 && l.CPUQuota < 1000:
+		return errors.New("CPU quota must be at least 1000µs")
+	case l.CPUPeriod < 1000 || l.CPUPeriod > 1000000:
+		return errors.New("CPU period must be between 1000µs and 1s")
+	case l.Memory < 0:
+		return errors.New("memory limit cannot be negative")
+	case l.MemorySwap < -1:
+		return errors.New("memory+swap limit must be -1 (unlimited), 0 or a size")
+	case l.MemorySwap > 0 && l.MemorySwap < l.Memory:
+		return errors.New("memory+swap limit must not be smaller than the memory limit")
+	case l.MemorySwap > 0 && l.Memory == 0:
+		return errors.New("memory+swap limit requires a memory limit")
+	case l.PidsLimit < 0:
+		return errors.New("PIDs limit cannot be negative")
+	}
+	return container.ValidateRestartPolicy(l.RestartPolicy)
+}
+
+// ValidateChange checks the limits replacing current ones. The daemon
+// ignores 0 for the memory limits, the CPU shares and a --cpus limit and has
+// no other value to remove them, so setting them back to 0 would silently
+// change nothing.
+func (l ContainerLimits) ValidateChange(current ContainerLimits) error {
+	if err := l.Validate(); err != nil {
+		return err
+	}
+	switch {
+	case current.Memory > 0 && l.Memory == 0:
+		return errors.New("the memory limit of a running container can be changed but not removed; recreate the container without it")
+	case current.MemorySwap != 0 && l.MemorySwap == 0:
+		return errors.New("the memory+swap limit cannot be reset to its default; use -1 for unlimited swap")
+	case current.CPUShares > 0 && l.CPUShares == 0:
+		return errors.New("CPU shares cannot be removed; use 1024, the default weight")
+	case l.NanoCPUs && current.CPUQuota > 0 && l.CPUQuota == 0:
+		return errors.New("a CPU limit set with --cpus can be changed but not removed; recreate the container without it")
+	}
+	return nil
+}
+
+// GetContainerLimits returns the current limits of a container.
+func (dm *DockerManager) GetContainerLimits(containerID string) (ContainerLimits, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	inspect, err := dm.Cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return ContainerLimits{}, fmt.Errorf("failed to inspect container %s: %w", containerID, err)
+	}
+	if inspect.ContainerJSONBase == nil {
+		return ContainerLimits{}, fmt.Errorf("no host configuration for container %s", containerID)
+	}
+	return LimitsFromHostConfig(inspect.HostConfig), nil
+}
+
+// UpdateContainerLimits applies new limits to a container and returns the
+// limits the daemon reports afterwards, along with its warnings.
+func (dm *DockerManager) UpdateContainerLimits(containerID string, limits ContainerLimits) (ContainerLimits, []string, error) {
+	current, err := dm.GetContainerLimits(containerID)
+	if err != nil {
+		return ContainerLimits{}, nil, err
+	}
+	if err := limits.ValidateChange(current); err != nil {
+		return ContainerLimits{}, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := dm.Cli.ContainerUpdate(ctx, containerID, limits.UpdateConfig())
+	if err != nil {
+		return ContainerLimits{}, nil, fmt.Errorf("failed to update container %s: %w", containerID, err)
+	}
+
+	applied, err := dm.GetContainerLimits(containerID)
+	if err != nil {
+		return ContainerLimits{}, resp.Warnings, err
+	}
+	return applied, resp.Warnings, nil
+}
+
+// ParseMemoryLimit parses sizes such as "512m", "1.5g" or "1048576" (bytes)
+// with binary multiples, as the docker CLI does. "0" and "" mean unlimited
+// and "-1" is passed through for the memory+swap limit.
+func ParseMemoryLimit(s string) (int64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch s {
+	case "", "0", "unlimited":
+		return 0, nil
+	case "-1":
+		return -1, nil
+	}
+
+	s = strings.TrimSuffix(strings.TrimSuffix(s, "b"), "i")
+	multiplier := int64(1)
+	if n := len(s); n > 0 {
+		switch s[n-1] {
+		case 'k':
+			multiplier = 1 << 10
+		case 'm':
+			multiplier = 1 << 20
+		case 'g':
+			multiplier = 1 << 30
+		case 't':
+			multiplier = 1 << 40
+		}
+		if multiplier > 1 {
+			s = s[:n-1]
+		}
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return int64(value * float64(multiplier)), nil
+}
+
+// FormatMemoryLimit is the inverse of ParseMemoryLimit, using the largest
+// unit that divides the size exactly.
+func FormatMemoryLimit(size int64) string {
+	switch {
+	case size == 0:
+		return "0"
+	case size < 0:
+		return "-1"
+	}
+	for _, unit := range []struct {
+		suffix string
+		size   int64
+	}{{"t", 1 << 40}, {"g", 1 << 30}, {"m", 1 << 20}, {"k", 1 << 10}} {
+		if size%unit.size == 0 {
+			return strconv.FormatInt(size/unit.size, 10) + unit.suffix
+		}
+	}
+	return strconv.FormatInt(size, 10)
+}
+
+// ParseRestartPolicy parses "no", "always", "unless-stopped" or
+// "on-failure[:max-retries]".
+func ParseRestartPolicy(s string) (container.RestartPolicy, error) {
+	name, retries, hasRetries := strings.Cut(strings.TrimSpace(s), ":")
+	policy := container.RestartPolicy{Name: container.RestartPolicyMode(name)}
+	if name == "" {
+		policy.Name = container.RestartPolicyDisabled
+	}
+	if hasRetries {
+		count, err := strconv.Atoi(retries)
+		if err != nil {
+			return container.RestartPolicy{}, fmt.Errorf("invalid maximum retry count %q", retries)
+		}
+		policy.MaximumRetryCount = count
+	}
+	if err := container.ValidateRestartPolicy(policy); err != nil {
+		return container.RestartPolicy{}, err
+	}
+	return policy, nil
+}
+
+// FormatRestartPolicy is the inverse of ParseRestartPolicy.
+func FormatRestartPolicy(policy container.RestartPolicy) string {
+	if policy.Name == "" {
+		return string(container.RestartPolicyDisabled)
+	}
+	if policy.Name == container.RestartPolicyOnFailure && policy.MaximumRetryCount > 0 {
+		return fmt.Sprintf("%s:%d", policy.Name, policy.MaximumRetryCount)
+	}
+	return string(policy.Name)
+}
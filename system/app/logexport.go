@@ -0,0 +1,131 @@
+package app
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// LogExportDirectory returns where exported logs are written, under the
+// server-pulse data directory.
+func LogExportDirectory() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".server-pulse", "logs")
+}
+
+// LogExportName returns the file name of logs of a container exported at a
+// given time, such as web-1_20240501-100000.log.gz.
+func LogExportName(name string, at time.Time, compress bool) string {
+	name = strings.Trim(strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_', r == '-':
+			return r
+		}
+		return '-'
+	}, name), "-.")
+	if name == "" {
+		name = "logs"
+	}
+	filename := name + "_" + at.Format("20060102-150405") + ".log"
+	if compress {
+		filename += ".gz"
+	}
+	return filename
+}
+
+// FormatExportLine renders a log line for a file: the timestamp as printed by
+// "docker logs --timestamps", the stream, the service of merged project logs
+// and the text.
+func FormatExportLine(line LogLine) string {
+	var b strings.Builder
+	if !line.Time.IsZero() {
+		b.WriteString(line.Time.Format(time.RFC3339Nano) + " ")
+	}
+	if line.Stderr {
+		b.WriteString("stderr ")
+	} else {
+		b.WriteString("stdout ")
+	}
+	if line.Service != "" {
+		b.WriteString(line.Service + " | ")
+	}
+	b.WriteString(line.Text)
+	return b.String()
+}
+
+// LogFile is a file logs are exported to, gzip-compressed when its name ends
+// in .gz. Lines are buffered until Close.
+type LogFile struct {
+	Path  string
+	Lines int
+
+	file *os.File
+	gz   *gzip.Writer
+	w    *bufio.Writer
+}
+
+// CreateLogFile creates the export file of a container's logs in dir.
+func CreateLogFile(dir, name string, compress bool) (*LogFile, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	path := filepath.Join(dir, LogExportName(name, time.Now(), compress))
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	f := &LogFile{Path: path, file: file}
+	var w io.Writer = file
+	if compress {
+		f.gz = gzip.NewWriter(file)
+		w = f.gz
+	}
+	f.w = bufio.NewWriter(w)
+	return f, nil
+}
+
+func (f *LogFile) WriteLine(line LogLine) error {
+	if _, err := f.w.WriteString(FormatExportLine(line) + "\n"); err != nil {
+		return fmt.Errorf("failed to write %s: %w", f.Path, err)
+	}
+	f.Lines++
+	return nil
+}
+
+// Close flushes the buffered lines and closes the file.
+func (f *LogFile) Close() error {
+	err := f.w.Flush()
+	if f.gz != nil {
+		if gzErr := f.gz.Close(); err == nil {
+			err = gzErr
+		}
+	}
+	if closeErr := f.file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", f.Path, err)
+	}
+	return nil
+}
+
+// ExportLogs writes log lines to a new file in dir and returns its path.
+func ExportLogs(dir, name string, lines []LogLine, compress bool) (string, error) {
+	f, err := CreateLogFile(dir, name, compress)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range lines {
+		if err := f.WriteLine(line); err != nil {
+			f.Close()
+			return "", err
+		}
+	}
+	return f.Path, f.Close()
+}
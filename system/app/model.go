@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/moby/moby/api/types/container"
+	eventtypes "github.com/moby/moby/api/types/events"
 	"github.com/moby/moby/client"
 )
 
@@ -24,6 +25,46 @@ type Container struct {
 	Health    string
 	Ports     []container.Port
 	PortsStr  string
+
+	RestartCount int // restarts by the restart policy since the last manual start
+	ExitCode     int // of the last run
+	OOMKilled    bool
+	StartedAt    time.Time
+}
+
+// A container that died at least RestartLoopCount times within the last
+// RestartLoopWindow is considered restart-looping.
+const (
+	RestartLoopCount  = 3
+	RestartLoopWindow = 10 * time.Minute
+)
+
+// RestartLooping reports whether the restart policy keeps restarting the
+// container, i.e. it keeps crashing shortly after starting. events is the
+// container event history covering the last RestartLoopWindow, or nil when
+// it is not watched. RestartCount covers the container's whole life, so
+// without events the current run must also have started recently after a
+// failed one.
+func (c Container) RestartLooping(now time.Time, events []ContainerEvent) bool {
+	if c.State == "restarting" {
+		return true
+	}
+	if events != nil {
+		return c.RecentDeaths(now, events) >= RestartLoopCount
+	}
+	return c.RestartCount >= RestartLoopCount && c.ExitCode != 0 && now.Sub(c.StartedAt) < RestartLoopWindow
+}
+
+// RecentDeaths counts the die events of the container within
+// RestartLoopWindow before now.
+func (c Container) RecentDeaths(now time.Time, events []ContainerEvent) int {
+	count := 0
+	for _, e := range events {
+		if e.ContainerID == c.ID && e.Action == string(eventtypes.ActionDie) && now.Sub(e.Time) < RestartLoopWindow {
+			count++
+		}
+	}
+	return count
 }
 
 type PortInfo struct {
@@ -52,8 +93,10 @@ type ContainerDetails struct {
 	IPAddress       string
 	Gateway         string
 	HealthCheck     string
+	Health          *HealthInfo // nil without a health check
 	Uptime          string
 	Ports           []PortInfo
+	Mounts          []MountInfo
 	NetworkSettings any
 	HostConfig      any
 	State           any
@@ -64,6 +107,14 @@ type HealthInfo struct {
 	FailingStreak int
 	LastCheck     time.Time
 	Output        string
+	Log           []HealthCheckResult // the last few checks, oldest first
+}
+
+type HealthCheckResult struct {
+	Start    time.Time
+	End      time.Time
+	ExitCode int
+	Output   string
 }
 
 type MountInfo struct {
@@ -74,17 +125,21 @@ type MountInfo struct {
 }
 
 type ContainerMsg []Container
+
+// ContainerNamesMsg maps full container IDs to display names, used to label
+// processes that run inside containers.
+type ContainerNamesMsg map[string]string
 type ContainerDetailsMsg ContainerDetails
 
 type ContainerLogsMsg struct {
 	ContainerID string
-	Logs        string
+	Lines       []LogLine
 	Error       error
 }
 
 type ContainerLogsStreamMsg struct {
 	ContainerID string
-	LogChan     chan string
+	LogChan     chan LogLine
 	CancelFunc  context.CancelFunc
 }
 
@@ -94,7 +149,14 @@ type ContainerLogsStopMsg struct {
 
 type ContainerLogLineMsg struct {
 	ContainerID string
-	Line        string
+	Line        LogLine
+}
+
+// LogsExportedMsg reports container logs written to a file.
+type LogsExportedMsg struct {
+	Path  string
+	Lines int
+	Error error
 }
 
 type ContainerOperationMsg struct {
@@ -104,6 +166,113 @@ type ContainerOperationMsg struct {
 	Error       error
 }
 
+// ContainerLimitsMsg carries the current limits of a container, loaded for
+// editing.
+type ContainerLimitsMsg struct {
+	ContainerID string
+	Limits      ContainerLimits
+	Error       error
+}
+
+// ContainerLimitsUpdatedMsg reports the limits in effect after an update.
+type ContainerLimitsUpdatedMsg struct {
+	ContainerID string
+	Limits      ContainerLimits
+	Warnings    []string
+	Error       error
+}
+
+// ContainerDiffMsg carries the filesystem changes of a container.
+type ContainerDiffMsg struct {
+	ContainerID string
+	Changes     []FileChange
+	Error       error
+}
+
+// ContainerDirMsg carries a directory listing of a container.
+type ContainerDirMsg struct {
+	ContainerID string
+	Listing     DirListing
+	Error       error
+}
+
+// ContainerCopyMsg reports a file or directory copied out of a container.
+type ContainerCopyMsg struct {
+	ContainerID string
+	Source      string
+	Result      CopyResult
+	Error       error
+}
+
+// ExecStartedMsg carries the output of a command started in a container.
+// ExecID is empty when the command could not be started.
+type ExecStartedMsg struct {
+	ContainerID string
+	ExecID      string
+	Lines       <-chan ExecLine
+	Result      <-chan ExecResult
+	CancelFunc  context.CancelFunc
+	Error       error
+}
+
+// ExecOutputMsg carries the lines a command printed since the last message.
+type ExecOutputMsg struct {
+	ExecID string
+	Lines  []ExecLine
+}
+
+// ExecDoneMsg reports how a command run in a container ended.
+type ExecDoneMsg struct {
+	ExecID string
+	Result ExecResult
+}
+
+// ContainerUpdateMsg carries one container inspected again after an event.
+// Found is false when the container no longer exists.
+type ContainerUpdateMsg struct {
+	ID        string
+	Container Container
+	Found     bool
+}
+
+// EventsStreamMsg carries a new subscription to container events.
+type EventsStreamMsg struct {
+	Events     <-chan ContainerEvent
+	Errors     <-chan error
+	CancelFunc context.CancelFunc
+}
+
+type ContainerEventMsg ContainerEvent
+
+// EventsStopMsg reports that the events subscription ended.
+type EventsStopMsg struct {
+	Error error
+}
+
+// ContainerStatsSampleMsg maps short container IDs to one stats sample,
+// used for the per-project totals of the Containers view.
+type ContainerStatsSampleMsg map[string]ContainerStats
+
+type ImagesMsg []Image
+
+// VolumesMsg carries the volume list. HasSizes is false when sizes were not
+// measured and the previous ones should be kept.
+type VolumesMsg struct {
+	Volumes  []Volume
+	HasSizes bool
+}
+
+type NetworksMsg []Network
+
+// ResourceOperationMsg reports the outcome of an operation on an image,
+// volume or network, e.g. "image_pull", "volume_prune" or "network_remove".
+type ResourceOperationMsg struct {
+	Ref       string
+	Operation string
+	Detail    string
+	Error     error
+}
+
 type ContainerStatusMsg struct {
 	ContainerID string
 	Status      string
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/filters"
+	"github.com/moby/moby/api/types/network"
+)
+
+// Network is a Docker network and the containers attached to it.
+type Network struct {
+	ID        string // short ID
+	Name      string
+	Driver    string
+	Scope     string
+	Internal  bool
+	Subnets   []string
+	Gateways  []string
+	Endpoints []NetworkEndpoint
+}
+
+// NetworkEndpoint is a container attached to a network. Stopped containers
+// keep their attachment but have no address.
+type NetworkEndpoint struct {
+	Container string
+	IPv4      string // with prefix length, e.g. 172.18.0.2/16
+	IPv6      string
+}
+
+// Builtin reports whether the network is one of the predefined networks
+// the daemon does not allow removing.
+func (n Network) Builtin() bool {
+	switch n.Name {
+	case network.NetworkBridge, network.NetworkHost, network.NetworkNone:
+		return true
+	}
+	return false
+}
+
+func (n Network) InUse() bool {
+	return len(n.Endpoints) > 0
+}
+
+// ListNetworks returns every network with the containers attached to it.
+func (dm *DockerManager) ListNetworks(ctx context.Context) ([]Network, error) {
+	networks, err := dm.Cli.NetworkList(ctx, network.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+	containers, err := dm.Cli.ContainerList(ctx, container.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+	return BuildNetworks(networks, containers), nil
+}
+
+// BuildNetworks attributes container endpoints to networks, sorted by name.
+// Network lists do not include endpoints, so they are taken from the
+// containers' network settings instead of inspecting every network.
+func BuildNetworks(networks []network.Summary, containers []container.Summary) []Network {
+	endpoints := make(map[string][]NetworkEndpoint)
+	for _, cont := range containers {
+		if cont.NetworkSettings == nil {
+			continue
+		}
+		_, name := containerNames(cont)
+		for _, settings := range cont.NetworkSettings.Networks {
+			if settings == nil {
+				continue
+			}
+			endpoint := NetworkEndpoint{Container: name}
+			if settings.IPAddress != "" {
+				endpoint.IPv4 = fmt.Sprintf("%s/%d", settings.IPAddress, settings.IPPrefixLen)
+			}
+			if settings.GlobalIPv6Address != "" {
+				endpoint.IPv6 = fmt.Sprintf("%s/%d", settings.GlobalIPv6Address, settings.GlobalIPv6PrefixLen)
+			}
+			endpoints[settings.NetworkID] = append(endpoints[settings.NetworkID], endpoint)
+		}
+	}
+
+	result := make([]Network, 0, len(networks))
+	for _, n := range networks {
+		attached := endpoints[n.ID]
+		sort.Slice(attached, func(i, j int) bool { return attached[i].Container < attached[j].Container })
+
+		net := Network{
+			ID:        n.ID[:min(12, len(n.ID))],
+			Name:      n.Name,
+			Driver:    n.Driver,
+			Scope:     n.Scope,
+			Internal:  n.Internal,
+			Endpoints: attached,
+		}
+		for _, cfg := range n.IPAM.Config {
+			if cfg.Subnet != "" {
+				net.Subnets = append(net.Subnets, cfg.Subnet)
+			}
+			if cfg.Gateway != "" {
+				net.Gateways = append(net.Gateways, cfg.Gateway)
+			}
+		}
+		result = append(result, net)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// RemoveNetwork removes a network. The daemon refuses networks that still
+// have containers attached.
+func (dm *DockerManager) RemoveNetwork(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := dm.Cli.NetworkRemove(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove network %s: %w", id, err)
+	}
+	return nil
+}
+
+// PruneNetworks removes every custom network without containers and
+// returns the names of the removed networks.
+func (dm *DockerManager) PruneNetworks() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	report, err := dm.Cli.NetworksPrune(ctx, filters.NewArgs())
+	if err != nil {
+		return nil, fmt.Errorf("failed to prune networks: %w", err)
+	}
+	return report.NetworksDeleted, nil
+}
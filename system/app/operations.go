@@ -1,17 +1,12 @@
 package app
 
 import (
-	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"os"
-	"os/exec"
-	"strings"
 	"time"
 
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/moby/moby/api/types/container"
 )
 
@@ -87,44 +82,9 @@ func (dm *DockerManager) DeleteContainer(containerID string, force bool) error {
 	return nil
 }
 
-func (dm *DockerManager) GetContainerLogs(containerID string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	options := container.LogsOptions{
-		ShowStdout: true,
-		ShowStderr: true,
-		Timestamps: false,
-		Follow:     false,
-		Tail:       "all",
-	}
-
-	logs, err := dm.Cli.ContainerLogs(ctx, containerID, options)
-	if err != nil {
-		return "", fmt.Errorf("failed to get logs for container %s: %w", containerID, err)
-	}
-	defer logs.Close()
-
-	var result strings.Builder
-	scanner := bufio.NewScanner(logs)
-
-	for scanner.Scan() {
-		line := scanner.Text()
-		if len(line) > 8 && (line[0] == 1 || line[0] == 2) {
-			line = line[8:]
-		}
-		result.WriteString(line)
-		result.WriteString("\n")
-	}
-
-	if err := scanner.Err(); err != nil {
-		return "", fmt.Errorf("error reading logs: %w", err)
-	}
-
-	return result.String(), nil
-}
-
-func (dm *DockerManager) StreamContainerLogs(containerID string) (chan string, context.CancelFunc, error) {
+// StreamContainerLogs follows the logs of a running container, starting
+// with the last 100 lines or, when since is set, the lines from then on.
+func (dm *DockerManager) StreamContainerLogs(containerID, since string) (chan LogLine, context.CancelFunc, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	containerJSON, err := dm.Cli.ContainerInspect(ctx, containerID)
@@ -141,10 +101,13 @@ func (dm *DockerManager) StreamContainerLogs(containerID string) (chan string, c
 	options := container.LogsOptions{
 		ShowStdout: true,
 		ShowStderr: true,
-		Timestamps: false,
+		Timestamps: true,
 		Follow:     true,
 		Tail:       "100",
 	}
+	if since != "" {
+		options.Since, options.Tail = since, "all"
+	}
 
 	logsReader, err := dm.Cli.ContainerLogs(ctx, containerID, options)
 	if err != nil {
@@ -152,42 +115,24 @@ func (dm *DockerManager) StreamContainerLogs(containerID string) (chan string, c
 		return nil, nil, fmt.Errorf("failed to stream logs: %w", err)
 	}
 
-	logChan := make(chan string, 50)
+	logChan := make(chan LogLine, 50)
+	tty := containerJSON.Config != nil && containerJSON.Config.Tty
 
 	go func() {
 		defer close(logChan)
 		defer logsReader.Close()
 
-		scanner := bufio.NewScanner(logsReader)
-
-		buf := make([]byte, 0, 64*1024)
-		scanner.Buffer(buf, 1024*1024)
-
-		for scanner.Scan() {
+		err := scanLines(logsReader, tty, func(stderr bool, text string) error {
 			select {
+			case logChan <- ParseLogLine(text, stderr):
+				return nil
 			case <-ctx.Done():
-				return
-			default:
-				line := scanner.Text()
-
-				cleanLine := line
-				if len(line) > 8 && (line[0] == 1 || line[0] == 2) {
-					cleanLine = line[8:]
-				}
-
-				select {
-				case logChan <- cleanLine:
-				case <-ctx.Done():
-					return
-				case <-time.After(1 * time.Second):
-					continue
-				}
+				return ctx.Err()
 			}
-		}
-
-		if err := scanner.Err(); err != nil && !strings.Contains(err.Error(), "context canceled") {
+		})
+		if err != nil && ctx.Err() == nil {
 			select {
-			case logChan <- fmt.Sprintf("ERROR: %v", err):
+			case logChan <- LogLine{Stderr: true, Text: fmt.Sprintf("ERROR: %v", err)}:
 			case <-ctx.Done():
 			}
 		}
@@ -196,33 +141,6 @@ func (dm *DockerManager) StreamContainerLogs(containerID string) (chan string, c
 	return logChan, cancel, nil
 }
 
-func (dm *DockerManager) StartLogsStreamCmd(containerID string) tea.Cmd {
-	return func() tea.Msg {
-		logChan, cancelFunc, err := dm.StreamContainerLogs(containerID)
-		if err != nil {
-			return ContainerLogsMsg{
-				ContainerID: containerID,
-				Logs:        "",
-				Error:       fmt.Errorf("streaming unavailable: %w", err),
-			}
-		}
-
-		return ContainerLogsStreamMsg{
-			ContainerID: containerID,
-			LogChan:     logChan,
-			CancelFunc:  cancelFunc,
-		}
-	}
-}
-
-func (dm *DockerManager) StopLogsStreamCmd(containerID string) tea.Cmd {
-	return func() tea.Msg {
-		return ContainerLogsStopMsg{
-			ContainerID: containerID,
-		}
-	}
-}
-
 func (dm *DockerManager) GetContainerStatus(containerID string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -288,7 +206,8 @@ func (dm *DockerManager) ToggleContainerPause(containerID string) error {
 	}
 }
 
-func isValidContainerID(id string) bool {
+// ValidContainerID reports whether id is a full or short container ID.
+func ValidContainerID(id string) bool {
 	if len(id) < 1 || len(id) > 64 {
 		return false
 	}
@@ -300,41 +219,6 @@ func isValidContainerID(id string) bool {
 	return true
 }
 
-func (dm *DockerManager) ExecInteractiveShellAlternative(containerID string) error {
-	if !isValidContainerID(containerID) {
-		return fmt.Errorf("invalid container ID: %s", containerID)
-	}
-
-	fmt.Print("\033[?1049l")
-	fmt.Print("\033[2J\033[H")
-
-	fmt.Println("Type 'exit' to return to Server-Pulse")
-
-	cmd := exec.Command("docker", "exec", "-it", containerID, "sh", "-c", "command -v bash >/dev/null 2>&1 && exec bash || exec sh")
-	cmd.Stdin = os.Stdin
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	err := cmd.Run()
-
-	dm.forceTerminalResetSimple()
-
-	if err != nil {
-		fmt.Printf("Shell session ended with error: %v\n", err)
-	}
-
-	// dm.waitSimple()
-
-	return err
-}
-
-func (dm *DockerManager) forceTerminalResetSimple() {
-	// Reset complet du terminal
-	fmt.Print("\033c") // Full terminal reset
-	time.Sleep(100 * time.Millisecond)
-	fmt.Print("\033[2J\033[H") // Clear and home
-}
-
 func (dm *DockerManager) GetContainerStatsStream(containerID string) (chan ContainerStatsMsg, context.CancelFunc, error) {
 	statsChan := make(chan ContainerStatsMsg, 1)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -461,3 +345,56 @@ func (dm *DockerManager) GetContainerStatsStream(containerID string) (chan Conta
 
 	return statsChan, cancel, nil
 }
+
+// GetContainerStats takes a single stats sample for a container. CPU usage is
+// computed against the pre-CPU sample the daemon includes in the response.
+func (dm *DockerManager) GetContainerStats(ctx context.Context, containerID string) (*ContainerStats, error) {
+	response, err := dm.Cli.ContainerStats(ctx, containerID, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get container stats: %w", err)
+	}
+	defer response.Body.Close()
+
+	var stats StatsJSON
+	if err := json.NewDecoder(response.Body).Decode(&stats); err != nil {
+		return nil, fmt.Errorf("failed to decode container stats: %w", err)
+	}
+
+	result := &ContainerStats{
+		MemoryUsage: stats.MemoryStats.Usage,
+		MemoryLimit: stats.MemoryStats.Limit,
+	}
+
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+	if cpuDelta > 0 && systemDelta > 0 {
+		numberCpus := float64(stats.CPUStats.OnlineCPUs)
+		if numberCpus == 0 {
+			numberCpus = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+		}
+		if numberCpus == 0 {
+			numberCpus = 1
+		}
+		result.CPUPercent = (cpuDelta / systemDelta) * numberCpus * 100.0
+	}
+
+	if stats.MemoryStats.Limit > 0 {
+		result.MemoryPercent = (float64(stats.MemoryStats.Usage) / float64(stats.MemoryStats.Limit)) * 100.0
+	}
+
+	for _, network := range stats.Networks {
+		result.NetworkRx += network.RxBytes
+		result.NetworkTx += network.TxBytes
+	}
+
+	for _, ioStat := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch ioStat.Op {
+		case "Read":
+			result.BlockRead += ioStat.Value
+		case "Write":
+			result.BlockWrite += ioStat.Value
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContainerRestartLooping(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	deaths := func(id string, ago ...time.Duration) []app.ContainerEvent {
+		events := []app.ContainerEvent{}
+		for _, d := range ago {
+			events = append(events,
+				app.ContainerEvent{Time: now.Add(-d), ContainerID: id, Action: "die", ExitCode: "1"},
+				app.ContainerEvent{Time: now.Add(-d + time.Second), ContainerID: id, Action: "start"})
+		}
+		return events
+	}
+	tests := []struct {
+		name      string
+		container app.Container
+		events    []app.ContainerEvent
+		expected  bool
+	}{
+		{"Restarting", app.Container{State: "restarting"}, nil, true},
+		{"Many restarts, just crashed", app.Container{State: "running", RestartCount: 5, ExitCode: 1, StartedAt: now.Add(-time.Minute)}, nil, true},
+		{"Many restarts, stable since", app.Container{State: "running", RestartCount: 5, ExitCode: 1, StartedAt: now.Add(-time.Hour)}, nil, false},
+		{"Old restarts, restarted cleanly", app.Container{State: "running", RestartCount: 5, StartedAt: now.Add(-time.Minute)}, nil, false},
+		{"Few restarts", app.Container{State: "running", RestartCount: 2, ExitCode: 1, StartedAt: now.Add(-time.Minute)}, nil, false},
+		{"Never restarted", app.Container{State: "exited", ExitCode: 1}, nil, false},
+		{"Recent deaths", app.Container{ID: "aaaaaaaaaaaa", State: "running", StartedAt: now.Add(-time.Minute)},
+			deaths("aaaaaaaaaaaa", 8*time.Minute, 4*time.Minute, time.Minute), true},
+		{"Old deaths, recent restart", app.Container{ID: "aaaaaaaaaaaa", State: "running", RestartCount: 5, ExitCode: 1, StartedAt: now.Add(-time.Minute)},
+			deaths("aaaaaaaaaaaa", 3*time.Hour, 2*time.Hour, time.Hour, time.Minute), false},
+		{"Deaths of another container", app.Container{ID: "aaaaaaaaaaaa", State: "running"},
+			deaths("bbbbbbbbbbbb", 3*time.Minute, 2*time.Minute, time.Minute), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.container.RestartLooping(now, tt.events))
+		})
+	}
+}
+
+func TestNewHealthInfo(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, app.NewHealthInfo(nil))
+
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	info := app.NewHealthInfo(&container.Health{
+		Status:        container.Unhealthy,
+		FailingStreak: 2,
+		Log: []*container.HealthcheckResult{
+			{Start: start, End: start.Add(time.Second), ExitCode: 0, Output: "ok\n"},
+			nil,
+			{Start: start.Add(30 * time.Second), End: start.Add(31 * time.Second), ExitCode: 1, Output: "connection refused\n"},
+		},
+	})
+
+	require.NotNil(t, info)
+	assert.Equal(t, "unhealthy", info.Status)
+	assert.Equal(t, 2, info.FailingStreak)
+	require.Len(t, info.Log, 2)
+	assert.Equal(t, "ok", info.Log[0].Output)
+	assert.Equal(t, 1, info.Log[1].ExitCode)
+	assert.Equal(t, start.Add(31*time.Second), info.LastCheck)
+	assert.Equal(t, "connection refused", info.Output)
+}
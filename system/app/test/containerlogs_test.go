@@ -0,0 +1,191 @@
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/moby/moby/api/pkg/stdcopy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogLine(t *testing.T) {
+	t.Parallel()
+
+	line := app.ParseLogLine(`2024-05-01T10:00:00.123456789Z {"level":"warn","msg":"slow"}`, true)
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 123456789, time.UTC), line.Time)
+	assert.Equal(t, `{"level":"warn","msg":"slow"}`, line.Text)
+	assert.Equal(t, "warn", line.Level)
+	assert.True(t, line.Stderr)
+
+	line = app.ParseLogLine("no timestamp here", false)
+	assert.True(t, line.Time.IsZero())
+	assert.Equal(t, "no timestamp here", line.Text)
+	assert.Empty(t, line.Level)
+}
+
+func TestDetectLogLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"logrus", `{"level":"info","msg":"started"}`, "info"},
+		{"upper case", `{"severity":"ERROR","message":"boom"}`, "error"},
+		{"warning", `{"lvl":"warning"}`, "warn"},
+		{"ecs", `{"log.level":"debug"}`, "debug"},
+		{"python", `{"levelname":"CRITICAL"}`, "fatal"},
+		{"pino numeric", `{"level":50,"msg":"failed"}`, "error"},
+		{"pino trace", `{"level":10}`, "trace"},
+		{"surrounding spaces", `  {"level":"info"}  `, "info"},
+		{"unknown level", `{"level":"verbose"}`, ""},
+		{"no level", `{"msg":"hello"}`, ""},
+		{"invalid json", `{"level":"info"`, ""},
+		{"plain text", "ERROR something failed", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, app.DetectLogLevel(tt.text))
+		})
+	}
+}
+
+func TestLogLevelRank(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, -1, app.LogLevelRank(""))
+	assert.Less(t, app.LogLevelRank("debug"), app.LogLevelRank("info"))
+	assert.Less(t, app.LogLevelRank("warn"), app.LogLevelRank("error"))
+}
+
+func TestParseLogsWindow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected app.LogsWindow
+		text     string
+		wantErr  bool
+	}{
+		{"empty", "  ", app.LogsWindow{}, "all", false},
+		{"since duration", "30m", app.LogsWindow{Since: "30m"}, "since 30m", false},
+		{"range", "2024-05-01T10:00:00Z 2024-05-01T11:00:00Z", app.LogsWindow{Since: "2024-05-01T10:00:00Z", Until: "2024-05-01T11:00:00Z"}, "2024-05-01T10:00:00Z → 2024-05-01T11:00:00Z", false},
+		{"until only", "- 1h", app.LogsWindow{Until: "1h"}, "until 1h", false},
+		{"unix timestamp", "1714557600", app.LogsWindow{Since: "1714557600"}, "since 1714557600", false},
+		{"invalid start", "yesterday", app.LogsWindow{}, "", true},
+		{"invalid end", "1h soon", app.LogsWindow{}, "", true},
+		{"too many values", "1h 30m 10m", app.LogsWindow{}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := app.ParseLogsWindow(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, window)
+			assert.Equal(t, tt.text, window.String())
+		})
+	}
+}
+
+func TestReadLogs(t *testing.T) {
+	t.Parallel()
+
+	var stream bytes.Buffer
+	stdout := stdcopy.NewStdWriter(&stream, stdcopy.Stdout)
+	stderr := stdcopy.NewStdWriter(&stream, stdcopy.Stderr)
+	stdout.Write([]byte("2024-05-01T10:00:00Z started\n"))
+	stderr.Write([]byte("2024-05-01T10:00:01Z {\"level\":\"error\"}\n"))
+
+	lines, err := app.ReadLogs(&stream, false)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "started", lines[0].Text)
+	assert.False(t, lines[0].Stderr)
+	assert.True(t, lines[1].Stderr)
+	assert.Equal(t, "error", lines[1].Level)
+
+	lines, err = app.ReadLogs(strings.NewReader("2024-05-01T10:00:00Z a\r\n2024-05-01T10:00:01Z b"), true)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "a", lines[0].Text)
+	assert.Equal(t, "b", lines[1].Text)
+	assert.False(t, lines[1].Stderr)
+}
+
+func TestMergeLogLines(t *testing.T) {
+	t.Parallel()
+
+	at := func(sec int) time.Time { return time.Date(2024, 5, 1, 10, 0, sec, 0, time.UTC) }
+	// parse splits raw logs as returned with timestamps, one entry per line.
+	parse := func(raw ...string) []app.LogLine {
+		var lines []app.LogLine
+		for _, line := range raw {
+			lines = append(lines, app.ParseLogLine(line, false))
+		}
+		return lines
+	}
+	tests := []struct {
+		name     string
+		logs     map[string][]app.LogLine
+		expected []string
+	}{
+		{
+			name: "Continuation keeps the previous time",
+			logs: map[string][]app.LogLine{
+				"web-1": {{Time: at(0), Text: "listening"}, {Text: "continued"}, {Time: at(2), Text: "GET /"}},
+				"db-1":  {{Time: at(1), Text: "ready"}},
+			},
+			expected: []string{"web-1 listening", "web-1 continued", "db-1 ready", "web-1 GET /"},
+		},
+		{
+			name: "Interleaved by time",
+			logs: map[string][]app.LogLine{
+				"web-1": parse("2024-05-01T10:00:00.000000001Z listening on :80", "2024-05-01T10:00:02Z GET /"),
+				"db-1":  parse("2024-05-01T10:00:01Z ready to accept connections"),
+			},
+			expected: []string{"web-1 listening on :80", "db-1 ready to accept connections", "web-1 GET /"},
+		},
+		{
+			name: "Equal timestamps ordered by service",
+			logs: map[string][]app.LogLine{
+				"worker-1": parse("2024-05-01T10:00:00Z b"),
+				"api-1":    parse("2024-05-01T10:00:00Z a"),
+			},
+			expected: []string{"api-1 a", "worker-1 b"},
+		},
+		{
+			name: "Continuation lines keep their position",
+			logs: map[string][]app.LogLine{
+				"api-1": parse("2024-05-01T10:00:00Z panic: boom", "\tmain.go:12", "2024-05-01T10:00:03Z restarted"),
+				"db-1":  parse("2024-05-01T10:00:01Z checkpoint"),
+			},
+			expected: []string{"api-1 panic: boom", "api-1 \tmain.go:12", "db-1 checkpoint", "api-1 restarted"},
+		},
+		{
+			name:     "No logs",
+			logs:     map[string][]app.LogLine{"api-1": nil},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, line := range app.MergeLogLines(tt.logs) {
+				got = append(got, line.Service+" "+line.Text)
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
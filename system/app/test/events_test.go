@@ -0,0 +1,107 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/moby/moby/api/types/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEvent(t *testing.T) {
+	t.Parallel()
+
+	const id = "0123456789abcdef0123456789abcdef"
+	tests := []struct {
+		name     string
+		message  events.Message
+		expected app.ContainerEvent
+		ok       bool
+	}{
+		{
+			name: "Die with exit code",
+			message: events.Message{
+				Type:     events.ContainerEventType,
+				Action:   events.ActionDie,
+				Actor:    events.Actor{ID: id, Attributes: map[string]string{"name": "shop-web-1", "com.docker.compose.project": "shop", "exitCode": "137"}},
+				TimeNano: 1700000000000000123,
+			},
+			expected: app.ContainerEvent{
+				Time:        time.Unix(0, 1700000000000000123),
+				ContainerID: "0123456789ab",
+				Name:        "web-1",
+				Project:     "shop",
+				Action:      "die",
+				ExitCode:    "137",
+			},
+			ok: true,
+		},
+		{
+			name: "Health status",
+			message: events.Message{
+				Type:   events.ContainerEventType,
+				Action: events.ActionHealthStatusUnhealthy,
+				Actor:  events.Actor{ID: id, Attributes: map[string]string{"name": "redis"}},
+				Time:   1700000000,
+			},
+			expected: app.ContainerEvent{
+				Time:        time.Unix(1700000000, 0),
+				ContainerID: "0123456789ab",
+				Name:        "redis",
+				Project:     app.NoProject,
+				Action:      "health_status",
+				Health:      "unhealthy",
+			},
+			ok: true,
+		},
+		{
+			name: "Exec events are ignored",
+			message: events.Message{
+				Type:   events.ContainerEventType,
+				Action: events.Action("exec_start: /bin/sh -c healthcheck"),
+				Actor:  events.Actor{ID: id},
+			},
+		},
+		{
+			name: "Other types are ignored",
+			message: events.Message{
+				Type:   events.NetworkEventType,
+				Action: events.ActionCreate,
+				Actor:  events.Actor{ID: id},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, ok := app.ParseEvent(tt.message)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, event)
+		})
+	}
+}
+
+func TestContainerEventDetail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		event   app.ContainerEvent
+		detail  string
+		crashed bool
+	}{
+		{"Crash", app.ContainerEvent{Action: "die", ExitCode: "1"}, "exit code 1", true},
+		{"Clean exit", app.ContainerEvent{Action: "die", ExitCode: "0"}, "exit code 0", false},
+		{"OOM", app.ContainerEvent{Action: "oom"}, "out of memory", false},
+		{"Health", app.ContainerEvent{Action: "health_status", Health: "healthy"}, "healthy", false},
+		{"Start", app.ContainerEvent{Action: "start"}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.detail, tt.event.Detail())
+			assert.Equal(t, tt.crashed, tt.event.Crashed())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/moby/moby/api/pkg/stdcopy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+		wantErr  bool
+	}{
+		{"words", "ls -la  /app", []string{"ls", "-la", "/app"}, false},
+		{"single quotes", `sh -c 'ps aux | grep "node"'`, []string{"sh", "-c", `ps aux | grep "node"`}, false},
+		{"double quotes", `echo "a \"b\" \$HOME \n"`, []string{"echo", `a "b" $HOME \n`}, false},
+		{"backslash", `touch my\ file`, []string{"touch", "my file"}, false},
+		{"adjacent quotes", `echo a'b'"c"`, []string{"echo", "abc"}, false},
+		{"empty argument", `printf ''`, []string{"printf", ""}, false},
+		{"empty", "   ", nil, true},
+		{"unterminated single quote", "echo 'a", nil, true},
+		{"unterminated double quote", `echo "a`, nil, true},
+		{"trailing backslash", `echo a\`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := app.SplitCommand(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, args)
+		})
+	}
+}
+
+func TestDemuxExecOutput(t *testing.T) {
+	t.Parallel()
+
+	var stream bytes.Buffer
+	stdout := stdcopy.NewStdWriter(&stream, stdcopy.Stdout)
+	stderr := stdcopy.NewStdWriter(&stream, stdcopy.Stderr)
+	stdout.Write([]byte("first\r\nsec"))
+	stderr.Write([]byte("warning\n"))
+	stdout.Write([]byte("ond\nno newline"))
+
+	lines := make(chan app.ExecLine, 10)
+	require.NoError(t, app.DemuxExecOutput(context.Background(), &stream, lines))
+	close(lines)
+
+	var got []app.ExecLine
+	for line := range lines {
+		got = append(got, line)
+	}
+	assert.Equal(t, []app.ExecLine{
+		{Text: "first"},
+		{Stderr: true, Text: "warning"},
+		{Text: "second"},
+		{Text: "no newline"},
+	}, got)
+}
+
+func TestDemuxExecOutputSplitsLongLines(t *testing.T) {
+	t.Parallel()
+
+	var stream bytes.Buffer
+	stdcopy.NewStdWriter(&stream, stdcopy.Stdout).Write([]byte(strings.Repeat("x", 100*1024) + "\n"))
+
+	lines := make(chan app.ExecLine, 10)
+	require.NoError(t, app.DemuxExecOutput(context.Background(), &stream, lines))
+	close(lines)
+
+	total := 0
+	count := 0
+	for line := range lines {
+		assert.LessOrEqual(t, len(line.Text), 64*1024)
+		total += len(line.Text)
+		count++
+	}
+	assert.Equal(t, 2, count)
+	assert.Equal(t, 100*1024, total)
+}
+
+func TestDemuxExecOutputCanceled(t *testing.T) {
+	t.Parallel()
+
+	var stream bytes.Buffer
+	stdcopy.NewStdWriter(&stream, stdcopy.Stdout).Write([]byte("a\nb\n"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := app.DemuxExecOutput(ctx, &stream, make(chan app.ExecLine))
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,171 @@
+package test
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	body     string
+	linkname string
+}
+
+func buildTar(t *testing.T, entries []tarEntry) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Typeflag: e.typeflag, Linkname: e.linkname, Mode: 0o644, Size: int64(len(e.body))}
+		if e.typeflag == tar.TypeDir {
+			hdr.Mode = 0o755
+			hdr.Size = 0
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		if e.body != "" {
+			_, err := tw.Write([]byte(e.body))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	return &buf
+}
+
+func TestFileChanges(t *testing.T) {
+	t.Parallel()
+
+	changes := app.FileChanges([]container.FilesystemChange{
+		{Kind: container.ChangeModify, Path: "/var/log"},
+		{Kind: container.ChangeAdd, Path: "/var/log/app.log"},
+		{Kind: container.ChangeDelete, Path: "/etc/motd"},
+	})
+	assert.Equal(t, []app.FileChange{
+		{Kind: "deleted", Path: "/etc/motd"},
+		{Kind: "changed", Path: "/var/log"},
+		{Kind: "added", Path: "/var/log/app.log"},
+	}, changes)
+}
+
+func TestListArchiveDir(t *testing.T) {
+	t.Parallel()
+
+	archive := buildTar(t, []tarEntry{
+		{name: "etc/", typeflag: tar.TypeDir},
+		{name: "etc/nginx/", typeflag: tar.TypeDir},
+		{name: "etc/nginx/nginx.conf", typeflag: tar.TypeReg, body: "worker_processes 1;"},
+		{name: "etc/hosts", typeflag: tar.TypeReg, body: "127.0.0.1 localhost"},
+		{name: "etc/localtime", typeflag: tar.TypeSymlink, linkname: "/usr/share/zoneinfo/UTC"},
+	})
+
+	listing, err := app.ListArchiveDir(archive, "/etc", 100)
+	require.NoError(t, err)
+	assert.Equal(t, "/etc", listing.Path)
+	assert.False(t, listing.Truncated)
+	require.Len(t, listing.Entries, 3)
+
+	assert.Equal(t, "nginx", listing.Entries[0].Name)
+	assert.True(t, listing.Entries[0].IsDir())
+	assert.Equal(t, "/etc/hosts", listing.Entries[1].Path)
+	assert.Equal(t, int64(19), listing.Entries[1].Size)
+	assert.Equal(t, "/usr/share/zoneinfo/UTC", listing.Entries[2].LinkTarget)
+}
+
+func TestListArchiveDirTruncated(t *testing.T) {
+	t.Parallel()
+
+	archive := buildTar(t, []tarEntry{
+		{name: "/", typeflag: tar.TypeDir},
+		{name: "bin/", typeflag: tar.TypeDir},
+		{name: "bin/sh", typeflag: tar.TypeReg, body: "#!"},
+		{name: "etc/", typeflag: tar.TypeDir},
+	})
+
+	listing, err := app.ListArchiveDir(archive, "/", 3)
+	require.NoError(t, err)
+	assert.True(t, listing.Truncated)
+	require.Len(t, listing.Entries, 1)
+	assert.Equal(t, "/bin", listing.Entries[0].Path)
+}
+
+func TestExtractArchive(t *testing.T) {
+	t.Parallel()
+
+	dest := t.TempDir()
+	archive := buildTar(t, []tarEntry{
+		{name: "nginx/", typeflag: tar.TypeDir},
+		{name: "nginx/conf.d/", typeflag: tar.TypeDir},
+		{name: "nginx/conf.d/default.conf", typeflag: tar.TypeReg, body: "server {}"},
+		{name: "nginx/nginx.conf", typeflag: tar.TypeReg, body: "events {}"},
+		{name: "nginx/current", typeflag: tar.TypeSymlink, linkname: "conf.d"},
+	})
+
+	result, err := app.ExtractArchive(archive, dest)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Files)
+	assert.Equal(t, int64(18), result.Bytes)
+
+	root, err := filepath.EvalSymlinks(dest)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "nginx"), result.Dest)
+
+	data, err := os.ReadFile(filepath.Join(dest, "nginx", "conf.d", "default.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, "server {}", string(data))
+
+	link, err := os.Readlink(filepath.Join(dest, "nginx", "current"))
+	require.NoError(t, err)
+	assert.Equal(t, "conf.d", link)
+}
+
+func TestExtractArchiveStaysInDestination(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		entries []tarEntry
+	}{
+		{
+			name: "Through a symlink",
+			entries: []tarEntry{
+				{name: "escape", typeflag: tar.TypeSymlink, linkname: "/"},
+				{name: "escape/tmp/owned", typeflag: tar.TypeReg, body: "x"},
+			},
+		},
+		{
+			name: "Hard link outside",
+			entries: []tarEntry{
+				{name: "up", typeflag: tar.TypeSymlink, linkname: ".."},
+				{name: "passwd", typeflag: tar.TypeLink, linkname: "up/secret"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent := t.TempDir()
+			dest := filepath.Join(parent, "dest")
+			require.NoError(t, os.Mkdir(dest, 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(parent, "secret"), []byte("s"), 0o600))
+
+			_, err := app.ExtractArchive(buildTar(t, tt.entries), dest)
+			assert.ErrorContains(t, err, "points outside")
+		})
+	}
+
+	// ".." is resolved within the destination.
+	dest := t.TempDir()
+	_, err := app.ExtractArchive(buildTar(t, []tarEntry{
+		{name: "../../outside.txt", typeflag: tar.TypeReg, body: "x"},
+	}), dest)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dest, "outside.txt"))
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/image"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitRepoTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		repoTag    string
+		repository string
+		tag        string
+	}{
+		{"Simple", "nginx:1.27", "nginx", "1.27"},
+		{"Namespaced", "library/redis:latest", "library/redis", "latest"},
+		{"Registry port", "localhost:5000/app:v2", "localhost:5000/app", "v2"},
+		{"Registry port without tag", "localhost:5000/app", "localhost:5000/app", "latest"},
+		{"No tag", "alpine", "alpine", "latest"},
+		{"Dangling", "<none>:<none>", "<none>", "<none>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository, tag := app.SplitRepoTag(tt.repoTag)
+			assert.Equal(t, tt.repository, repository)
+			assert.Equal(t, tt.tag, tag)
+		})
+	}
+}
+
+func TestBuildImages(t *testing.T) {
+	t.Parallel()
+
+	images := []image.Summary{
+		{ID: "sha256:aaaaaaaaaaaa1111", RepoTags: []string{"nginx:1.27", "nginx:latest"}, Size: 200, Created: 1700000000},
+		{ID: "sha256:bbbbbbbbbbbb2222", Size: 500},
+		{ID: "sha256:cccccccccccc3333", RepoDigests: []string{"redis@sha256:dead"}, Size: 100},
+	}
+	containers := []container.Summary{
+		{ImageID: "sha256:aaaaaaaaaaaa1111", Names: []string{"/web"}},
+		{ImageID: "sha256:aaaaaaaaaaaa1111", Names: []string{"/shop-api"}, Labels: map[string]string{"com.docker.compose.project": "shop"}},
+	}
+
+	result := app.BuildImages(images, containers)
+	require.Len(t, result, 4)
+
+	assert.Equal(t, "bbbbbbbbbbbb", result[0].ID)
+	assert.True(t, result[0].Dangling())
+	assert.Equal(t, "bbbbbbbbbbbb", result[0].Ref())
+
+	assert.Equal(t, "nginx:1.27", result[1].Ref())
+	assert.Equal(t, "nginx:latest", result[2].Ref())
+	assert.Equal(t, []string{"api", "web"}, result[1].Containers)
+	assert.Equal(t, int64(1700000000), result[1].Created.Unix())
+
+	assert.Equal(t, "redis", result[3].Repository)
+	assert.False(t, result[3].Dangling())
+	assert.Equal(t, "cccccccccccc", result[3].Ref(), "digest-only images are referenced by ID")
+}
+
+func TestReadPullStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		stream   string
+		expected string
+		err      string
+	}{
+		{
+			name: "Up to date",
+			stream: `{"status":"Pulling from library/nginx","id":"latest"}
+{"status":"Digest: sha256:abc"}
+{"status":"Status: Image is up to date for nginx:latest"}`,
+			expected: "Image is up to date for nginx:latest",
+		},
+		{
+			name: "Newer image",
+			stream: `{"status":"Downloading","progressDetail":{"current":1,"total":2},"id":"a1"}
+{"status":"Status: Downloaded newer image for nginx:latest"}`,
+			expected: "Downloaded newer image for nginx:latest",
+		},
+		{
+			name:   "Error in stream",
+			stream: `{"status":"Pulling from library/nope"}` + "\n" + `{"errorDetail":{"message":"manifest unknown"},"error":"manifest unknown"}`,
+			err:    "manifest unknown",
+		},
+		{
+			name:   "Malformed stream",
+			stream: `{"status":`,
+			err:    "unexpected EOF",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := app.ReadPullStatus(strings.NewReader(tt.stream))
+			if tt.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, status)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemoryLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected int64
+		wantErr  bool
+	}{
+		{"Unlimited", "0", 0, false},
+		{"Empty", "", 0, false},
+		{"Unlimited swap", "-1", -1, false},
+		{"Bytes", "1048576", 1 << 20, false},
+		{"Megabytes", "512m", 512 << 20, false},
+		{"Gigabytes with suffix", "2GB", 2 << 30, false},
+		{"Binary suffix", "1GiB", 1 << 30, false},
+		{"Fraction", "1.5g", 3 << 29, false},
+		{"Garbage", "lots", 0, true},
+		{"Negative", "-2m", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, err := app.ParseMemoryLimit(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, size)
+		})
+	}
+}
+
+func TestFormatMemoryLimit(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0", app.FormatMemoryLimit(0))
+	assert.Equal(t, "-1", app.FormatMemoryLimit(-1))
+	assert.Equal(t, "512m", app.FormatMemoryLimit(512<<20))
+	assert.Equal(t, "1536m", app.FormatMemoryLimit(3<<29))
+	assert.Equal(t, "2g", app.FormatMemoryLimit(2<<30))
+	assert.Equal(t, "1000", app.FormatMemoryLimit(1000))
+}
+
+func TestParseRestartPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected container.RestartPolicy
+		wantErr  bool
+	}{
+		{"no", container.RestartPolicy{Name: container.RestartPolicyDisabled}, false},
+		{"", container.RestartPolicy{Name: container.RestartPolicyDisabled}, false},
+		{"unless-stopped", container.RestartPolicy{Name: container.RestartPolicyUnlessStopped}, false},
+		{"on-failure:5", container.RestartPolicy{Name: container.RestartPolicyOnFailure, MaximumRetryCount: 5}, false},
+		{"always:3", container.RestartPolicy{}, true},
+		{"on-failure:x", container.RestartPolicy{}, true},
+		{"sometimes", container.RestartPolicy{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			policy, err := app.ParseRestartPolicy(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, policy)
+
+			reparsed, err := app.ParseRestartPolicy(app.FormatRestartPolicy(policy))
+			require.NoError(t, err)
+			assert.Equal(t, policy, reparsed)
+		})
+	}
+}
+
+func TestContainerLimitsUpdateConfig(t *testing.T) {
+	t.Parallel()
+
+	pids := int64(200)
+	limits := app.LimitsFromHostConfig(&container.HostConfig{
+		RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyAlways},
+		Resources: container.Resources{
+			CPUShares: 512,
+			CPUQuota:  50000,
+			Memory:    256 << 20,
+			PidsLimit: &pids,
+		},
+	})
+	assert.Equal(t, int64(100000), limits.CPUPeriod)
+	assert.Equal(t, int64(200), limits.PidsLimit)
+	require.NoError(t, limits.Validate())
+
+	config := limits.UpdateConfig()
+	assert.Equal(t, int64(50000), config.CPUQuota)
+	assert.Equal(t, int64(100000), config.CPUPeriod)
+	assert.Zero(t, config.NanoCPUs)
+	require.NotNil(t, config.PidsLimit)
+	assert.Equal(t, int64(200), *config.PidsLimit)
+	assert.Equal(t, container.RestartPolicyAlways, config.RestartPolicy.Name)
+
+	lifted := app.LimitsFromHostConfig(&container.HostConfig{Resources: container.Resources{CPUQuota: -1}})
+	assert.Zero(t, lifted.CPUQuota)
+
+	// Containers started with --cpus keep their limit as NanoCPUs.
+	nano := app.LimitsFromHostConfig(&container.HostConfig{Resources: container.Resources{NanoCPUs: 1.5e9}})
+	assert.True(t, nano.NanoCPUs)
+	assert.Equal(t, int64(150000), nano.CPUQuota)
+	nano.CPUQuota = 200000
+	config = nano.UpdateConfig()
+	assert.Equal(t, int64(2e9), config.NanoCPUs)
+	assert.Zero(t, config.CPUQuota)
+	assert.Equal(t, container.RestartPolicyDisabled, config.RestartPolicy.Name)
+
+	// The update API reads 0 as "unchanged": lifting the quota and the PIDs
+	// limit is sent as -1.
+	limits.CPUQuota, limits.PidsLimit = 0, 0
+	config = limits.UpdateConfig()
+	assert.Equal(t, int64(-1), config.CPUQuota)
+	require.NotNil(t, config.PidsLimit)
+	assert.Equal(t, int64(-1), *config.PidsLimit)
+}
+
+func TestContainerLimitsValidateChange(t *testing.T) {
+	t.Parallel()
+
+	current := app.ContainerLimits{
+		CPUShares:     512,
+		CPUQuota:      50000,
+		CPUPeriod:     100000,
+		Memory:        512 << 20,
+		MemorySwap:    1 << 30,
+		PidsLimit:     100,
+		RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyDisabled},
+	}
+	tests := []struct {
+		name    string
+		modify  func(*app.ContainerLimits)
+		wantErr string
+	}{
+		{"Unchanged", func(*app.ContainerLimits) {}, ""},
+		{"Lift CPU quota", func(l *app.ContainerLimits) { l.CPUQuota = 0 }, ""},
+		{"Lift PIDs limit", func(l *app.ContainerLimits) { l.PidsLimit = 0 }, ""},
+		{"Unlimited swap", func(l *app.ContainerLimits) { l.MemorySwap = -1 }, ""},
+		{"Default CPU shares", func(l *app.ContainerLimits) { l.CPUShares = 1024 }, ""},
+		{"Remove memory limit", func(l *app.ContainerLimits) { l.Memory, l.MemorySwap = 0, -1 }, "memory limit"},
+		{"Reset swap", func(l *app.ContainerLimits) { l.MemorySwap = 0 }, "memory+swap"},
+		{"Remove CPU shares", func(l *app.ContainerLimits) { l.CPUShares = 0 }, "CPU shares"},
+		{"Remove --cpus", func(l *app.ContainerLimits) { l.NanoCPUs, l.CPUQuota = true, 0 }, "--cpus"},
+		{"Invalid", func(l *app.ContainerLimits) { l.PidsLimit = -5 }, "PIDs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits := current
+			tt.modify(&limits)
+			err := limits.ValidateChange(current)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	// Limits that were never set can stay at 0.
+	assert.NoError(t, app.ContainerLimits{CPUPeriod: 100000}.ValidateChange(app.ContainerLimits{CPUPeriod: 100000}))
+}
+
+func TestContainerLimitsValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := app.ContainerLimits{CPUPeriod: 100000, RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyDisabled}}
+	tests := []struct {
+		name    string
+		modify  func(*app.ContainerLimits)
+		wantErr bool
+	}{
+		{"Unlimited", func(*app.ContainerLimits) {}, false},
+		{"Swap below memory", func(l *app.ContainerLimits) { l.Memory, l.MemorySwap = 512<<20, 256<<20 }, true},
+		{"Swap without memory", func(l *app.ContainerLimits) { l.MemorySwap = 512 << 20 }, true},
+		{"Unlimited swap", func(l *app.ContainerLimits) { l.Memory, l.MemorySwap = 512<<20, -1 }, false},
+		{"Tiny quota", func(l *app.ContainerLimits) { l.CPUQuota = 10 }, true},
+		{"One CPU share", func(l *app.ContainerLimits) { l.CPUShares = 1 }, true},
+		{"Negative PIDs", func(l *app.ContainerLimits) { l.PidsLimit = -5 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits := valid
+			tt.modify(&limits)
+			if tt.wantErr {
+				assert.Error(t, limits.Validate())
+			} else {
+				assert.NoError(t, limits.Validate())
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package test
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogExportName(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		input    string
+		compress bool
+		expected string
+	}{
+		{"container", "web-1", false, "web-1_20240501-100000.log"},
+		{"compressed", "web-1", true, "web-1_20240501-100000.log.gz"},
+		{"unsafe characters", "/my app/db", false, "my-app-db_20240501-100000.log"},
+		{"empty", "..", false, "logs_20240501-100000.log"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, app.LogExportName(tt.input, at, tt.compress))
+		})
+	}
+}
+
+func TestFormatExportLine(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 5, 1, 10, 0, 0, 5, time.UTC)
+	assert.Equal(t, "2024-05-01T10:00:00.000000005Z stdout started",
+		app.FormatExportLine(app.LogLine{Time: at, Text: "started"}))
+	assert.Equal(t, "stderr db-1 | failed",
+		app.FormatExportLine(app.LogLine{Stderr: true, Service: "db-1", Text: "failed"}))
+}
+
+func TestExportLogs(t *testing.T) {
+	t.Parallel()
+
+	lines := []app.LogLine{{Text: "first"}, {Text: "second", Stderr: true}}
+	expected := "stdout first\nstderr second\n"
+
+	dir := filepath.Join(t.TempDir(), "logs")
+	path, err := app.ExportLogs(dir, "web-1", lines, false)
+	require.NoError(t, err)
+	assert.Equal(t, dir, filepath.Dir(path))
+	assert.True(t, strings.HasPrefix(filepath.Base(path), "web-1_"))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(data))
+
+	path, err = app.ExportLogs(dir, "web-1", lines, true)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(path, ".log.gz"))
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	require.NoError(t, err)
+	data, err = io.ReadAll(gz)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(data))
+}
+
+func TestLogFileCountsLines(t *testing.T) {
+	t.Parallel()
+
+	f, err := app.CreateLogFile(t.TempDir(), "web-1", false)
+	require.NoError(t, err)
+	for range 3 {
+		require.NoError(t, f.WriteLine(app.LogLine{Text: "line"}))
+	}
+	require.NoError(t, f.Close())
+	assert.Equal(t, 3, f.Lines)
+
+	data, err := os.ReadFile(f.Path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, strings.Count(string(data), "\n"))
+}
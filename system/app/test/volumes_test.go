@@ -0,0 +1,89 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/api/types/volume"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildVolumes(t *testing.T) {
+	t.Parallel()
+
+	volumes := []*volume.Volume{
+		{Name: "cache", Driver: "local"},
+		{Name: "db", Driver: "local", Mountpoint: "/var/lib/docker/volumes/db/_data"},
+		nil,
+		{Name: "logs", Driver: "local"},
+	}
+	sizes := map[string]int64{"db": 300, "logs": 300}
+	containers := []container.Summary{
+		{Names: []string{"/postgres"}, Mounts: []container.MountPoint{
+			{Type: "volume", Name: "db"},
+			{Type: "bind", Source: "/etc/postgres"},
+		}},
+		{Names: []string{"/backup"}, Mounts: []container.MountPoint{{Type: "volume", Name: "db"}}},
+	}
+
+	result := app.BuildVolumes(volumes, sizes, containers)
+	require.Len(t, result, 3)
+
+	assert.Equal(t, "db", result[0].Name)
+	assert.Equal(t, "/var/lib/docker/volumes/db/_data", result[0].Mountpoint)
+	assert.Equal(t, []string{"backup", "postgres"}, result[0].Containers)
+	assert.True(t, result[0].InUse())
+
+	assert.Equal(t, "logs", result[1].Name, "equal sizes are ordered by name")
+	assert.False(t, result[1].InUse())
+
+	assert.Equal(t, "cache", result[2].Name)
+	assert.Equal(t, int64(-1), result[2].Size, "unmeasured volumes have no size")
+}
+
+func TestBuildNetworks(t *testing.T) {
+	t.Parallel()
+
+	networks := []network.Summary{
+		{ID: "net-bridge-0123456789", Name: "bridge", Driver: "bridge"},
+		{ID: "net-shop-0123456789", Name: "shop_default", Driver: "bridge", IPAM: network.IPAM{
+			Config: []network.IPAMConfig{{Subnet: "172.18.0.0/16", Gateway: "172.18.0.1"}},
+		}},
+		{ID: "net-empty", Name: "empty", Driver: "bridge"},
+	}
+	containers := []container.Summary{
+		{Names: []string{"/shop-web"}, Labels: map[string]string{"com.docker.compose.project": "shop"},
+			NetworkSettings: &container.NetworkSettingsSummary{Networks: map[string]*network.EndpointSettings{
+				"shop_default": {NetworkID: "net-shop-0123456789", IPAddress: "172.18.0.3", IPPrefixLen: 16},
+			}}},
+		{Names: []string{"/shop-db"}, Labels: map[string]string{"com.docker.compose.project": "shop"},
+			NetworkSettings: &container.NetworkSettingsSummary{Networks: map[string]*network.EndpointSettings{
+				"shop_default": {NetworkID: "net-shop-0123456789"},
+			}}},
+		{Names: []string{"/standalone"}},
+	}
+
+	result := app.BuildNetworks(networks, containers)
+	require.Len(t, result, 3)
+
+	assert.Equal(t, "bridge", result[0].Name)
+	assert.True(t, result[0].Builtin())
+	assert.False(t, result[0].InUse())
+
+	assert.Equal(t, "empty", result[1].Name)
+	assert.Equal(t, "net-empty", result[1].ID)
+	assert.False(t, result[1].Builtin())
+
+	shop := result[2]
+	assert.Equal(t, "net-shop-012", shop.ID)
+	assert.Equal(t, []string{"172.18.0.0/16"}, shop.Subnets)
+	assert.Equal(t, []string{"172.18.0.1"}, shop.Gateways)
+	assert.True(t, shop.InUse())
+	assert.Equal(t, []app.NetworkEndpoint{
+		{Container: "db"},
+		{Container: "web", IPv4: "172.18.0.3/16"},
+	}, shop.Endpoints)
+}
@@ -0,0 +1,128 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/filters"
+	"github.com/moby/moby/api/types/system"
+	"github.com/moby/moby/api/types/volume"
+)
+
+// Volume is a Docker volume and the containers, running or not, that mount
+// it.
+type Volume struct {
+	Name       string
+	Driver     string
+	Mountpoint string
+	Size       int64 // bytes, -1 when not measured
+	Containers []string
+}
+
+func (v Volume) InUse() bool {
+	return len(v.Containers) > 0
+}
+
+// ListVolumes returns every volume. Measuring sizes walks each volume on
+// disk, so it is only done when withSizes is set; otherwise Size is -1.
+func (dm *DockerManager) ListVolumes(ctx context.Context, withSizes bool) ([]Volume, error) {
+	list, err := dm.Cli.VolumeList(ctx, volume.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	containers, err := dm.Cli.ContainerList(ctx, container.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	var sizes map[string]int64
+	if withSizes {
+		usage, err := dm.Cli.DiskUsage(ctx, system.DiskUsageOptions{Types: []system.DiskUsageObject{system.VolumeObject}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to measure volumes: %w", err)
+		}
+		sizes = make(map[string]int64, len(usage.Volumes))
+		for _, v := range usage.Volumes {
+			if v != nil && v.UsageData != nil {
+				sizes[v.Name] = v.UsageData.Size
+			}
+		}
+	}
+	return BuildVolumes(list.Volumes, sizes, containers), nil
+}
+
+// BuildVolumes attributes containers to the volumes they mount, largest
+// volume first. Volumes missing from sizes get a size of -1.
+func BuildVolumes(volumes []*volume.Volume, sizes map[string]int64, containers []container.Summary) []Volume {
+	mountedBy := make(map[string][]string)
+	for _, cont := range containers {
+		_, name := containerNames(cont)
+		for _, m := range cont.Mounts {
+			if m.Type == "volume" && m.Name != "" {
+				mountedBy[m.Name] = append(mountedBy[m.Name], name)
+			}
+		}
+	}
+
+	result := make([]Volume, 0, len(volumes))
+	for _, v := range volumes {
+		if v == nil {
+			continue
+		}
+		size, ok := sizes[v.Name]
+		if !ok {
+			size = -1
+		}
+		users := mountedBy[v.Name]
+		sort.Strings(users)
+		result = append(result, Volume{
+			Name:       v.Name,
+			Driver:     v.Driver,
+			Mountpoint: v.Mountpoint,
+			Size:       size,
+			Containers: users,
+		})
+	}
+
+	SortVolumes(result)
+	return result
+}
+
+// SortVolumes orders volumes largest first, then by name.
+func SortVolumes(volumes []Volume) {
+	sort.SliceStable(volumes, func(i, j int) bool {
+		if volumes[i].Size != volumes[j].Size {
+			return volumes[i].Size > volumes[j].Size
+		}
+		return volumes[i].Name < volumes[j].Name
+	})
+}
+
+// RemoveVolume removes a volume. The daemon refuses volumes still mounted by
+// a container.
+func (dm *DockerManager) RemoveVolume(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := dm.Cli.VolumeRemove(ctx, name, false); err != nil {
+		return fmt.Errorf("failed to remove volume %s: %w", name, err)
+	}
+	return nil
+}
+
+// PruneVolumes removes every volume, named or anonymous, that no container
+// mounts, and returns how many were deleted and the space reclaimed.
+func (dm *DockerManager) PruneVolumes() (int, uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	// Without all=true the daemon only prunes anonymous volumes.
+	report, err := dm.Cli.VolumesPrune(ctx, filters.NewArgs(filters.Arg("all", "true")))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to prune volumes: %w", err)
+	}
+	return len(report.VolumesDeleted), report.SpaceReclaimed, nil
+}
@@ -0,0 +1,383 @@
+package exporter
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/system/performance"
+	resource "github.com/System-Pulse/server-pulse/system/resource"
+	"github.com/System-Pulse/server-pulse/system/security"
+	"github.com/System-Pulse/server-pulse/utils"
+)
+
+const namespace = "server_pulse_"
+
+// Exporter turns the collectors used by the TUI into Prometheus metrics.
+// Security checks are slow, so they run on their own schedule and the last
+// result is served from cache.
+type Exporter struct {
+	Docker           *app.DockerManager
+	Security         *security.SecurityManager
+	Domain           string
+	SecurityInterval time.Duration
+
+	mu     sync.RWMutex
+	checks []security.SecurityCheck
+}
+
+func New(docker *app.DockerManager, domain string, securityInterval time.Duration) *Exporter {
+	sm := security.NewSecurityManager()
+	sm.IsRoot = utils.IsRoot()
+
+	return &Exporter{
+		Docker:           docker,
+		Security:         sm,
+		Domain:           domain,
+		SecurityInterval: securityInterval,
+	}
+}
+
+// RunSecurityChecks refreshes the cached security checks until ctx is done.
+func (e *Exporter) RunSecurityChecks(ctx context.Context) {
+	for {
+		if checks, err := e.Security.CollectSecurityChecks(ctx, e.Domain); err == nil {
+			e.mu.Lock()
+			e.checks = checks
+			e.mu.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.SecurityInterval):
+		}
+	}
+}
+
+type collector struct {
+	name    string
+	collect func(ctx context.Context, ms *MetricSet) error
+}
+
+var collectors = []collector{
+	{"cpu", func(ctx context.Context, ms *MetricSet) error {
+		info, err := resource.CollectCPU(ctx)
+		if err == nil {
+			recordCPU(ms, info)
+		}
+		return err
+	}},
+	{"memory", func(ctx context.Context, ms *MetricSet) error {
+		info, err := resource.CollectMemory(ctx)
+		if err == nil {
+			recordMemory(ms, info)
+		}
+		return err
+	}},
+	{"disk", func(ctx context.Context, ms *MetricSet) error {
+		disks, err := resource.CollectDisks(ctx)
+		if err == nil {
+			recordDisks(ms, disks)
+		}
+		return err
+	}},
+	{"network", func(ctx context.Context, ms *MetricSet) error {
+		info, err := resource.CollectNetwork(ctx)
+		if err == nil {
+			recordNetwork(ms, info)
+		}
+		return err
+	}},
+	{"cpu_metrics", func(ctx context.Context, ms *MetricSet) error {
+		metrics, err := performance.CollectCPUMetrics(ctx)
+		if err == nil {
+			recordCPUMetrics(ms, metrics)
+		}
+		return err
+	}},
+	{"io_metrics", func(ctx context.Context, ms *MetricSet) error {
+		metrics, err := performance.CollectIOMetrics(ctx)
+		if err == nil {
+			recordIOMetrics(ms, metrics)
+		}
+		return err
+	}},
+	{"memory_metrics", func(ctx context.Context, ms *MetricSet) error {
+		metrics, err := performance.CollectMemoryMetrics(ctx)
+		if err == nil {
+			recordMemoryMetrics(ms, metrics)
+		}
+		return err
+	}},
+}
+
+// Collect samples every collector once. Collectors that have not answered
+// when ctx expires are reported as failed.
+func (e *Exporter) Collect(ctx context.Context) *MetricSet {
+	ms := NewMetricSet()
+
+	type result struct {
+		index int
+		set   *MetricSet
+		err   error
+	}
+	results := make(chan result, len(collectors))
+	for i, c := range collectors {
+		go func() {
+			set := NewMetricSet()
+			err := c.collect(ctx, set)
+			results <- result{i, set, err}
+		}()
+	}
+
+	var containerSet *MetricSet
+	containersDone := make(chan struct{})
+	go func() {
+		defer close(containersDone)
+		containerSet = e.collectContainers(ctx)
+	}()
+
+	sets := make([]*MetricSet, len(collectors))
+	for received := 0; received < len(collectors); received++ {
+		select {
+		case r := <-results:
+			if r.err == nil {
+				sets[r.index] = r.set
+			}
+		case <-ctx.Done():
+			received = len(collectors)
+		}
+	}
+
+	up := ms.Gauge(namespace+"collector_success", "Whether the collector succeeded during the last scrape.")
+	for i, set := range sets {
+		up.Add(boolValue(set != nil), "collector", collectors[i].name)
+		if set != nil {
+			ms.Merge(set)
+		}
+	}
+
+	select {
+	case <-containersDone:
+		ms.Merge(containerSet)
+	case <-ctx.Done():
+		if e.Docker != nil {
+			up.Add(0, "collector", "containers")
+		}
+	}
+
+	e.recordSecurity(ms)
+
+	return ms
+}
+
+func recordCPU(ms *MetricSet, info resource.CPUInfo) {
+	ms.Gauge(namespace+"cpu_usage_percent", "Overall CPU usage in percent.").Add(info.Usage)
+	core := ms.Gauge(namespace+"cpu_core_usage_percent", "Per-core CPU usage in percent.")
+	for i, usage := range info.PerCore {
+		core.Add(usage, "core", strconv.Itoa(i))
+	}
+	load := ms.Gauge(namespace+"load_average", "System load average.")
+	load.Add(info.LoadAvg1, "period", "1m")
+	load.Add(info.LoadAvg5, "period", "5m")
+	load.Add(info.LoadAvg15, "period", "15m")
+}
+
+func recordMemory(ms *MetricSet, info resource.MemoryInfo) {
+	ms.Gauge(namespace+"memory_total_bytes", "Total physical memory.").Add(float64(info.Total))
+	ms.Gauge(namespace+"memory_used_bytes", "Used physical memory.").Add(float64(info.Used))
+	ms.Gauge(namespace+"memory_free_bytes", "Free physical memory.").Add(float64(info.Free))
+	ms.Gauge(namespace+"memory_usage_percent", "Physical memory usage in percent.").Add(info.Usage)
+	ms.Gauge(namespace+"swap_total_bytes", "Total swap space.").Add(float64(info.SwapTotal))
+	ms.Gauge(namespace+"swap_used_bytes", "Used swap space.").Add(float64(info.SwapUsed))
+	ms.Gauge(namespace+"swap_usage_percent", "Swap usage in percent.").Add(info.SwapUsage)
+}
+
+func recordDisks(ms *MetricSet, disks []resource.DiskInfo) {
+	total := ms.Gauge(namespace+"disk_total_bytes", "Filesystem size.")
+	used := ms.Gauge(namespace+"disk_used_bytes", "Used filesystem space.")
+	free := ms.Gauge(namespace+"disk_free_bytes", "Free filesystem space.")
+	usage := ms.Gauge(namespace+"disk_usage_percent", "Filesystem usage in percent.")
+	inodesTotal := ms.Gauge(namespace+"disk_inodes_total", "Filesystem inode count.")
+	inodesUsed := ms.Gauge(namespace+"disk_inodes_used", "Used filesystem inodes.")
+	for _, disk := range disks {
+		total.Add(float64(disk.Total), "mountpoint", disk.Mountpoint)
+		used.Add(float64(disk.Used), "mountpoint", disk.Mountpoint)
+		free.Add(float64(disk.Free), "mountpoint", disk.Mountpoint)
+		usage.Add(disk.Usage, "mountpoint", disk.Mountpoint)
+		if disk.InodesTotal > 0 {
+			inodesTotal.Add(float64(disk.InodesTotal), "mountpoint", disk.Mountpoint)
+			inodesUsed.Add(float64(disk.InodesUsed), "mountpoint", disk.Mountpoint)
+		}
+	}
+}
+
+func recordNetwork(ms *MetricSet, info resource.NetworkInfo) {
+	rx := ms.Counter(namespace+"network_receive_bytes_total", "Bytes received per interface.")
+	tx := ms.Counter(namespace+"network_transmit_bytes_total", "Bytes sent per interface.")
+	status := ms.Gauge(namespace+"network_interface_up", "Whether the interface is up.")
+	for _, iface := range info.Interfaces {
+		rx.Add(float64(iface.RxBytes), "interface", iface.Name)
+		tx.Add(float64(iface.TxBytes), "interface", iface.Name)
+		status.Add(boolValue(iface.Status == "up"), "interface", iface.Name)
+	}
+}
+
+func recordCPUMetrics(ms *MetricSet, m *performance.CPUMetrics) {
+	state := ms.Gauge(namespace+"cpu_state_percent", "Share of CPU time spent in each state since boot.")
+	for _, s := range []struct {
+		name  string
+		value float64
+	}{
+		{"user", m.StateBreakdown.User},
+		{"system", m.StateBreakdown.System},
+		{"idle", m.StateBreakdown.Idle},
+		{"iowait", m.StateBreakdown.IOWait},
+		{"irq", m.StateBreakdown.IRQ},
+		{"softirq", m.StateBreakdown.SoftIRQ},
+		{"steal", m.StateBreakdown.Steal},
+		{"nice", m.StateBreakdown.Nice},
+	} {
+		state.Add(s.value, "state", s.name)
+	}
+	ms.Counter(namespace+"context_switches_total", "Context switches since boot.").Add(float64(m.ContextSwitches))
+	ms.Counter(namespace+"interrupts_total", "Interrupts since boot.").Add(float64(m.Interrupts))
+	ms.Gauge(namespace+"processes", "Number of processes.").Add(float64(m.ProcessCount))
+	ms.Gauge(namespace+"threads", "Number of threads.").Add(float64(m.ThreadCount))
+}
+
+func recordIOMetrics(ms *MetricSet, m *performance.IOMetrics) {
+	reads := ms.Counter(namespace+"disk_reads_completed_total", "Reads completed per block device.")
+	writes := ms.Counter(namespace+"disk_writes_completed_total", "Writes completed per block device.")
+	readBytes := ms.Counter(namespace+"disk_read_bytes_total", "Bytes read per block device.")
+	writeBytes := ms.Counter(namespace+"disk_written_bytes_total", "Bytes written per block device.")
+	inProgress := ms.Gauge(namespace+"disk_io_in_progress", "I/O operations currently in progress per block device.")
+	for _, disk := range m.Disks {
+		reads.Add(float64(disk.ReadIOPS), "device", disk.Device)
+		writes.Add(float64(disk.WriteIOPS), "device", disk.Device)
+		readBytes.Add(float64(disk.ReadBytes), "device", disk.Device)
+		writeBytes.Add(float64(disk.WriteBytes), "device", disk.Device)
+		inProgress.Add(float64(disk.QueueDepth), "device", disk.Device)
+	}
+}
+
+func recordMemoryMetrics(ms *MetricSet, m *performance.MemoryMetrics) {
+	ms.Gauge(namespace+"memory_available_bytes", "Memory available for new workloads.").Add(float64(m.Available))
+	ms.Gauge(namespace+"memory_buffers_bytes", "Memory used by kernel buffers.").Add(float64(m.Buffers))
+	ms.Gauge(namespace+"memory_cached_bytes", "Memory used by the page cache.").Add(float64(m.Cached))
+	ms.Gauge(namespace+"memory_shared_bytes", "Shared memory.").Add(float64(m.Shared))
+	ms.Gauge(namespace+"memory_dirty_bytes", "Memory waiting to be written back to disk.").Add(float64(m.Dirty))
+	ms.Gauge(namespace+"memory_slab_bytes", "Kernel slab memory.").Add(float64(m.Slab))
+	ms.Gauge(namespace+"swap_cached_bytes", "Swap cached in memory.").Add(float64(m.SwapCached))
+}
+
+func (e *Exporter) collectContainers(ctx context.Context) *MetricSet {
+	ms := NewMetricSet()
+	if e.Docker == nil {
+		return ms
+	}
+
+	containers, err := e.Docker.ListContainers(ctx)
+	ms.Gauge(namespace+"collector_success", "Whether the collector succeeded during the last scrape.").
+		Add(boolValue(err == nil), "collector", "containers")
+	if err != nil {
+		return ms
+	}
+
+	state := ms.Gauge(namespace+"container_running", "Whether the container is running.")
+	health := ms.Gauge(namespace+"container_healthy", "Container health check result (1 healthy, 0 unhealthy, absent without health check).")
+	for _, c := range containers {
+		state.Add(boolValue(c.State == "running"), "id", c.ID, "name", c.Name, "image", c.Image, "state", c.State)
+		switch c.Health {
+		case "healthy":
+			health.Add(1, "id", c.ID, "name", c.Name)
+		case "unhealthy":
+			health.Add(0, "id", c.ID, "name", c.Name)
+		}
+	}
+
+	type containerStats struct {
+		container app.Container
+		stats     *app.ContainerStats
+	}
+	results := make(chan containerStats)
+	running := 0
+	for _, c := range containers {
+		if c.State != "running" {
+			continue
+		}
+		running++
+		go func(c app.Container) {
+			stats, err := e.Docker.GetContainerStats(ctx, c.ID)
+			if err != nil {
+				stats = nil
+			}
+			select {
+			case results <- containerStats{c, stats}:
+			case <-ctx.Done():
+			}
+		}(c)
+	}
+
+	cpu := ms.Gauge(namespace+"container_cpu_usage_percent", "Container CPU usage in percent of one CPU.")
+	memUsage := ms.Gauge(namespace+"container_memory_usage_bytes", "Container memory usage.")
+	memLimit := ms.Gauge(namespace+"container_memory_limit_bytes", "Container memory limit.")
+	memPercent := ms.Gauge(namespace+"container_memory_usage_percent", "Container memory usage in percent of its limit.")
+	rx := ms.Counter(namespace+"container_network_receive_bytes_total", "Bytes received by the container.")
+	tx := ms.Counter(namespace+"container_network_transmit_bytes_total", "Bytes sent by the container.")
+	blockRead := ms.Counter(namespace+"container_block_read_bytes_total", "Bytes read from block devices by the container.")
+	blockWrite := ms.Counter(namespace+"container_block_write_bytes_total", "Bytes written to block devices by the container.")
+
+	for i := 0; i < running; i++ {
+		select {
+		case r := <-results:
+			if r.stats == nil {
+				continue
+			}
+			labels := []string{"id", r.container.ID, "name", r.container.Name}
+			cpu.Add(r.stats.CPUPercent, labels...)
+			memUsage.Add(float64(r.stats.MemoryUsage), labels...)
+			memLimit.Add(float64(r.stats.MemoryLimit), labels...)
+			memPercent.Add(r.stats.MemoryPercent, labels...)
+			rx.Add(float64(r.stats.NetworkRx), labels...)
+			tx.Add(float64(r.stats.NetworkTx), labels...)
+			blockRead.Add(float64(r.stats.BlockRead), labels...)
+			blockWrite.Add(float64(r.stats.BlockWrite), labels...)
+		case <-ctx.Done():
+			return ms
+		}
+	}
+
+	return ms
+}
+
+func (e *Exporter) recordSecurity(ms *MetricSet) {
+	e.mu.RLock()
+	checks := e.checks
+	e.mu.RUnlock()
+
+	status := ms.Gauge(namespace+"security_check_status",
+		"Security check outcome: 0 passed, 1 warning, 2 failed, 3 unknown. The raw status is in the status label.")
+	for _, check := range checks {
+		value := 2.0
+		switch check.Result() {
+		case security.CheckPassed:
+			value = 0
+		case security.CheckWarning:
+			value = 1
+		case security.CheckUnknown:
+			value = 3
+		}
+		status.Add(value, "check", check.Name, "status", check.Status)
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,124 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MetricSet accumulates metric families and renders them in the Prometheus
+// text exposition format. Families are written in registration order.
+type MetricSet struct {
+	families []*Family
+	byName   map[string]*Family
+}
+
+// Family is a named metric with its samples.
+type Family struct {
+	Name    string
+	Help    string
+	Type    string
+	samples []sample
+}
+
+type sample struct {
+	labels []string
+	value  float64
+}
+
+func NewMetricSet() *MetricSet {
+	return &MetricSet{byName: make(map[string]*Family)}
+}
+
+// Gauge returns the gauge family with the given name, creating it if needed.
+func (ms *MetricSet) Gauge(name, help string) *Family {
+	return ms.family(name, help, "gauge")
+}
+
+// Counter returns the counter family with the given name, creating it if needed.
+func (ms *MetricSet) Counter(name, help string) *Family {
+	return ms.family(name, help, "counter")
+}
+
+func (ms *MetricSet) family(name, help, metricType string) *Family {
+	if f, ok := ms.byName[name]; ok {
+		return f
+	}
+	f := &Family{Name: name, Help: help, Type: metricType}
+	ms.families = append(ms.families, f)
+	ms.byName[name] = f
+	return f
+}
+
+// Merge appends the samples of other into the matching families of ms.
+func (ms *MetricSet) Merge(other *MetricSet) {
+	for _, f := range other.families {
+		target := ms.family(f.Name, f.Help, f.Type)
+		target.samples = append(target.samples, f.samples...)
+	}
+}
+
+// Add records a sample. Labels are given as alternating name/value pairs.
+func (f *Family) Add(value float64, labels ...string) {
+	if len(labels)%2 != 0 {
+		labels = labels[:len(labels)-1]
+	}
+	f.samples = append(f.samples, sample{labels: labels, value: value})
+}
+
+// WriteTo renders every family that has at least one sample.
+func (ms *MetricSet) WriteTo(w io.Writer) (int64, error) {
+	var out strings.Builder
+
+	for _, f := range ms.families {
+		if len(f.samples) == 0 {
+			continue
+		}
+		out.WriteString(fmt.Sprintf("# HELP %s %s\n", f.Name, escapeHelp(f.Help)))
+		out.WriteString(fmt.Sprintf("# TYPE %s %s\n", f.Name, f.Type))
+		for _, s := range f.samples {
+			out.WriteString(f.Name)
+			if len(s.labels) > 0 {
+				out.WriteString("{")
+				for i := 0; i < len(s.labels); i += 2 {
+					if i > 0 {
+						out.WriteString(",")
+					}
+					out.WriteString(fmt.Sprintf("%s=\"%s\"", s.labels[i], escapeLabelValue(s.labels[i+1])))
+				}
+				out.WriteString("}")
+			}
+			out.WriteString(" ")
+			out.WriteString(formatValue(s.value))
+			out.WriteString("\n")
+		}
+	}
+
+	n, err := io.WriteString(w, out.String())
+	return int64(n), err
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func escapeHelp(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, "\n", `\n`)
+}
+
+func escapeLabelValue(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return strings.ReplaceAll(s, "\n", `\n`)
+}
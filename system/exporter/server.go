@@ -0,0 +1,54 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Handler serves the metrics of a single scrape per request.
+func (e *Exporter) Handler(timeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		ms := e.Collect(ctx)
+		w.Header().Set("Content-Type", contentType)
+		ms.WriteTo(w)
+	})
+}
+
+// Serve exposes /metrics on addr until ctx is cancelled.
+func (e *Exporter) Serve(ctx context.Context, addr string, timeout time.Duration) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", e.Handler(timeout))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintln(w, "server-pulse exporter - metrics are served at /metrics")
+	})
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go e.RunSecurityChecks(ctx)
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("failed to serve metrics on %s: %w", addr, err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/exporter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricSetWriteTo(t *testing.T) {
+	t.Parallel()
+
+	ms := exporter.NewMetricSet()
+	ms.Gauge("test_usage_percent", "Usage in percent.").Add(42.5)
+	disk := ms.Gauge("test_disk_bytes", "Disk size.")
+	disk.Add(1024, "mountpoint", "/")
+	disk.Add(2048, "mountpoint", "/home")
+	ms.Counter("test_empty_total", "Never sampled.")
+
+	var out strings.Builder
+	_, err := ms.WriteTo(&out)
+	require.NoError(t, err)
+
+	expected := `# HELP test_usage_percent Usage in percent.
+# TYPE test_usage_percent gauge
+test_usage_percent 42.5
+# HELP test_disk_bytes Disk size.
+# TYPE test_disk_bytes gauge
+test_disk_bytes{mountpoint="/"} 1024
+test_disk_bytes{mountpoint="/home"} 2048
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestMetricSetEscapesLabels(t *testing.T) {
+	t.Parallel()
+
+	ms := exporter.NewMetricSet()
+	ms.Gauge("test_check", "Check.").Add(1, "status", "say \"hi\"\n", "path", `C:\tmp`)
+
+	var out strings.Builder
+	_, err := ms.WriteTo(&out)
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), `test_check{status="say \"hi\"\n",path="C:\\tmp"} 1`)
+}
+
+func TestMetricSetMerge(t *testing.T) {
+	t.Parallel()
+
+	a := exporter.NewMetricSet()
+	a.Gauge("test_up", "Up.").Add(1, "collector", "cpu")
+	b := exporter.NewMetricSet()
+	b.Gauge("test_up", "Up.").Add(0, "collector", "containers")
+	b.Gauge("test_other", "Other.").Add(3)
+	a.Merge(b)
+
+	var out strings.Builder
+	_, err := a.WriteTo(&out)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(out.String(), "# TYPE test_up gauge"))
+	assert.Contains(t, out.String(), `test_up{collector="containers"} 0`)
+	assert.Contains(t, out.String(), "test_other 3")
+}
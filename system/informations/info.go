@@ -1,25 +1,22 @@
 package informations
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/System-Pulse/server-pulse/utils"
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/shirou/gopsutil/v4/host"
 )
 
-func UpdateSystemInfo() tea.Cmd {
-	return func() tea.Msg {
-		hostInfo, err := host.Info()
-		if err != nil {
-			return utils.ErrMsg(err)
-		}
-
-		return SystemMsg{
-			Hostname: hostInfo.Hostname,
-			OS:       fmt.Sprintf("%s %s", hostInfo.Platform, hostInfo.PlatformVersion),
-			Kernel:   hostInfo.KernelVersion,
-			Uptime:   hostInfo.Uptime,
-		}
+func CollectSystemInfo(ctx context.Context) (SystemInfo, error) {
+	hostInfo, err := host.InfoWithContext(ctx)
+	if err != nil {
+		return SystemInfo{}, err
 	}
+
+	return SystemInfo{
+		Hostname: hostInfo.Hostname,
+		OS:       fmt.Sprintf("%s %s", hostInfo.Platform, hostInfo.PlatformVersion),
+		Kernel:   hostInfo.KernelVersion,
+		Uptime:   hostInfo.Uptime,
+	}, nil
 }
@@ -1,14 +1,13 @@
 package logs
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os/exec"
 	"strconv"
 	"strings"
 	"time"
-
-	tea "github.com/charmbracelet/bubbletea"
 )
 
 func NewLogManager() *LogManager {
@@ -37,46 +36,42 @@ func (lm *LogManager) DetectLogSystem() LogSource {
 	return LogSourceJournald // Default to journald
 }
 
-// GetSystemLogs retrieves system logs based on filters
-func (lm *LogManager) GetSystemLogs(filters LogFilters) tea.Cmd {
-	return func() tea.Msg {
-		source := lm.DetectLogSystem()
-
-		var entries []LogEntry
-		var err error
-
-		switch source {
-		case LogSourceJournald:
-			entries, err = lm.getJournalLogs(filters)
-		case LogSourceSyslog:
-			entries, err = lm.getSyslogEntries(filters)
-		default:
-			entries, err = lm.getJournalLogs(filters)
-		}
-
-		if err != nil {
-			return LogsMsg(LogsInfos{
-				Source:     source,
-				Entries:    []LogEntry{},
-				TotalCount: 0,
-				Filters:    filters,
-				ErrorMsg:   fmt.Sprintf("Error loading logs: %v", err),
-			})
-		}
+// CollectSystemLogs retrieves system logs based on filters from the log system
+// of the host. On error the returned infos still carry the source and filters.
+func (lm *LogManager) CollectSystemLogs(ctx context.Context, filters LogFilters) (LogsInfos, error) {
+	source := lm.DetectLogSystem()
+
+	var entries []LogEntry
+	var err error
+
+	switch source {
+	case LogSourceJournald:
+		entries, err = lm.getJournalLogs(ctx, filters)
+	case LogSourceSyslog:
+		entries, err = lm.getSyslogEntries(ctx, filters)
+	default:
+		entries, err = lm.getJournalLogs(ctx, filters)
+	}
 
-		return LogsMsg(LogsInfos{
-			Source:     source,
-			Entries:    entries,
-			TotalCount: len(entries),
-			HasMore:    len(entries) >= filters.Limit,
-			Filters:    filters,
-			ErrorMsg:   "",
-		})
+	if err != nil {
+		return LogsInfos{
+			Source:  source,
+			Entries: []LogEntry{},
+			Filters: filters,
+		}, err
 	}
+
+	return LogsInfos{
+		Source:     source,
+		Entries:    entries,
+		TotalCount: len(entries),
+		HasMore:    len(entries) >= filters.Limit,
+		Filters:    filters,
+	}, nil
 }
 
 // getJournalLogs retrieves logs from systemd journal
-func (lm *LogManager) getJournalLogs(filters LogFilters) ([]LogEntry, error) {
+func (lm *LogManager) getJournalLogs(ctx context.Context, filters LogFilters) ([]LogEntry, error) {
 	args := []string{}
 
 	// Time range - convert shorthand to journalctl format
@@ -116,16 +111,16 @@ func (lm *LogManager) getJournalLogs(filters LogFilters) ([]LogEntry, error) {
 	useSudo := lm.CanUseSudo && lm.SudoPassword != ""
 	if useSudo {
 		sudoArgs := append([]string{"-S", "journalctl"}, args...)
-		cmd = exec.Command("sudo", sudoArgs...)
+		cmd = exec.CommandContext(ctx, "sudo", sudoArgs...)
 		cmd.Stdin = strings.NewReader(lm.SudoPassword + "\n")
 	} else {
-		cmd = exec.Command("journalctl", args...)
+		cmd = exec.CommandContext(ctx, "journalctl", args...)
 	}
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		// Fallback to simple format if JSON fails
-		return lm.getJournalLogsSimple(filters)
+		return lm.getJournalLogsSimple(ctx, filters)
 	}
 
 	return lm.parseJournalJSON(output, filters)
@@ -189,7 +184,7 @@ func (lm *LogManager) parseJournalJSON(output []byte, filters LogFilters) ([]Log
 }
 
 // getJournalLogsSimple fallback to simple text format
-func (lm *LogManager) getJournalLogsSimple(filters LogFilters) ([]LogEntry, error) {
+func (lm *LogManager) getJournalLogsSimple(ctx context.Context, filters LogFilters) ([]LogEntry, error) {
 	args := []string{}
 
 	if filters.TimeRange != "" && filters.TimeRange != "custom" {
@@ -219,10 +214,10 @@ func (lm *LogManager) getJournalLogsSimple(filters LogFilters) ([]LogEntry, erro
 	useSudo := lm.CanUseSudo && lm.SudoPassword != ""
 	if useSudo {
 		sudoArgs := append([]string{"-S", "journalctl"}, args...)
-		cmd = exec.Command("sudo", sudoArgs...)
+		cmd = exec.CommandContext(ctx, "sudo", sudoArgs...)
 		cmd.Stdin = strings.NewReader(lm.SudoPassword + "\n")
 	} else {
-		cmd = exec.Command("journalctl", args...)
+		cmd = exec.CommandContext(ctx, "journalctl", args...)
 	}
 
 	output, err := cmd.CombinedOutput()
@@ -258,7 +253,7 @@ func (lm *LogManager) parseSimpleLogFormat(output []byte, filters LogFilters) []
 }
 
 // getSyslogEntries retrieves logs from syslog files
-func (lm *LogManager) getSyslogEntries(filters LogFilters) ([]LogEntry, error) {
+func (lm *LogManager) getSyslogEntries(ctx context.Context, filters LogFilters) ([]LogEntry, error) {
 	files := []string{"/var/log/syslog", "/var/log/messages"}
 
 	for _, file := range files {
@@ -273,10 +268,10 @@ func (lm *LogManager) getSyslogEntries(filters LogFilters) ([]LogEntry, error) {
 		var cmd *exec.Cmd
 		if lm.CanUseSudo && lm.SudoPassword != "" {
 			sudoArgs := append([]string{"-S"}, args...)
-			cmd = exec.Command("sudo", sudoArgs...)
+			cmd = exec.CommandContext(ctx, "sudo", sudoArgs...)
 			cmd.Stdin = strings.NewReader(lm.SudoPassword + "\n")
 		} else {
-			cmd = exec.Command(args[0], args[1:]...)
+			cmd = exec.CommandContext(ctx, args[0], args[1:]...)
 		}
 
 		output, err := cmd.Output()
@@ -1,106 +1,104 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
-
-	"github.com/System-Pulse/server-pulse/utils"
-	tea "github.com/charmbracelet/bubbletea"
 )
 
-func GetConnections() tea.Cmd {
-	return func() tea.Msg {
-		cmd := exec.Command("sudo", "-n", "ss", "-tunape")
-		output, err := cmd.Output()
+// CollectConnections lists the sockets reported by ss, with their owning
+// process when sudo allows it.
+func CollectConnections(ctx context.Context) ([]ConnectionInfo, error) {
+	cmd := exec.CommandContext(ctx, "sudo", "-n", "ss", "-tunape")
+	output, err := cmd.Output()
+	if err != nil {
+		cmd = exec.CommandContext(ctx, "ss", "-tunae")
+		output, err = cmd.Output()
 		if err != nil {
-			cmd = exec.Command("ss", "-tunae")
-			output, err = cmd.Output()
-			if err != nil {
-				return utils.ErrMsg(fmt.Errorf("failed to run ss: %w", err))
-			}
+			return nil, fmt.Errorf("failed to run ss: %w", err)
 		}
+	}
 
-		var connections []ConnectionInfo
-		lines := strings.Split(string(output), "\n")
-		pidRegex := regexp.MustCompile(`users:\(\("([^"]+)",pid=(\d+),.*\)\)`)
-		uidRegex := regexp.MustCompile(`uid:(\d+)`)
+	var connections []ConnectionInfo
+	lines := strings.Split(string(output), "\n")
+	pidRegex := regexp.MustCompile(`users:\(\("([^"]+)",pid=(\d+),.*\)\)`)
+	uidRegex := regexp.MustCompile(`uid:(\d+)`)
 
-		for i, line := range lines {
-			if i == 0 || strings.TrimSpace(line) == "" { // Skip header and empty lines
-				continue
-			}
-			fields := strings.Fields(line)
-			if len(fields) < 5 {
-				continue
-			}
+	for i, line := range lines {
+		if i == 0 || strings.TrimSpace(line) == "" { // Skip header and empty lines
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 5 {
+			continue
+		}
 
-			var state, pidInfo, uidInfo string
-			proto := fields[0]
+		var state, pidInfo, uidInfo string
+		proto := fields[0]
 
-			// Parse state based on protocol
-			if proto == "tcp" || proto == "tcp6" {
-				if len(fields) < 6 {
-					continue
-				}
-				state = fields[1]
-			} else if proto == "udp" || proto == "udp6" {
-				state = "UNCONN"
-			} else {
-				state = "-"
+		// Parse state based on protocol
+		if proto == "tcp" || proto == "tcp6" {
+			if len(fields) < 6 {
+				continue
 			}
+			state = fields[1]
+		} else if proto == "udp" || proto == "udp6" {
+			state = "UNCONN"
+		} else {
+			state = "-"
+		}
 
-			// Parse process info
-			matches := pidRegex.FindStringSubmatch(line)
-			if len(matches) > 2 {
-				processName := matches[1]
-				pid := matches[2]
-				// Try to get the actual process name from /proc if possible
-				if processName == "-" {
-					if pidInt, err := strconv.Atoi(pid); err == nil {
-						if cmdline, err := getProcessCommand(pidInt); err == nil && cmdline != "" {
-							processName = cmdline
-						}
+		// Parse process info
+		matches := pidRegex.FindStringSubmatch(line)
+		if len(matches) > 2 {
+			processName := matches[1]
+			pid := matches[2]
+			// Try to get the actual process name from /proc if possible
+			if processName == "-" {
+				if pidInt, err := strconv.Atoi(pid); err == nil {
+					if cmdline, err := getProcessCommand(pidInt); err == nil && cmdline != "" {
+						processName = cmdline
 					}
 				}
-				pidInfo = fmt.Sprintf("%s (%s)", processName, pid)
-			} else {
-				pidInfo = "N/A"
 			}
+			pidInfo = fmt.Sprintf("%s (%s)", processName, pid)
+		} else {
+			pidInfo = "N/A"
+		}
 
-			// Parse UID if available
-			uidMatches := uidRegex.FindStringSubmatch(line)
-			if len(uidMatches) > 1 {
-				uidInfo = uidMatches[1]
-				// Try to resolve username from UID
-				if username := getUsernameFromUID(uidInfo); username != "" {
-					uidInfo = username
-				}
+		// Parse UID if available
+		uidMatches := uidRegex.FindStringSubmatch(line)
+		if len(uidMatches) > 1 {
+			uidInfo = uidMatches[1]
+			// Try to resolve username from UID
+			if username := getUsernameFromUID(uidInfo); username != "" {
+				uidInfo = username
 			}
+		}
 
-			// Parse addresses with better formatting
-			localAddr := fields[4]
-			foreignAddr := fields[5]
+		// Parse addresses with better formatting
+		localAddr := fields[4]
+		foreignAddr := fields[5]
 
-			// Add UID info to PID field if available
-			if uidInfo != "" && pidInfo != "N/A" {
-				pidInfo = fmt.Sprintf("%s [UID:%s]", pidInfo, uidInfo)
-			}
-
-			connections = append(connections, ConnectionInfo{
-				Proto:       proto,
-				RecvQ:       fields[2],
-				SendQ:       fields[3],
-				LocalAddr:   localAddr,
-				ForeignAddr: foreignAddr,
-				State:       state,
-				PID:         pidInfo,
-			})
+		// Add UID info to PID field if available
+		if uidInfo != "" && pidInfo != "N/A" {
+			pidInfo = fmt.Sprintf("%s [UID:%s]", pidInfo, uidInfo)
 		}
-		return ConnectionsMsg(connections)
+
+		connections = append(connections, ConnectionInfo{
+			Proto:       proto,
+			RecvQ:       fields[2],
+			SendQ:       fields[3],
+			LocalAddr:   localAddr,
+			ForeignAddr: foreignAddr,
+			State:       state,
+			PID:         pidInfo,
+		})
 	}
+	return connections, nil
 }
 
 // Helper function to get process command from /proc
@@ -1,6 +1,8 @@
 package network
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os/exec"
@@ -8,13 +10,13 @@ import (
 	"strconv"
 	"strings"
 	"time"
-
-	tea "github.com/charmbracelet/bubbletea"
 )
 
 var validHostname = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9\-\.]*[a-zA-Z0-9])?$`)
 
-func isValidTarget(target string) bool {
+// ValidTarget reports whether target is an IP address or a hostname that is
+// safe to pass to ping and traceroute.
+func ValidTarget(target string) bool {
 	if len(target) == 0 || len(target) > 253 {
 		return false
 	}
@@ -24,38 +26,37 @@ func isValidTarget(target string) bool {
 	return validHostname.MatchString(target)
 }
 
-func Ping(target string, count int) tea.Cmd {
-	return func() tea.Msg {
-		if !isValidTarget(target) {
-			return PingMsg(PingResult{
-				Target:  target,
-				Success: false,
-				Error:   "Invalid target: must be a valid hostname or IP address",
-			})
-		}
-		if count < 1 || count > 100 {
-			count = 3
+// RunPing pings target count times; failures are reported in the result.
+func RunPing(ctx context.Context, target string, count int) PingResult {
+	if !ValidTarget(target) {
+		return PingResult{
+			Target:  target,
+			Success: false,
+			Error:   "Invalid target: must be a valid hostname or IP address",
 		}
-		cmd := exec.Command("ping", "-c", strconv.Itoa(count), "-W", "5", target)
-		output, err := cmd.CombinedOutput()
+	}
+	if count < 1 || count > 100 {
+		count = 3
+	}
+	cmd := exec.CommandContext(ctx, "ping", "-c", strconv.Itoa(count), "-W", "5", target)
+	output, err := cmd.CombinedOutput()
 
-		if err != nil {
-			outputStr := string(output)
-			if strings.Contains(outputStr, "bytes from") {
-				return parseSystemPingOutput(target, outputStr)
-			}
-			return PingMsg(PingResult{
-				Target:  target,
-				Success: false,
-				Error:   fmt.Sprintf("Ping failed: %v", err),
-			})
+	if err != nil {
+		outputStr := string(output)
+		if strings.Contains(outputStr, "bytes from") {
+			return parseSystemPingOutput(target, outputStr)
+		}
+		return PingResult{
+			Target:  target,
+			Success: false,
+			Error:   fmt.Sprintf("Ping failed: %v", err),
 		}
-
-		return parseSystemPingOutput(target, string(output))
 	}
+
+	return parseSystemPingOutput(target, string(output))
 }
 
-func parseSystemPingOutput(target, output string) PingMsg {
+func parseSystemPingOutput(target, output string) PingResult {
 	lines := strings.SplitSeq(output, "\n")
 	for line := range lines {
 		if strings.Contains(line, "bytes from") {
@@ -63,14 +64,14 @@ func parseSystemPingOutput(target, output string) PingMsg {
 				timePart := strings.Split(line, "time=")[1]
 				timePart = strings.Split(timePart, " ")[0]
 				if latency, err := time.ParseDuration(timePart); err == nil {
-					return PingMsg{
+					return PingResult{
 						Target:  target,
 						Success: true,
 						Latency: latency,
 					}
 				}
 			}
-			return PingMsg{
+			return PingResult{
 				Target:  target,
 				Success: true,
 				Latency: time.Millisecond * 10,
@@ -78,243 +79,199 @@ func parseSystemPingOutput(target, output string) PingMsg {
 		}
 	}
 
-	return PingMsg{
+	return PingResult{
 		Target:  target,
 		Success: false,
 		Error:   "No response from target",
 	}
 }
 
-func Traceroute(target string) tea.Cmd {
-	return func() tea.Msg {
-		if !isValidTarget(target) {
-			return TracerouteMsg(TracerouteResult{
-				Target: target,
-				Error:  "Invalid target: must be a valid hostname or IP address",
-			})
-		}
-		checkCmd := exec.Command("which", "traceroute")
-		if err := checkCmd.Run(); err != nil {
-			return TracerouteInstallPromptMsg{Target: target}
+// TracerouteInstalled reports whether the traceroute command is available.
+func TracerouteInstalled() bool {
+	_, err := exec.LookPath("traceroute")
+	return err == nil
+}
+
+// RunTraceroute traces the route to target; failures are reported in the
+// result.
+func RunTraceroute(ctx context.Context, target string) TracerouteResult {
+	if !ValidTarget(target) {
+		return TracerouteResult{
+			Target: target,
+			Error:  "Invalid target: must be a valid hostname or IP address",
 		}
+	}
 
-		cmd := exec.Command("traceroute", "-n", "-w", "2", "-q", "1", "-m", "30", target)
+	cmd := exec.CommandContext(ctx, "traceroute", "-n", "-w", "2", "-q", "1", "-m", "30", target)
 
-		output, err := cmd.Output()
+	output, err := cmd.Output()
 
-		if err != nil {
-			return TracerouteMsg(TracerouteResult{
-				Target: target,
-				Error:  fmt.Sprintf("traceroute failed: %v", err),
-			})
+	if err != nil {
+		return TracerouteResult{
+			Target: target,
+			Error:  fmt.Sprintf("traceroute failed: %v", err),
 		}
+	}
 
-		var hops []TracerouteHop
-		outputStr := string(output)
-		outputStr = strings.ReplaceAll(outputStr, "*", "")
-		lines := strings.Split(outputStr, "\n")
+	var hops []TracerouteHop
+	outputStr := string(output)
+	outputStr = strings.ReplaceAll(outputStr, "*", "")
+	lines := strings.Split(outputStr, "\n")
 
-		for i, line := range lines {
-			if i == 0 || strings.TrimSpace(line) == "" {
-				continue
-			}
+	for i, line := range lines {
+		if i == 0 || strings.TrimSpace(line) == "" {
+			continue
+		}
 
-			fields := strings.Fields(line)
-			if len(fields) < 2 {
-				continue
-			}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
 
-			// Skip header line
-			if fields[0] == "traceroute" {
-				continue
-			}
+		// Skip header line
+		if fields[0] == "traceroute" {
+			continue
+		}
 
-			hop := TracerouteHop{HopNumber: i}
+		hop := TracerouteHop{HopNumber: i}
 
-			// Parse hop number
-			fmt.Sscanf(fields[0], "%d", &hop.HopNumber)
+		// Parse hop number
+		fmt.Sscanf(fields[0], "%d", &hop.HopNumber)
 
-			// Parse IP addresses and latencies
-			if len(fields) >= 2 {
-				hop.IP = fields[1]
+		// Parse IP addresses and latencies
+		if len(fields) >= 2 {
+			hop.IP = fields[1]
 
-				// Try to resolve hostname if available
-				if len(fields) >= 3 && !strings.HasPrefix(fields[2], "(") {
-					hop.Hostname = fields[2]
-				}
+			// Try to resolve hostname if available
+			if len(fields) >= 3 && !strings.HasPrefix(fields[2], "(") {
+				hop.Hostname = fields[2]
+			}
 
-				// Parse latencies
-				for j := 2; j < len(fields); j++ {
-					if before, ok := strings.CutSuffix(fields[j], "ms"); ok {
-						latencyStr := before
-						if parsed, err := time.ParseDuration(latencyStr + "ms"); err == nil {
-							switch j - 2 {
-							case 0:
-								hop.Latency1 = parsed
-							case 1:
-								hop.Latency2 = parsed
-							case 2:
-								hop.Latency3 = parsed
-							}
+			// Parse latencies
+			for j := 2; j < len(fields); j++ {
+				if before, ok := strings.CutSuffix(fields[j], "ms"); ok {
+					latencyStr := before
+					if parsed, err := time.ParseDuration(latencyStr + "ms"); err == nil {
+						switch j - 2 {
+						case 0:
+							hop.Latency1 = parsed
+						case 1:
+							hop.Latency2 = parsed
+						case 2:
+							hop.Latency3 = parsed
 						}
 					}
 				}
 			}
-
-			hops = append(hops, hop)
 		}
 
-		return TracerouteMsg(TracerouteResult{
-			Target: target,
-			Hops:   hops,
-		})
+		hops = append(hops, hop)
+	}
+
+	return TracerouteResult{
+		Target: target,
+		Hops:   hops,
 	}
 }
 
-func InstallTraceroute(target string, sudoPassword string) tea.Cmd {
-	return func() tea.Msg {
-		var cmd *exec.Cmd
-
-		if _, err := exec.LookPath("apt-get"); err == nil {
-			// Try apt-get (Debian/Ubuntu)
-			cmd = exec.Command("sudo", "-S", "DEBIAN_FRONTEND=noninteractive", "apt-get", "install", "-y", "traceroute")
-		} else if _, err := exec.LookPath("yum"); err == nil {
-			// Try yum (CentOS/RHEL)
-			cmd = exec.Command("sudo", "-S", "yum", "install", "-y", "traceroute")
-		} else if _, err := exec.LookPath("dnf"); err == nil {
-			// Try dnf (Fedora)
-			cmd = exec.Command("sudo", "-S", "dnf", "install", "-y", "traceroute")
-		} else if _, err := exec.LookPath("pacman"); err == nil {
-			// Try pacman (Arch)
-			cmd = exec.Command("sudo", "-S", "pacman", "-S", "--noconfirm", "traceroute")
-		} else if _, err := exec.LookPath("apk"); err == nil {
-			// Try apk (Alpine)
-			cmd = exec.Command("sudo", "-S", "apk", "add", "traceroute")
-		} else {
-			return TracerouteInstallResultMsg{
-				Success: false,
-				Target:  target,
-				Error:   "Could not detect package manager. Please install traceroute manually.",
-			}
+// ErrIncorrectPassword is returned by RunTracerouteInstall when sudo rejects
+// the password.
+var ErrIncorrectPassword = errors.New("Incorrect password. Please try again.")
+
+// RunTracerouteInstall installs traceroute with the package manager of the
+// system, giving sudoPassword to sudo when it is not empty.
+func RunTracerouteInstall(ctx context.Context, sudoPassword string) error {
+	var cmd *exec.Cmd
+
+	if _, err := exec.LookPath("apt-get"); err == nil {
+		// Try apt-get (Debian/Ubuntu)
+		cmd = exec.CommandContext(ctx, "sudo", "-S", "DEBIAN_FRONTEND=noninteractive", "apt-get", "install", "-y", "traceroute")
+	} else if _, err := exec.LookPath("yum"); err == nil {
+		// Try yum (CentOS/RHEL)
+		cmd = exec.CommandContext(ctx, "sudo", "-S", "yum", "install", "-y", "traceroute")
+	} else if _, err := exec.LookPath("dnf"); err == nil {
+		// Try dnf (Fedora)
+		cmd = exec.CommandContext(ctx, "sudo", "-S", "dnf", "install", "-y", "traceroute")
+	} else if _, err := exec.LookPath("pacman"); err == nil {
+		// Try pacman (Arch)
+		cmd = exec.CommandContext(ctx, "sudo", "-S", "pacman", "-S", "--noconfirm", "traceroute")
+	} else if _, err := exec.LookPath("apk"); err == nil {
+		// Try apk (Alpine)
+		cmd = exec.CommandContext(ctx, "sudo", "-S", "apk", "add", "traceroute")
+	} else {
+		return errors.New("Could not detect package manager. Please install traceroute manually.")
+	}
+
+	// Provide sudo password via stdin and close it properly
+	if sudoPassword != "" {
+		stdin, err := cmd.StdinPipe()
+		if err != nil {
+			return fmt.Errorf("Failed to create stdin pipe: %w", err)
 		}
 
-		// Provide sudo password via stdin and close it properly
-		if sudoPassword != "" {
-			stdin, err := cmd.StdinPipe()
-			if err != nil {
-				return TracerouteInstallResultMsg{
-					Success: false,
-					Target:  target,
-					Error:   fmt.Sprintf("Failed to create stdin pipe: %v", err),
-				}
-			}
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			stdin.Close()
+			return fmt.Errorf("Failed to create stdout pipe: %w", err)
+		}
+		stderr, err := cmd.StderrPipe()
+		if err != nil {
+			stdin.Close()
+			return fmt.Errorf("Failed to create stderr pipe: %w", err)
+		}
 
-			stdout, err := cmd.StdoutPipe()
-			if err != nil {
-				stdin.Close()
-				return TracerouteInstallResultMsg{
-					Success: false,
-					Target:  target,
-					Error:   fmt.Sprintf("Failed to create stdout pipe: %v", err),
-				}
-			}
-			stderr, err := cmd.StderrPipe()
-			if err != nil {
-				stdin.Close()
-				return TracerouteInstallResultMsg{
-					Success: false,
-					Target:  target,
-					Error:   fmt.Sprintf("Failed to create stderr pipe: %v", err),
-				}
-			}
+		// Start the command
+		if err := cmd.Start(); err != nil {
+			return fmt.Errorf("Failed to start command: %w", err)
+		}
 
-			// Start the command
-			if err := cmd.Start(); err != nil {
-				return TracerouteInstallResultMsg{
-					Success: false,
-					Target:  target,
-					Error:   fmt.Sprintf("Failed to start command: %v", err),
-				}
-			}
+		// Write password and close stdin immediately
+		_, err = stdin.Write([]byte(sudoPassword + "\n"))
+		stdin.Close()
+		if err != nil {
+			return fmt.Errorf("Failed to write password: %w", err)
+		}
 
-			// Write password and close stdin immediately
-			_, err = stdin.Write([]byte(sudoPassword + "\n"))
-			stdin.Close()
-			if err != nil {
-				return TracerouteInstallResultMsg{
-					Success: false,
-					Target:  target,
-					Error:   fmt.Sprintf("Failed to write password: %v", err),
+		var stderrBuf strings.Builder
+		go func() {
+			var buf [4096]byte
+			for {
+				n, err := stdout.Read(buf[:])
+				if err != nil {
+					break
 				}
+				_ = n
 			}
-
-			var stderrBuf strings.Builder
-			go func() {
-				var buf [4096]byte
-				for {
-					n, err := stdout.Read(buf[:])
-					if err != nil {
-						break
-					}
-					_ = n
-				}
-			}()
-			go func() {
-				var buf [4096]byte
-				for {
-					n, err := stderr.Read(buf[:])
-					if err != nil {
-						break
-					}
-					stderrBuf.Write(buf[:n])
-				}
-			}()
-
-			// Wait for command to finish
-			err = cmd.Wait()
-			if err != nil {
-				stderrOutput := stderrBuf.String()
-				if strings.Contains(stderrOutput, "Sorry, try again") ||
-					strings.Contains(stderrOutput, "incorrect password") ||
-					strings.Contains(stderrOutput, "authentication failure") ||
-					strings.Contains(err.Error(), "exit status 1") && strings.Contains(stderrOutput, "sudo") {
-					return TracerouteInstallResultMsg{
-						Success:         false,
-						Target:          target,
-						Error:           "Incorrect password. Please try again.",
-						PasswordInvalid: true,
-					}
-				}
-				return TracerouteInstallResultMsg{
-					Success: false,
-					Target:  target,
-					Error:   fmt.Sprintf("Installation failed: %v\n%s", err, stderrOutput),
+		}()
+		go func() {
+			var buf [4096]byte
+			for {
+				n, err := stderr.Read(buf[:])
+				if err != nil {
+					break
 				}
+				stderrBuf.Write(buf[:n])
 			}
-		} else {
-			output, err := cmd.CombinedOutput()
-			if err != nil {
-				return TracerouteInstallResultMsg{
-					Success: false,
-					Target:  target,
-					Error:   fmt.Sprintf("Installation failed: %v\n%s", err, string(output)),
-				}
+		}()
+
+		// Wait for command to finish
+		err = cmd.Wait()
+		if err != nil {
+			stderrOutput := stderrBuf.String()
+			if strings.Contains(stderrOutput, "Sorry, try again") ||
+				strings.Contains(stderrOutput, "incorrect password") ||
+				strings.Contains(stderrOutput, "authentication failure") ||
+				strings.Contains(err.Error(), "exit status 1") && strings.Contains(stderrOutput, "sudo") {
+				return ErrIncorrectPassword
 			}
+			return fmt.Errorf("Installation failed: %v\n%s", err, stderrOutput)
 		}
-
-		return TracerouteInstallResultMsg{
-			Success: true,
-			Target:  target,
+	} else {
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("Installation failed: %v\n%s", err, string(output))
 		}
 	}
-}
-
-// SpeedTest runs a complete network speed test
-func SpeedTest() tea.Cmd {
-	return RunSpeedTest()
-}
 
-// SpeedTestWithProgress runs a speed test with progress updates
-func SpeedTestWithProgress() tea.Cmd {
-	return RunSpeedTestWithProgress(nil)
+	return nil
 }
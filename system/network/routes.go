@@ -2,32 +2,31 @@ package network
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/System-Pulse/server-pulse/utils"
-	tea "github.com/charmbracelet/bubbletea"
 )
 
-func GetRoutes() tea.Cmd {
-	return func() tea.Msg {
-		var routes []RouteInfo
-
-		ipv4Routes, err := readIPv4Routes()
-		if err != nil {
-			return utils.ErrMsg(fmt.Errorf("failed to read IPv4 routes: %w", err))
-		}
-		routes = append(routes, ipv4Routes...)
+// CollectRoutes lists the IPv4 and IPv6 routes of the kernel routing tables.
+func CollectRoutes(ctx context.Context) ([]RouteInfo, error) {
+	var routes []RouteInfo
 
-		ipv6Routes, err := readIPv6Routes()
-		if err != nil {
-			return utils.ErrMsg(fmt.Errorf("failed to read IPv6 routes: %w", err))
-		}
-		routes = append(routes, ipv6Routes...)
+	ipv4Routes, err := readIPv4Routes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read IPv4 routes: %w", err)
+	}
+	routes = append(routes, ipv4Routes...)
 
-		return RoutesMsg(routes)
+	ipv6Routes, err := readIPv6Routes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read IPv6 routes: %w", err)
 	}
+	routes = append(routes, ipv6Routes...)
+
+	return routes, nil
 }
 
 func readIPv4Routes() ([]RouteInfo, error) {
@@ -9,30 +9,43 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
-
-	tea "github.com/charmbracelet/bubbletea"
 )
 
 // =============================================================================
 // Constants and Configuration
 // =============================================================================
 
+// SpeedTestServers are the endpoints used by the speed test.
+type SpeedTestServers struct {
+	DownloadURL string
+	UploadURL   string
+	PingURL     string
+}
+
+// SpeedTestURLs can be overridden from the configuration file.
+var SpeedTestURLs = DefaultSpeedTestServers()
+
+func DefaultSpeedTestServers() SpeedTestServers {
+	return SpeedTestServers{
+		// Cloudflare's speed test files (reliable CDN), 25MB chunk
+		DownloadURL: "https://speed.cloudflare.com/__down?bytes=26214400",
+		// httpbin's /post endpoint echoes back uploaded data
+		UploadURL: "https://httpbin.org/post",
+		PingURL:   "https://speed.cloudflare.com/__down?bytes=0",
+	}
+}
+
 const (
 	// Download test configuration
-	// Using Cloudflare's speed test files (reliable CDN)
-	downloadURL          = "https://speed.cloudflare.com/__down?bytes=26214400" // 25MB chunk
-	downloadConcurrency  = 4                                                    // Number of parallel download goroutines
-	downloadTestDuration = 10 * time.Second                                     // Maximum test duration
+	downloadConcurrency  = 4                // Number of parallel download goroutines
+	downloadTestDuration = 10 * time.Second // Maximum test duration
 
 	// Upload test configuration
-	// Using httpbin's /post endpoint for uploads (echoes back data)
-	uploadURL          = "https://httpbin.org/post"
 	uploadConcurrency  = 4                // Number of parallel upload goroutines
 	uploadChunkSize    = 1 * 1024 * 1024  // 1MB per upload chunk
 	uploadTestDuration = 10 * time.Second // Maximum test duration
 
 	// Ping test configuration
-	pingURL   = "https://speed.cloudflare.com/__down?bytes=0"
 	pingCount = 5 // Number of ping requests
 
 	// HTTP client configuration
@@ -56,10 +69,10 @@ type SpeedTestResult struct {
 	TestDuration time.Duration
 }
 
-// SpeedTestMsg is a tea.Msg that contains speed test results
+// SpeedTestMsg contains speed test results
 type SpeedTestMsg SpeedTestResult
 
-// SpeedTestProgressMsg is a tea.Msg that contains progress updates during the test
+// SpeedTestProgressMsg contains progress updates during the test
 type SpeedTestProgressMsg struct {
 	Stage       string  // "ping", "download", "upload"
 	Progress    float64 // 0.0 to 1.0
@@ -67,7 +80,7 @@ type SpeedTestProgressMsg struct {
 	CurrentMbps float64 // Current speed for download/upload stages
 }
 
-// SpeedTestErrorMsg is a tea.Msg for speed test errors
+// SpeedTestErrorMsg reports a failed speed test
 type SpeedTestErrorMsg struct {
 	Error string
 }
@@ -103,13 +116,16 @@ type SpeedTestPingResult struct {
 
 // testPing performs latency measurements to the specified URL.
 // It makes multiple requests and calculates min, max, and average RTT.
-func testPing(client *http.Client, url string, count int) (*SpeedTestPingResult, error) {
+func testPing(ctx context.Context, client *http.Client, url string, count int) (*SpeedTestPingResult, error) {
 	var samples []time.Duration
 
 	for range count {
+		if ctx.Err() != nil {
+			break
+		}
 		start := time.Now()
 
-		req, err := http.NewRequest(http.MethodHead, url, nil)
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
 		if err != nil {
 			// Skip failed request creation, try next
 			continue
@@ -212,11 +228,11 @@ func downloadWorker(ctx context.Context, client *http.Client, url string, bytesD
 
 // testDownload measures download speed using parallel connections.
 // Returns the speed in Mbps (megabits per second).
-func testDownload(client *http.Client, url string, concurrency int, duration time.Duration, progressChan chan<- int64) (float64, error) {
+func testDownload(ctx context.Context, client *http.Client, url string, concurrency int, duration time.Duration, progressChan chan<- int64) (float64, error) {
 	var bytesDownloaded int64
 	var wg sync.WaitGroup
 
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(ctx, duration)
 	defer cancel()
 
 	// Start download workers
@@ -312,11 +328,11 @@ func uploadWorker(ctx context.Context, client *http.Client, url string, chunkSiz
 
 // testUpload measures upload speed using parallel connections.
 // Returns the speed in Mbps (megabits per second).
-func testUpload(client *http.Client, url string, concurrency int, chunkSize int, duration time.Duration, progressChan chan<- int64) (float64, error) {
+func testUpload(ctx context.Context, client *http.Client, url string, concurrency int, chunkSize int, duration time.Duration, progressChan chan<- int64) (float64, error) {
 	var bytesUploaded int64
 	var wg sync.WaitGroup
 
-	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	ctx, cancel := context.WithTimeout(ctx, duration)
 	defer cancel()
 
 	// Start upload workers
@@ -347,7 +363,7 @@ func testUpload(client *http.Client, url string, concurrency int, chunkSize int,
 // =============================================================================
 
 // selectServer verifies server connectivity with retries.
-func selectServer(client *http.Client, url string, maxRetries int) error {
+func selectServer(ctx context.Context, client *http.Client, url string, maxRetries int) error {
 	for attempt := 1; attempt <= maxRetries; attempt++ {
 		// Use GET instead of HEAD for better compatibility with CDN servers
 		req, err := http.NewRequest(http.MethodGet, url, nil)
@@ -356,8 +372,8 @@ func selectServer(client *http.Client, url string, maxRetries int) error {
 		}
 
 		// Use a short timeout for server selection
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		req = req.WithContext(ctx)
+		attemptCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		req = req.WithContext(attemptCtx)
 
 		resp, err := client.Do(req)
 		if err != nil {
@@ -389,128 +405,52 @@ func selectServer(client *http.Client, url string, maxRetries int) error {
 // Main Speed Test Function
 // =============================================================================
 
-// RunSpeedTest performs a complete network speed test and returns results as tea.Msg
-func RunSpeedTest() tea.Cmd {
-	return func() tea.Msg {
-		startTime := time.Now()
-
-		// Create HTTP client with timeout
-		client := createHTTPClient(httpTimeout)
-
-		// Server selection
-		if err := selectServer(client, pingURL, 3); err != nil {
-			return SpeedTestErrorMsg{Error: fmt.Sprintf("Server selection failed: %v", err)}
-		}
-
-		// Ping test
-		pingResult, err := testPing(client, pingURL, pingCount)
-		if err != nil {
-			return SpeedTestErrorMsg{Error: fmt.Sprintf("Ping test failed: %v", err)}
-		}
-
-		// Download test
-		downloadMbps, err := testDownload(client, downloadURL, downloadConcurrency, downloadTestDuration, nil)
-		if err != nil {
-			return SpeedTestErrorMsg{Error: fmt.Sprintf("Download test failed: %v", err)}
-		}
-
-		// Upload test
-		uploadMbps, err := testUpload(client, uploadURL, uploadConcurrency, uploadChunkSize, uploadTestDuration, nil)
-		if err != nil {
-			return SpeedTestErrorMsg{Error: fmt.Sprintf("Upload test failed: %v", err)}
-		}
-
-		testDuration := time.Since(startTime)
-
-		return SpeedTestMsg(SpeedTestResult{
-			PingResult:   pingResult,
-			DownloadMbps: downloadMbps,
-			UploadMbps:   uploadMbps,
-			Server:       "Cloudflare Speed Test",
-			TestDuration: testDuration,
-		})
-	}
-}
-
-// RunSpeedTestWithProgress performs a speed test with progress updates
-func RunSpeedTestWithProgress(progressChan chan<- SpeedTestProgressMsg) tea.Cmd {
-	return func() tea.Msg {
-		startTime := time.Now()
-
-		// Create HTTP client with timeout
-		client := createHTTPClient(httpTimeout)
-
-		// Server selection
+// MeasureSpeed performs a complete network speed test, reporting each stage
+// on progressChan when it is not nil.
+func MeasureSpeed(ctx context.Context, progressChan chan<- SpeedTestProgressMsg) (SpeedTestResult, error) {
+	startTime := time.Now()
+	progress := func(stage string, value float64, message string) {
 		if progressChan != nil {
-			progressChan <- SpeedTestProgressMsg{
-				Stage:    "server",
-				Progress: 0.1,
-				Message:  "Selecting server...",
-			}
-		}
-
-		if err := selectServer(client, pingURL, 3); err != nil {
-			return SpeedTestErrorMsg{Error: fmt.Sprintf("Server selection failed: %v", err)}
-		}
-
-		// Ping test
-		if progressChan != nil {
-			progressChan <- SpeedTestProgressMsg{
-				Stage:    "ping",
-				Progress: 0.2,
-				Message:  "Testing latency...",
-			}
-		}
-
-		pingResult, err := testPing(client, pingURL, pingCount)
-		if err != nil {
-			return SpeedTestErrorMsg{Error: fmt.Sprintf("Ping test failed: %v", err)}
-		}
-
-		// Download test with progress
-		if progressChan != nil {
-			progressChan <- SpeedTestProgressMsg{
-				Stage:    "download",
-				Progress: 0.4,
-				Message:  "Testing download speed...",
-			}
-		}
-
-		downloadMbps, err := testDownload(client, downloadURL, downloadConcurrency, downloadTestDuration, nil)
-		if err != nil {
-			return SpeedTestErrorMsg{Error: fmt.Sprintf("Download test failed: %v", err)}
+			progressChan <- SpeedTestProgressMsg{Stage: stage, Progress: value, Message: message}
 		}
+	}
 
-		// Upload test
-		if progressChan != nil {
-			progressChan <- SpeedTestProgressMsg{
-				Stage:    "upload",
-				Progress: 0.7,
-				Message:  "Testing upload speed...",
-			}
-		}
+	// Create HTTP client with timeout
+	client := createHTTPClient(httpTimeout)
 
-		uploadMbps, err := testUpload(client, uploadURL, uploadConcurrency, uploadChunkSize, uploadTestDuration, nil)
-		if err != nil {
-			return SpeedTestErrorMsg{Error: fmt.Sprintf("Upload test failed: %v", err)}
-		}
+	// Server selection
+	progress("server", 0.1, "Selecting server...")
+	if err := selectServer(ctx, client, SpeedTestURLs.PingURL, 3); err != nil {
+		return SpeedTestResult{}, fmt.Errorf("Server selection failed: %w", err)
+	}
 
-		testDuration := time.Since(startTime)
+	// Ping test
+	progress("ping", 0.2, "Testing latency...")
+	pingResult, err := testPing(ctx, client, SpeedTestURLs.PingURL, pingCount)
+	if err != nil {
+		return SpeedTestResult{}, fmt.Errorf("Ping test failed: %w", err)
+	}
 
-		if progressChan != nil {
-			progressChan <- SpeedTestProgressMsg{
-				Stage:    "complete",
-				Progress: 1.0,
-				Message:  "Speed test completed",
-			}
-		}
+	// Download test
+	progress("download", 0.4, "Testing download speed...")
+	downloadMbps, err := testDownload(ctx, client, SpeedTestURLs.DownloadURL, downloadConcurrency, downloadTestDuration, nil)
+	if err != nil {
+		return SpeedTestResult{}, fmt.Errorf("Download test failed: %w", err)
+	}
 
-		return SpeedTestMsg(SpeedTestResult{
-			PingResult:   pingResult,
-			DownloadMbps: downloadMbps,
-			UploadMbps:   uploadMbps,
-			Server:       "Cloudflare Speed Test",
-			TestDuration: testDuration,
-		})
+	// Upload test
+	progress("upload", 0.7, "Testing upload speed...")
+	uploadMbps, err := testUpload(ctx, client, SpeedTestURLs.UploadURL, uploadConcurrency, uploadChunkSize, uploadTestDuration, nil)
+	if err != nil {
+		return SpeedTestResult{}, fmt.Errorf("Upload test failed: %w", err)
 	}
+
+	progress("complete", 1.0, "Speed test completed")
+	return SpeedTestResult{
+		PingResult:   pingResult,
+		DownloadMbps: downloadMbps,
+		UploadMbps:   uploadMbps,
+		Server:       "Cloudflare Speed Test",
+		TestDuration: time.Since(startTime),
+	}, nil
 }
@@ -0,0 +1,34 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/network"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectivityInvalidTarget(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		target string
+	}{
+		{"Empty", ""},
+		{"Shell metacharacters", "example.com; rm -rf /"},
+		{"Option", "-f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ping := network.RunPing(context.Background(), tt.target, 3)
+			assert.False(t, ping.Success)
+			assert.Contains(t, ping.Error, "Invalid target")
+
+			trace := network.RunTraceroute(context.Background(), tt.target)
+			assert.Empty(t, trace.Hops)
+			assert.Contains(t, trace.Error, "Invalid target")
+		})
+	}
+}
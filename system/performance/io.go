@@ -2,6 +2,7 @@ package performance
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"sort"
@@ -9,28 +10,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/System-Pulse/server-pulse/utils"
-	"github.com/System-Pulse/server-pulse/widgets/model"
-	"github.com/System-Pulse/server-pulse/widgets/vars"
-	"github.com/charmbracelet/bubbles/table"
-	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 	"github.com/shirou/gopsutil/v4/process"
 )
 
-// GetIOMetrics collects comprehensive I/O performance metrics
-func GetIOMetrics() tea.Cmd {
-	return func() tea.Msg {
-		metrics, err := collectIOMetrics()
-		return IOMetricsMsg{
-			Metrics: metrics,
-			Error:   err,
-		}
-	}
-}
-
-func collectIOMetrics() (*model.IOMetrics, error) {
-	metrics := &model.IOMetrics{
+// CollectIOMetrics reads cumulative disk statistics from /proc/diskstats and
+// the processes with the most I/O.
+func CollectIOMetrics(ctx context.Context) (*IOMetrics, error) {
+	metrics := &IOMetrics{
 		LastUpdate: time.Now(),
 	}
 
@@ -50,7 +36,7 @@ func collectIOMetrics() (*model.IOMetrics, error) {
 	}
 
 	// Collect top I/O processes
-	processes, err := getTopIOProcesses()
+	processes, err := getTopIOProcesses(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get process I/O stats: %v", err)
 	}
@@ -72,14 +58,14 @@ func collectIOMetrics() (*model.IOMetrics, error) {
 	return metrics, nil
 }
 
-func getDiskIOStats() ([]model.DiskIOInfo, error) {
+func getDiskIOStats() ([]DiskIOInfo, error) {
 	file, err := os.Open("/proc/diskstats")
 	if err != nil {
 		return nil, err
 	}
 	defer file.Close()
 
-	var disks []model.DiskIOInfo
+	var disks []DiskIOInfo
 	scanner := bufio.NewScanner(file)
 
 	for scanner.Scan() {
@@ -122,7 +108,7 @@ func getDiskIOStats() ([]model.DiskIOInfo, error) {
 			utilization = float64(ioTime) / 10.0 // Convert from 10ms units to percentage
 		}
 
-		disk := model.DiskIOInfo{
+		disk := DiskIOInfo{
 			Device:      device,
 			ReadIOPS:    reads,
 			WriteIOPS:   writes,
@@ -147,28 +133,28 @@ func getDiskIOStats() ([]model.DiskIOInfo, error) {
 	return disks, scanner.Err()
 }
 
-func getTopIOProcesses() ([]model.ProcessIOInfo, error) {
-	processes, err := process.Processes()
+func getTopIOProcesses(ctx context.Context) ([]ProcessIOInfo, error) {
+	processes, err := process.ProcessesWithContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var ioProcesses []model.ProcessIOInfo
+	var ioProcesses []ProcessIOInfo
 
 	for _, p := range processes {
 		// Get I/O counters
-		ioCounters, err := p.IOCounters()
+		ioCounters, err := p.IOCountersWithContext(ctx)
 		if err != nil {
 			continue // Skip processes we can't access
 		}
 
 		// Get process name
-		name, err := p.Name()
+		name, err := p.NameWithContext(ctx)
 		if err != nil {
 			name = "unknown"
 		}
 
-		ioProcess := model.ProcessIOInfo{
+		ioProcess := ProcessIOInfo{
 			PID:        p.Pid,
 			Command:    name,
 			ReadIOPS:   ioCounters.ReadCount,
@@ -197,206 +183,3 @@ func getTopIOProcesses() ([]model.ProcessIOInfo, error) {
 
 	return ioProcesses, nil
 }
-
-func RenderInputOutput() string {
-	return vars.CardStyle.Render("⏳ Loading I/O Performance Metrics...")
-}
-
-func RenderInputOutputWithData(metrics *model.IOMetrics, loading bool) string {
-	if loading {
-		return vars.CardStyle.Render("⏳ Loading I/O Performance Metrics...")
-	}
-
-	if metrics == nil {
-		return vars.CardStyle.Render("⏳ Loading I/O Performance Metrics...")
-	}
-
-	var b strings.Builder
-
-	// Title
-	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(vars.AccentColor).MarginBottom(1)
-	b.WriteString(titleStyle.Render("─ I/O PERFORMANCE ANALYSIS ─"))
-	b.WriteString("\n")
-
-	// Summary section
-	b.WriteString(lipgloss.NewStyle().Bold(true).Render("📊 I/O SUMMARY"))
-	b.WriteString("\n\n")
-
-	// Average latency with color coding
-	latencyColor := lipgloss.Color("46") // Green by default
-	latencyIcon := "✅"
-	if metrics.AverageLatency > 20 {
-		latencyColor = lipgloss.Color("196") // Red for high latency
-		latencyIcon = "🔴"
-	} else if metrics.AverageLatency > 10 {
-		latencyColor = lipgloss.Color("214") // Orange for medium latency
-		latencyIcon = "🟡"
-	}
-
-	latencyText := lipgloss.NewStyle().Foreground(latencyColor).Bold(true).Render(
-		fmt.Sprintf("%s %.2f ms", latencyIcon, metrics.AverageLatency),
-	)
-	b.WriteString(fmt.Sprintf("│ Average Latency: %s     ", latencyText))
-
-	// Last update
-	lastUpdateText := lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render(
-		metrics.LastUpdate.Format("15:04:05"),
-	)
-	b.WriteString(fmt.Sprintf("│    Last Update:     %s\n", lastUpdateText))
-	b.WriteString("\n")
-
-	// Create summary table
-	summaryColumns := []table.Column{
-		{Title: "Total Read IOPS", Width: 16},
-		{Title: "Total Write IOPS", Width: 17},
-		{Title: "Total Read", Width: 15},
-		{Title: "Total Write", Width: 16},
-	}
-
-	summaryRows := []table.Row{
-		{
-			formatNumber(metrics.TotalReadIOPS),
-			formatNumber(metrics.TotalWriteIOPS),
-			utils.FormatBytes(metrics.TotalReadBytes),
-			utils.FormatBytes(metrics.TotalWriteBytes),
-		},
-	}
-
-	summaryTable := table.New(
-		table.WithColumns(summaryColumns),
-		table.WithRows(summaryRows),
-		table.WithFocused(false),
-		table.WithHeight(2),
-	)
-
-	// Style the summary table
-	summaryStyle := table.DefaultStyles()
-	summaryStyle.Header = summaryStyle.Header.
-		BorderStyle(lipgloss.NormalBorder()).
-		BorderBottom(true).
-		Bold(true).
-		Foreground(lipgloss.Color("39"))
-
-	summaryStyle.Cell = summaryStyle.Cell.
-		Foreground(lipgloss.Color("255"))
-
-	summaryTable.SetStyles(summaryStyle)
-
-	b.WriteString(summaryTable.View())
-	b.WriteString("\n\n")
-
-	// Disk I/O table
-	if len(metrics.Disks) > 0 {
-		b.WriteString(lipgloss.NewStyle().Bold(true).Render("💾 DISK I/O PERFORMANCE"))
-		b.WriteString("\n\n")
-
-		// Create table
-		columns := []table.Column{
-			{Title: "Device", Width: 8},
-			{Title: "Read IOPS", Width: 10},
-			{Title: "Write IOPS", Width: 11},
-			{Title: "Read MB/s", Width: 10},
-			{Title: "Write MB/s", Width: 11},
-			{Title: "Util%", Width: 6},
-			{Title: "Queue", Width: 6},
-		}
-
-		var rows []table.Row
-		for _, disk := range metrics.Disks {
-			readMBps := float64(disk.ReadBytes) / (1024 * 1024)
-			writeMBps := float64(disk.WriteBytes) / (1024 * 1024)
-
-			rows = append(rows, table.Row{
-				disk.Device,
-				formatNumber(disk.ReadIOPS),
-				formatNumber(disk.WriteIOPS),
-				fmt.Sprintf("%.1f", readMBps),
-				fmt.Sprintf("%.1f", writeMBps),
-				fmt.Sprintf("%.1f", disk.Utilization),
-				formatNumber(disk.QueueDepth),
-			})
-		}
-
-		t := table.New(
-			table.WithColumns(columns),
-			table.WithRows(rows),
-			table.WithFocused(false),
-			table.WithHeight(min(8, len(rows)+1)),
-		)
-
-		s := table.DefaultStyles()
-		s.Header = s.Header.
-			BorderStyle(lipgloss.NormalBorder()).
-			BorderBottom(true).
-			Bold(false).
-			Foreground(lipgloss.Color("39"))
-
-		s.Cell = s.Cell.
-			Foreground(lipgloss.Color("255"))
-		t.SetStyles(s)
-
-		b.WriteString(t.View())
-		b.WriteString("\n\n")
-	}
-
-	// Top I/O Processes
-	if len(metrics.TopProcesses) > 0 {
-		b.WriteString(lipgloss.NewStyle().Bold(true).Render("🔥 TOP I/O PROCESSES"))
-		b.WriteString("\n\n")
-
-		columns := []table.Column{
-			{Title: "PID", Width: 6},
-			{Title: "Command", Width: 20},
-			{Title: "Read IOPS", Width: 10},
-			{Title: "Write IOPS", Width: 11},
-			{Title: "Read MB", Width: 10},
-			{Title: "Write MB", Width: 11},
-		}
-
-		var rows []table.Row
-		for _, proc := range metrics.TopProcesses {
-			readMB := float64(proc.ReadBytes) / (1024 * 1024)
-			writeMB := float64(proc.WriteBytes) / (1024 * 1024)
-
-			rows = append(rows, table.Row{
-				fmt.Sprintf("%d", proc.PID),
-				utils.Ellipsis(proc.Command, 18),
-				formatNumber(proc.ReadIOPS),
-				formatNumber(proc.WriteIOPS),
-				fmt.Sprintf("%.1f", readMB),
-				fmt.Sprintf("%.1f", writeMB),
-			})
-		}
-
-		t := table.New(
-			table.WithColumns(columns),
-			table.WithRows(rows),
-			table.WithFocused(false),
-			table.WithHeight(min(6, len(rows)+1)),
-		)
-
-		s := table.DefaultStyles()
-		s.Header = s.Header.
-			BorderStyle(lipgloss.NormalBorder()).
-			BorderBottom(true).
-			Bold(false).
-			Foreground(lipgloss.Color("39"))
-
-		s.Cell = s.Cell.
-			Foreground(lipgloss.Color("255"))
-
-		t.SetStyles(s)
-
-		b.WriteString(t.View())
-		b.WriteString("\n\n")
-	}
-
-	return vars.CardStyle.Render(b.String())
-}
-
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
@@ -2,70 +2,77 @@ package performance
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/shirou/gopsutil/v4/cpu"
 )
 
-func GetHealthMetrics() tea.Cmd {
-	return func() tea.Msg {
-		// This introduces a 1-second delay.
-		cpuTimes1, err := cpu.Times(false)
-		if err != nil || len(cpuTimes1) == 0 {
-			return HealthMetricsMsg{nil, nil}
-		}
-
-		time.Sleep(time.Second)
-
-		cpuTimes2, err := cpu.Times(false)
-		if err != nil || len(cpuTimes2) == 0 {
-			return HealthMetricsMsg{nil, nil}
-		}
+// CollectHealthMetrics samples CPU times one second apart to derive IOWait
+// and steal time, and reads the kernel counters used by CalculateHealthScore.
+func CollectHealthMetrics(ctx context.Context) (*HealthMetrics, error) {
+	cpuTimes1, err := cpu.TimesWithContext(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CPU times: %v", err)
+	}
+	if len(cpuTimes1) == 0 {
+		return nil, fmt.Errorf("no CPU times reported")
+	}
 
-		totalDiff := (cpuTimes2[0].User - cpuTimes1[0].User) +
-			(cpuTimes2[0].System - cpuTimes1[0].System) +
-			(cpuTimes2[0].Idle - cpuTimes1[0].Idle) +
-			(cpuTimes2[0].Nice - cpuTimes1[0].Nice) +
-			(cpuTimes2[0].Iowait - cpuTimes1[0].Iowait) +
-			(cpuTimes2[0].Irq - cpuTimes1[0].Irq) +
-			(cpuTimes2[0].Softirq - cpuTimes1[0].Softirq) +
-			(cpuTimes2[0].Steal - cpuTimes1[0].Steal)
-
-		metrics := &HealthMetrics{}
-		if totalDiff > 0 {
-			metrics.IOWait = (cpuTimes2[0].Iowait - cpuTimes1[0].Iowait) / totalDiff * 100
-			metrics.StealTime = (cpuTimes2[0].Steal - cpuTimes1[0].Steal) / totalDiff * 100
-		}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(time.Second):
+	}
 
-		ctxt, err := getStatValue("/proc/stat", "ctxt")
-		if err == nil {
-			metrics.ContextSwitches = ctxt
-		}
+	cpuTimes2, err := cpu.TimesWithContext(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CPU times: %v", err)
+	}
+	if len(cpuTimes2) == 0 {
+		return nil, fmt.Errorf("no CPU times reported")
+	}
 
-		intr, err := getStatValue("/proc/stat", "intr")
-		if err == nil {
-			metrics.Interrupts = intr
-		}
+	totalDiff := (cpuTimes2[0].User - cpuTimes1[0].User) +
+		(cpuTimes2[0].System - cpuTimes1[0].System) +
+		(cpuTimes2[0].Idle - cpuTimes1[0].Idle) +
+		(cpuTimes2[0].Nice - cpuTimes1[0].Nice) +
+		(cpuTimes2[0].Iowait - cpuTimes1[0].Iowait) +
+		(cpuTimes2[0].Irq - cpuTimes1[0].Irq) +
+		(cpuTimes2[0].Softirq - cpuTimes1[0].Softirq) +
+		(cpuTimes2[0].Steal - cpuTimes1[0].Steal)
+
+	metrics := &HealthMetrics{}
+	if totalDiff > 0 {
+		metrics.IOWait = (cpuTimes2[0].Iowait - cpuTimes1[0].Iowait) / totalDiff * 100
+		metrics.StealTime = (cpuTimes2[0].Steal - cpuTimes1[0].Steal) / totalDiff * 100
+	}
 
-		pgfault, err := getStatValue("/proc/vmstat", "pgfault")
-		if err == nil {
-			metrics.MinorFaults = pgfault
-		}
+	ctxt, err := getStatValue("/proc/stat", "ctxt")
+	if err == nil {
+		metrics.ContextSwitches = ctxt
+	}
 
-		pgmajfault, err := getStatValue("/proc/vmstat", "pgmajfault")
-		if err == nil {
-			metrics.MajorFaults = pgmajfault
-		}
+	intr, err := getStatValue("/proc/stat", "intr")
+	if err == nil {
+		metrics.Interrupts = intr
+	}
 
-		score := CalculateHealthScore(metrics)
+	pgfault, err := getStatValue("/proc/vmstat", "pgfault")
+	if err == nil {
+		metrics.MinorFaults = pgfault
+	}
 
-		return HealthMetricsMsg{metrics, score}
+	pgmajfault, err := getStatValue("/proc/vmstat", "pgmajfault")
+	if err == nil {
+		metrics.MajorFaults = pgmajfault
 	}
+
+	return metrics, nil
 }
 
 func formatNumber(n uint64) string {
@@ -108,7 +115,34 @@ func getStatValue(filePath, key string) (uint64, error) {
 	return 0, fmt.Errorf("key %s not found in %s", key, filePath)
 }
 
+// HealthThresholds are the limits CalculateHealthScore compares metrics to.
+// Percentages are of total CPU time; counters are totals since boot.
+type HealthThresholds struct {
+	IOWaitWarning   float64
+	IOWaitCritical  float64
+	StealWarning    float64
+	StealCritical   float64
+	MajorFaults     uint64
+	ContextSwitches uint64
+}
+
+// Thresholds can be overridden from the configuration file.
+var Thresholds = DefaultHealthThresholds()
+
+func DefaultHealthThresholds() HealthThresholds {
+	return HealthThresholds{
+		IOWaitWarning:   10,
+		IOWaitCritical:  20,
+		StealWarning:    5,
+		StealCritical:   10,
+		MajorFaults:     10000,
+		ContextSwitches: 100000000, // 100 million
+	}
+}
+
 func CalculateHealthScore(metrics *HealthMetrics) *HealthScore {
+	limits := Thresholds
+
 	score := 100
 	var issues []string
 	var recommendations []string
@@ -116,11 +150,11 @@ func CalculateHealthScore(metrics *HealthMetrics) *HealthScore {
 
 	// IOWait
 	checksPerformed = append(checksPerformed, "IOWait")
-	if metrics.IOWait > 20 {
+	if metrics.IOWait > limits.IOWaitCritical {
 		score -= 30
 		issues = append(issues, fmt.Sprintf("IOWait very high (%.2f%%)", metrics.IOWait))
 		recommendations = append(recommendations, "Investigate disk activity, upgrade to faster storage (SSD/NVMe)")
-	} else if metrics.IOWait > 10 {
+	} else if metrics.IOWait > limits.IOWaitWarning {
 		score -= 15
 		issues = append(issues, fmt.Sprintf("IOWait elevated (%.2f%%)", metrics.IOWait))
 		recommendations = append(recommendations, "Check for processes with high disk I/O, consider upgrading storage")
@@ -128,11 +162,11 @@ func CalculateHealthScore(metrics *HealthMetrics) *HealthScore {
 
 	// CPU Steal Time
 	checksPerformed = append(checksPerformed, "CPU Steal Time")
-	if metrics.StealTime > 10 {
+	if metrics.StealTime > limits.StealCritical {
 		score -= 20
 		issues = append(issues, fmt.Sprintf("CPU Steal Time high (%.2f%%)", metrics.StealTime))
 		recommendations = append(recommendations, "Check host machine load, consider migrating VM or upgrading host")
-	} else if metrics.StealTime > 5 {
+	} else if metrics.StealTime > limits.StealWarning {
 		score -= 10
 		issues = append(issues, fmt.Sprintf("CPU Steal Time elevated (%.2f%%)", metrics.StealTime))
 		recommendations = append(recommendations, "Monitor host machine performance")
@@ -140,7 +174,7 @@ func CalculateHealthScore(metrics *HealthMetrics) *HealthScore {
 
 	// Major Page Faults
 	checksPerformed = append(checksPerformed, "Major Page Faults")
-	if metrics.MajorFaults > 10000 {
+	if metrics.MajorFaults > limits.MajorFaults {
 		score -= 15
 		issues = append(issues, fmt.Sprintf("High number of major page faults (%s)", formatNumber(metrics.MajorFaults)))
 		recommendations = append(recommendations, "Investigate memory usage, consider increasing RAM")
@@ -148,7 +182,7 @@ func CalculateHealthScore(metrics *HealthMetrics) *HealthScore {
 
 	// Context Switches
 	checksPerformed = append(checksPerformed, "Context Switches")
-	if metrics.ContextSwitches > 100000000 { // 100 million
+	if metrics.ContextSwitches > limits.ContextSwitches {
 		score -= 10
 		issues = append(issues, fmt.Sprintf("High number of context switches (%s)", formatNumber(metrics.ContextSwitches)))
 		recommendations = append(recommendations, "Optimize multi-threaded applications, check for excessive context switching")
@@ -0,0 +1,355 @@
+package process
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/shirou/gopsutil/v4/net"
+)
+
+// ProcessDetails is everything /proc exposes about a single process. Sections
+// the caller is not allowed to read (other users' processes without root)
+// are left empty and explained in Warnings.
+type ProcessDetails struct {
+	PID         int32
+	Cmdline     string
+	Cwd         string
+	Exe         string
+	Environ     []string
+	Limits      []ProcessLimit
+	Files       []OpenFile
+	Sockets     []Socket
+	Memory      *MemoryRollup
+	IOPriority  *IOPriority
+	Cgroup      string
+	ContainerID string
+	Warnings    []string
+}
+
+type ProcessLimit struct {
+	Name  string
+	Soft  string
+	Hard  string
+	Units string
+}
+
+// OpenFile is a file descriptor and what it points to, e.g. a path,
+// "socket:[12345]" or "pipe:[6789]".
+type OpenFile struct {
+	FD     int
+	Target string
+}
+
+type Socket struct {
+	FD     uint32
+	Proto  string
+	Local  string
+	Remote string
+	State  string
+}
+
+// MemoryRollup is the summary of /proc/<pid>/smaps_rollup, in bytes.
+type MemoryRollup struct {
+	Rss          uint64
+	Pss          uint64
+	SharedClean  uint64
+	SharedDirty  uint64
+	PrivateClean uint64
+	PrivateDirty uint64
+	Swap         uint64
+	SwapPss      uint64
+}
+
+// CollectProcessDetails reads the detail view of pid. It only fails when the
+// process does not exist; unreadable sections become warnings.
+func CollectProcessDetails(ctx context.Context, pid int32) (*ProcessDetails, error) {
+	dir := filepath.Join("/proc", strconv.Itoa(int(pid)))
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("process %d: %w", pid, err)
+	}
+
+	details := &ProcessDetails{PID: pid}
+	warn := func(section string, err error) {
+		details.Warnings = append(details.Warnings, fmt.Sprintf("%s: %v", section, unwrapPathError(err)))
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, "cmdline")); err != nil {
+		warn("cmdline", err)
+	} else {
+		details.Cmdline = strings.TrimSpace(strings.ReplaceAll(string(data), "\x00", " "))
+	}
+
+	var err error
+	if details.Cwd, err = os.Readlink(filepath.Join(dir, "cwd")); err != nil {
+		warn("cwd", err)
+	}
+	if details.Exe, err = os.Readlink(filepath.Join(dir, "exe")); err != nil {
+		warn("exe", err)
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, "environ")); err != nil {
+		warn("environment", err)
+	} else {
+		for _, entry := range bytes.Split(data, []byte{0}) {
+			if len(entry) > 0 {
+				details.Environ = append(details.Environ, string(entry))
+			}
+		}
+		sort.Strings(details.Environ)
+	}
+
+	if f, err := os.Open(filepath.Join(dir, "limits")); err != nil {
+		warn("limits", err)
+	} else {
+		details.Limits, err = ParseLimits(f)
+		f.Close()
+		if err != nil {
+			warn("limits", err)
+		}
+	}
+
+	if details.Files, err = readOpenFiles(filepath.Join(dir, "fd")); err != nil {
+		warn("open files", err)
+	}
+
+	if conns, err := net.ConnectionsPidWithContext(ctx, "inet", pid); err != nil {
+		warn("sockets", err)
+	} else {
+		details.Sockets = sockets(conns)
+	}
+
+	if f, err := os.Open(filepath.Join(dir, "smaps_rollup")); err != nil {
+		warn("memory maps", err)
+	} else {
+		details.Memory, err = ParseSmapsRollup(f)
+		f.Close()
+		if err != nil {
+			warn("memory maps", err)
+		}
+	}
+
+	if priority, err := GetIOPriority(int(pid)); err != nil {
+		warn("I/O priority", err)
+	} else {
+		details.IOPriority = &priority
+	}
+
+	if f, err := os.Open(filepath.Join(dir, "cgroup")); err != nil {
+		warn("cgroup", err)
+	} else {
+		details.Cgroup, err = ParseCgroup(f)
+		f.Close()
+		if err != nil {
+			warn("cgroup", err)
+		}
+		details.ContainerID = ContainerIDFromCgroup(details.Cgroup)
+	}
+
+	return details, nil
+}
+
+// ParseLimits parses /proc/<pid>/limits. Columns are aligned with spaces and
+// limit names contain spaces themselves, so fields are cut at the header's
+// column offsets.
+func ParseLimits(r io.Reader) ([]ProcessLimit, error) {
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		return nil, scanner.Err()
+	}
+	header := scanner.Text()
+	soft := strings.Index(header, "Soft Limit")
+	hard := strings.Index(header, "Hard Limit")
+	units := strings.Index(header, "Units")
+	if soft < 0 || hard < soft || units < hard {
+		return nil, fmt.Errorf("unexpected limits header %q", header)
+	}
+
+	column := func(line string, from, to int) string {
+		if from >= len(line) {
+			return ""
+		}
+		return strings.TrimSpace(line[from:min(to, len(line))])
+	}
+
+	var limits []ProcessLimit
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		limits = append(limits, ProcessLimit{
+			Name:  column(line, 0, soft),
+			Soft:  column(line, soft, hard),
+			Hard:  column(line, hard, units),
+			Units: column(line, units, len(line)),
+		})
+	}
+	return limits, scanner.Err()
+}
+
+// ParseSmapsRollup parses /proc/<pid>/smaps_rollup, whose values are in kB.
+func ParseSmapsRollup(r io.Reader) (*MemoryRollup, error) {
+	rollup := &MemoryRollup{}
+	fields := map[string]*uint64{
+		"Rss":           &rollup.Rss,
+		"Pss":           &rollup.Pss,
+		"Shared_Clean":  &rollup.SharedClean,
+		"Shared_Dirty":  &rollup.SharedDirty,
+		"Private_Clean": &rollup.PrivateClean,
+		"Private_Dirty": &rollup.PrivateDirty,
+		"Swap":          &rollup.Swap,
+		"SwapPss":       &rollup.SwapPss,
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		target, known := fields[name]
+		if !known {
+			continue
+		}
+		kb, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimSpace(value), " kB"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		*target = kb * 1024
+	}
+	return rollup, scanner.Err()
+}
+
+// ParseCgroup returns the cgroup path of a process from /proc/<pid>/cgroup,
+// preferring the unified (v2) hierarchy and falling back to the v1 memory
+// or pids controller.
+func ParseCgroup(r io.Reader) (string, error) {
+	paths := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		for _, controller := range strings.Split(parts[1], ",") {
+			paths[controller] = parts[2]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	for _, controller := range []string{"", "memory", "pids", "cpu"} {
+		if path, ok := paths[controller]; ok {
+			return path, nil
+		}
+	}
+	for _, path := range paths {
+		return path, nil
+	}
+	return "", nil
+}
+
+var containerIDPattern = regexp.MustCompile(`(?:^|[/-])([0-9a-f]{64})(?:\.scope)?(?:/|$)`)
+
+// ContainerIDFromCgroup extracts the 64-character container ID used by
+// Docker, containerd and CRI-O cgroup paths such as
+// /system.slice/docker-<id>.scope or /docker/<id>.
+func ContainerIDFromCgroup(path string) string {
+	matches := containerIDPattern.FindAllStringSubmatch(path, -1)
+	if len(matches) == 0 {
+		return ""
+	}
+	// Nested runtimes list the innermost container last.
+	return matches[len(matches)-1][1]
+}
+
+// ContainerIDForPID resolves the container pid runs in from
+// /proc/<pid>/cgroup. It returns an empty string for host processes and
+// processes whose cgroup cannot be read.
+func ContainerIDForPID(pid int32) string {
+	f, err := os.Open(filepath.Join("/proc", strconv.Itoa(int(pid)), "cgroup"))
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	path, err := ParseCgroup(f)
+	if err != nil {
+		return ""
+	}
+	return ContainerIDFromCgroup(path)
+}
+
+func readOpenFiles(fdDir string) ([]OpenFile, error) {
+	entries, err := os.ReadDir(fdDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []OpenFile
+	for _, entry := range entries {
+		fd, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		target, err := os.Readlink(filepath.Join(fdDir, entry.Name()))
+		if err != nil {
+			// Closed between ReadDir and Readlink
+			continue
+		}
+		files = append(files, OpenFile{FD: fd, Target: target})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].FD < files[j].FD })
+	return files, nil
+}
+
+func sockets(conns []net.ConnectionStat) []Socket {
+	var result []Socket
+	for _, c := range conns {
+		proto := "tcp"
+		if c.Type == syscall.SOCK_DGRAM {
+			proto = "udp"
+		}
+		if c.Family == syscall.AF_INET6 {
+			proto += "6"
+		}
+
+		remote := ""
+		if c.Raddr.IP != "" {
+			remote = fmt.Sprintf("%s:%d", c.Raddr.IP, c.Raddr.Port)
+		}
+		result = append(result, Socket{
+			FD:     c.Fd,
+			Proto:  proto,
+			Local:  fmt.Sprintf("%s:%d", c.Laddr.IP, c.Laddr.Port),
+			Remote: remote,
+			State:  c.Status,
+		})
+	}
+	// Listening sockets first, then by descriptor
+	sort.SliceStable(result, func(i, j int) bool {
+		li, lj := result[i].State == "LISTEN", result[j].State == "LISTEN"
+		if li != lj {
+			return li
+		}
+		return result[i].FD < result[j].FD
+	})
+	return result
+}
+
+func unwrapPathError(err error) error {
+	if pathErr, ok := err.(*os.PathError); ok {
+		return pathErr.Err
+	}
+	return err
+}
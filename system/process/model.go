@@ -1,11 +1,41 @@
 package process
 
 type ProcessInfo struct {
-	PID     int32
-	User    string
-	CPU     float64
-	Mem     float64
-	Command string
+	PID        int32
+	PPID       int32
+	User       string
+	CPU        float64
+	Mem        float64
+	RSS        uint64 // resident set size in bytes
+	CreateTime int64  // milliseconds since the epoch
+	State      string
+	Threads    int32
+	Nice       int32
+	ReadBytes  uint64  // storage bytes read since start, 0 when /proc/<pid>/io is unreadable
+	WriteBytes uint64  // storage bytes written since start
+	ReadRate   float64 // bytes per second, filled in by FillIORates
+	WriteRate  float64
+	Command    string
+	Cmdline    string // full command line, empty for kernel threads
+	// ContainerID is the full 64-character ID of the container the process
+	// runs in, resolved from its cgroup, or empty for host processes.
+	ContainerID string
 }
 
 type ProcessMsg []ProcessInfo
+
+// ProcessOperationMsg reports the outcome of a signal, renice or ionice
+// request. Operation is one of "kill", "signal", "renice" or "ionice".
+type ProcessOperationMsg struct {
+	PID       int32
+	Operation string
+	Detail    string
+	Error     error
+}
+
+// ProcessDetailsMsg carries the detail view of a process. ContainerName is
+// filled in by callers that can reach the container runtime.
+type ProcessDetailsMsg struct {
+	Details       *ProcessDetails
+	ContainerName string
+}
@@ -1,74 +1,148 @@
 package process
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
-	"sort"
+	"slices"
 	"syscall"
 	"time"
 
-	"github.com/System-Pulse/server-pulse/utils"
-	tea "github.com/charmbracelet/bubbletea"
+	"github.com/shirou/gopsutil/v4/mem"
 	"github.com/shirou/gopsutil/v4/process"
 )
 
-func UpdateProcesses() tea.Cmd {
-	return func() tea.Msg {
-		processes, err := process.Processes()
-		if err != nil {
-			return utils.ErrMsg(err)
-		}
+// CollectProcesses returns every process on the system, unsorted. Processes
+// that exit while being inspected are reported with the fields read so far.
+func CollectProcesses(ctx context.Context) ([]ProcessInfo, error) {
+	processes, err := process.ProcessesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		var processList []ProcessInfo
-		for _, p := range processes {
-			name, _ := p.Name()
-			user, _ := p.Username()
-			cpu, _ := p.CPUPercent()
-			mem, _ := p.MemoryPercent()
-
-			processList = append(processList, ProcessInfo{
-				PID:     p.Pid,
-				User:    user,
-				CPU:     cpu,
-				Mem:     float64(mem),
-				Command: name,
-			})
+	// Read the memory total once instead of letting MemoryPercent read
+	// /proc/meminfo again for every process.
+	var totalMemory uint64
+	if vmem, err := mem.VirtualMemoryWithContext(ctx); err == nil {
+		totalMemory = vmem.Total
+	}
+
+	processList := make([]ProcessInfo, 0, len(processes))
+	for _, p := range processes {
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
 
-		// Sort by CPU to keep the most active processes when truncating
-		sort.Slice(processList, func(i, j int) bool {
-			return processList[i].CPU > processList[j].CPU
-		})
-		if len(processList) > 50 {
-			processList = processList[:50]
+		name, _ := p.NameWithContext(ctx)
+		user, _ := p.UsernameWithContext(ctx)
+		cpu, _ := p.CPUPercentWithContext(ctx)
+		created, _ := p.CreateTimeWithContext(ctx)
+		ppid, _ := p.PpidWithContext(ctx)
+		threads, _ := p.NumThreadsWithContext(ctx)
+		cmdline, _ := p.CmdlineWithContext(ctx)
+		nice, _ := p.NiceWithContext(ctx)
+
+		info := ProcessInfo{
+			PID:        p.Pid,
+			PPID:       ppid,
+			User:       user,
+			CPU:        cpu,
+			CreateTime: created,
+			Threads:    threads,
+			Nice:       nice,
+			Command:    name,
+			Cmdline:    cmdline,
+		}
+		if memInfo, err := p.MemoryInfoWithContext(ctx); err == nil {
+			info.RSS = memInfo.RSS
+			if totalMemory > 0 {
+				info.Mem = 100 * float64(memInfo.RSS) / float64(totalMemory)
+			}
 		}
+		if status, err := p.StatusWithContext(ctx); err == nil && len(status) > 0 {
+			info.State = status[0]
+		}
+		if io, err := p.IOCountersWithContext(ctx); err == nil {
+			info.ReadBytes = io.ReadBytes
+			info.WriteBytes = io.WriteBytes
+		}
+		info.ContainerID = ContainerIDForPID(p.Pid)
 
-		return ProcessMsg(processList)
+		processList = append(processList, info)
+	}
+
+	return processList, nil
+}
+
+// FillIORates sets the read and write rates of current from the counters of
+// the previous collection, elapsed ago. Processes that did not exist then,
+// or whose PID was reused since, keep a zero rate.
+func FillIORates(previous, current []ProcessInfo, elapsed time.Duration) {
+	if elapsed <= 0 {
+		return
+	}
+	before := make(map[int32]ProcessInfo, len(previous))
+	for _, p := range previous {
+		before[p.PID] = p
+	}
+
+	seconds := elapsed.Seconds()
+	for i := range current {
+		p := &current[i]
+		old, ok := before[p.PID]
+		if !ok || old.CreateTime != p.CreateTime || p.ReadBytes < old.ReadBytes || p.WriteBytes < old.WriteBytes {
+			continue
+		}
+		p.ReadRate = float64(p.ReadBytes-old.ReadBytes) / seconds
+		p.WriteRate = float64(p.WriteBytes-old.WriteBytes) / seconds
 	}
 }
 
 func StopProcess(pid int) error {
-	process, err := os.FindProcess(pid)
-	if err != nil {
-		return err
+	return StopProcesses([]int{pid})
+}
+
+// StopProcesses sends SIGTERM to every pid, in order, and kills those still
+// running after 5 seconds. Processes that are already gone are ignored. PID 1
+// is never signalled.
+func StopProcesses(pids []int) error {
+	if slices.Contains(pids, 1) {
+		return errors.New("refusing to stop PID 1")
 	}
-	if err := process.Signal(syscall.SIGTERM); err != nil {
-		return err
+	var errs []error
+	for _, pid := range pids {
+		process, err := os.FindProcess(pid)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := process.Signal(syscall.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			errs = append(errs, fmt.Errorf("pid %d: %w", pid, err))
+		}
 	}
 
 	// Poll every 200ms for up to 5 seconds instead of blocking for 5s
 	deadline := time.Now().Add(5 * time.Second)
 	for time.Now().Before(deadline) {
-		if !processExists(pid) {
-			return nil
+		if !slices.ContainsFunc(pids, processExists) {
+			return errors.Join(errs...)
 		}
 		time.Sleep(200 * time.Millisecond)
 	}
 
-	if processExists(pid) {
-		return process.Kill()
+	for _, pid := range pids {
+		if !processExists(pid) {
+			continue
+		}
+		if process, err := os.FindProcess(pid); err == nil {
+			if err := process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+				errs = append(errs, fmt.Errorf("pid %d: %w", pid, err))
+			}
+		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 func processExists(pid int) bool {
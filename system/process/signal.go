@@ -0,0 +1,150 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/System-Pulse/server-pulse/system/security"
+)
+
+// SignalOption is an entry of the signal picker.
+type SignalOption struct {
+	Name        string
+	Signal      syscall.Signal
+	Description string
+}
+
+// Signals lists the signals offered in the Process view, most common first.
+var Signals = []SignalOption{
+	{"HUP", syscall.SIGHUP, "reload configuration"},
+	{"INT", syscall.SIGINT, "interrupt"},
+	{"TERM", syscall.SIGTERM, "terminate gracefully"},
+	{"KILL", syscall.SIGKILL, "kill immediately"},
+	{"USR1", syscall.SIGUSR1, "user-defined 1"},
+	{"USR2", syscall.SIGUSR2, "user-defined 2"},
+	{"STOP", syscall.SIGSTOP, "pause"},
+	{"CONT", syscall.SIGCONT, "resume"},
+}
+
+// IOClass is an I/O scheduling class as understood by ionice(1).
+type IOClass int
+
+const (
+	IOClassNone IOClass = iota
+	IOClassRealtime
+	IOClassBestEffort
+	IOClassIdle
+)
+
+func (c IOClass) String() string {
+	switch c {
+	case IOClassRealtime:
+		return "realtime"
+	case IOClassBestEffort:
+		return "best-effort"
+	case IOClassIdle:
+		return "idle"
+	default:
+		return "none"
+	}
+}
+
+// IOPriority is a class and, for the realtime and best-effort classes, a
+// level from 0 (highest) to 7 (lowest).
+type IOPriority struct {
+	Class IOClass
+	Level int
+}
+
+func (p IOPriority) String() string {
+	if p.Class == IOClassRealtime || p.Class == IOClassBestEffort {
+		return fmt.Sprintf("%s/%d", p.Class, p.Level)
+	}
+	return p.Class.String()
+}
+
+// ParseIOPriority accepts "idle", "be:4", "best-effort:4", "rt:0" or
+// "realtime:0". The level defaults to 4 when omitted.
+func ParseIOPriority(s string) (IOPriority, error) {
+	name, levelStr, hasLevel := strings.Cut(strings.ToLower(strings.TrimSpace(s)), ":")
+
+	var p IOPriority
+	switch name {
+	case "idle", "3":
+		p.Class = IOClassIdle
+	case "be", "best-effort", "2":
+		p.Class = IOClassBestEffort
+	case "rt", "realtime", "1":
+		p.Class = IOClassRealtime
+	default:
+		return IOPriority{}, fmt.Errorf("unknown I/O class %q (want idle, be or rt)", name)
+	}
+
+	if p.Class == IOClassIdle {
+		if hasLevel {
+			return IOPriority{}, fmt.Errorf("the idle class has no level")
+		}
+		return p, nil
+	}
+
+	p.Level = 4
+	if hasLevel {
+		level, err := strconv.Atoi(strings.TrimSpace(levelStr))
+		if err != nil || level < 0 || level > 7 {
+			return IOPriority{}, fmt.Errorf("I/O level must be between 0 and 7, got %q", levelStr)
+		}
+		p.Level = level
+	}
+	return p, nil
+}
+
+// ParseNice validates a nice value between -20 and 19.
+func ParseNice(s string) (int, error) {
+	nice, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || nice < -20 || nice > 19 {
+		return 0, fmt.Errorf("nice value must be between -20 and 19, got %q", s)
+	}
+	return nice, nil
+}
+
+// SendSignal delivers sig to pid. When the process belongs to another user
+// the signal is sent through sudo, the same way the security checks
+// escalate.
+func SendSignal(pid int, sig syscall.Signal, sm *security.SecurityManager) error {
+	err := syscall.Kill(pid, sig)
+	if !needsSudo(err, sm) {
+		return err
+	}
+	return runSudo(sm, "kill", "-s", strconv.Itoa(int(sig)), strconv.Itoa(pid))
+}
+
+// Renice sets the nice value of pid. Lowering it requires root.
+func Renice(pid, nice int, sm *security.SecurityManager) error {
+	err := syscall.Setpriority(syscall.PRIO_PROCESS, pid, nice)
+	if !needsSudo(err, sm) {
+		return err
+	}
+	return runSudo(sm, "renice", "-n", strconv.Itoa(nice), "-p", strconv.Itoa(pid))
+}
+
+func needsSudo(err error, sm *security.SecurityManager) bool {
+	if !errors.Is(err, syscall.EPERM) && !errors.Is(err, syscall.EACCES) {
+		return false
+	}
+	return sm != nil && sm.CanUseSudo && !sm.IsRoot
+}
+
+func runSudo(sm *security.SecurityManager, args ...string) error {
+	cmd := exec.Command("sudo", append([]string{"-S"}, args...)...)
+	if sm.SudoPassword != "" {
+		cmd.Stdin = strings.NewReader(sm.SudoPassword + "\n")
+	}
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("sudo %s: %w: %s", args[0], err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
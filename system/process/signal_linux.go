@@ -0,0 +1,47 @@
+//go:build linux
+
+package process
+
+import (
+	"strconv"
+	"syscall"
+
+	"github.com/System-Pulse/server-pulse/system/security"
+)
+
+const (
+	ioprioWhoProcess = 1
+	ioprioClassShift = 13
+)
+
+// SetIOPriority changes the I/O scheduling class of pid. The realtime class
+// requires root.
+func SetIOPriority(pid int, p IOPriority, sm *security.SecurityManager) error {
+	value := int(p.Class)<<ioprioClassShift | p.Level
+	_, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_SET, ioprioWhoProcess, uintptr(pid), uintptr(value))
+	var err error
+	if errno != 0 {
+		err = errno
+	}
+	if !needsSudo(err, sm) {
+		return err
+	}
+
+	args := []string{"ionice", "-c", strconv.Itoa(int(p.Class))}
+	if p.Class != IOClassIdle {
+		args = append(args, "-n", strconv.Itoa(p.Level))
+	}
+	return runSudo(sm, append(args, "-p", strconv.Itoa(pid))...)
+}
+
+// GetIOPriority reads the I/O scheduling class of pid.
+func GetIOPriority(pid int) (IOPriority, error) {
+	value, _, errno := syscall.Syscall(syscall.SYS_IOPRIO_GET, ioprioWhoProcess, uintptr(pid), 0)
+	if errno != 0 {
+		return IOPriority{}, errno
+	}
+	return IOPriority{
+		Class: IOClass(value >> ioprioClassShift),
+		Level: int(value & (1<<ioprioClassShift - 1)),
+	}, nil
+}
@@ -0,0 +1,22 @@
+//go:build !linux
+
+package process
+
+import (
+	"errors"
+
+	"github.com/System-Pulse/server-pulse/system/security"
+)
+
+// errIOPriority is returned where the ioprio system calls do not exist.
+var errIOPriority = errors.New("I/O priority not supported on this platform")
+
+// SetIOPriority changes the I/O scheduling class of pid, on Linux only.
+func SetIOPriority(pid int, p IOPriority, sm *security.SecurityManager) error {
+	return errIOPriority
+}
+
+// GetIOPriority reads the I/O scheduling class of pid, on Linux only.
+func GetIOPriority(pid int) (IOPriority, error) {
+	return IOPriority{}, errIOPriority
+}
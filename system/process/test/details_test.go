@@ -0,0 +1,111 @@
+package test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	proc "github.com/System-Pulse/server-pulse/system/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLimits(t *testing.T) {
+	t.Parallel()
+
+	limits, err := proc.ParseLimits(strings.NewReader(
+		"Limit                     Soft Limit           Hard Limit           Units     \n" +
+			"Max cpu time              unlimited            unlimited            seconds   \n" +
+			"Max open files            1024                 524288               files     \n" +
+			"Max realtime timeout      unlimited            unlimited            us        \n"))
+	require.NoError(t, err)
+	require.Len(t, limits, 3)
+	assert.Equal(t, proc.ProcessLimit{Name: "Max open files", Soft: "1024", Hard: "524288", Units: "files"}, limits[1])
+	assert.Equal(t, "us", limits[2].Units)
+
+	_, err = proc.ParseLimits(strings.NewReader("garbage\n"))
+	assert.Error(t, err)
+}
+
+func TestParseSmapsRollup(t *testing.T) {
+	t.Parallel()
+
+	rollup, err := proc.ParseSmapsRollup(strings.NewReader(
+		"55d0c5a4b000-7ffd2b1f9000 ---p 00000000 00:00 0                          [rollup]\n" +
+			"Rss:                5120 kB\n" +
+			"Pss:                2048 kB\n" +
+			"Private_Dirty:       512 kB\n" +
+			"Swap:                100 kB\n" +
+			"SwapPss:              50 kB\n"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5120*1024), rollup.Rss)
+	assert.Equal(t, uint64(2048*1024), rollup.Pss)
+	assert.Equal(t, uint64(512*1024), rollup.PrivateDirty)
+	assert.Equal(t, uint64(100*1024), rollup.Swap)
+	assert.Equal(t, uint64(50*1024), rollup.SwapPss)
+}
+
+func TestParseCgroup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Unified hierarchy", "0::/system.slice/nginx.service\n", "/system.slice/nginx.service"},
+		{"Hybrid prefers unified", "12:memory:/docker/abc\n0::/init.scope\n", "/init.scope"},
+		{"Legacy memory controller", "4:cpu,cpuacct:/docker/abc\n7:memory:/docker/def\n", "/docker/def"},
+		{"Empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := proc.ParseCgroup(strings.NewReader(tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, path)
+		})
+	}
+}
+
+func TestContainerIDFromCgroup(t *testing.T) {
+	t.Parallel()
+
+	id := strings.Repeat("0123456789abcdef", 4)
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{"Docker systemd driver", "/system.slice/docker-" + id + ".scope", id},
+		{"Docker cgroupfs driver", "/docker/" + id, id},
+		{"Containerd CRI", "/kubepods.slice/kubepods-pod1.slice/cri-containerd-" + id + ".scope", id},
+		{"Host service", "/system.slice/ssh.service", ""},
+		{"Too short", "/docker/0123456789ab", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, proc.ContainerIDFromCgroup(tt.path))
+		})
+	}
+}
+
+func TestCollectProcessDetailsSelf(t *testing.T) {
+	t.Parallel()
+
+	details, err := proc.CollectProcessDetails(context.Background(), int32(os.Getpid()))
+	require.NoError(t, err)
+
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	assert.Equal(t, exe, details.Exe)
+	assert.NotEmpty(t, details.Cmdline)
+	assert.NotEmpty(t, details.Files)
+	assert.NotEmpty(t, details.Limits)
+	assert.Equal(t, details.ContainerID, proc.ContainerIDForPID(details.PID))
+
+	_, err = proc.CollectProcessDetails(context.Background(), -1)
+	assert.Error(t, err)
+}
@@ -2,6 +2,10 @@ package test
 
 import (
 	"testing"
+	"time"
+
+	proc "github.com/System-Pulse/server-pulse/system/process"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestUpdateProcesses(t *testing.T) {
@@ -11,3 +15,24 @@ func TestUpdateProcesses(t *testing.T) {
 		t.Skip("Process tests require system access - skipping integration tests")
 	})
 }
+
+func TestFillIORates(t *testing.T) {
+	t.Parallel()
+
+	previous := []proc.ProcessInfo{
+		{PID: 1, CreateTime: 100, ReadBytes: 1000, WriteBytes: 0},
+		{PID: 2, CreateTime: 100, ReadBytes: 5000},
+	}
+	current := []proc.ProcessInfo{
+		{PID: 1, CreateTime: 100, ReadBytes: 3000, WriteBytes: 4000},
+		{PID: 2, CreateTime: 200, ReadBytes: 9000}, // PID reused
+		{PID: 3, CreateTime: 300, ReadBytes: 100},  // new process
+	}
+
+	proc.FillIORates(previous, current, 2*time.Second)
+
+	assert.Equal(t, 1000.0, current[0].ReadRate)
+	assert.Equal(t, 2000.0, current[0].WriteRate)
+	assert.Zero(t, current[1].ReadRate)
+	assert.Zero(t, current[2].ReadRate)
+}
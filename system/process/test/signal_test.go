@@ -0,0 +1,84 @@
+package test
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+
+	proc "github.com/System-Pulse/server-pulse/system/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIOPriority(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected proc.IOPriority
+		wantErr  bool
+	}{
+		{"Idle", "idle", proc.IOPriority{Class: proc.IOClassIdle}, false},
+		{"Best effort default level", "be", proc.IOPriority{Class: proc.IOClassBestEffort, Level: 4}, false},
+		{"Best effort long name", "Best-Effort:7", proc.IOPriority{Class: proc.IOClassBestEffort, Level: 7}, false},
+		{"Realtime", "rt:0", proc.IOPriority{Class: proc.IOClassRealtime, Level: 0}, false},
+		{"Level out of range", "be:8", proc.IOPriority{}, true},
+		{"Idle with level", "idle:3", proc.IOPriority{}, true},
+		{"Unknown class", "fast", proc.IOPriority{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priority, err := proc.ParseIOPriority(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, priority)
+		})
+	}
+}
+
+func TestParseNice(t *testing.T) {
+	t.Parallel()
+
+	nice, err := proc.ParseNice(" -5 ")
+	require.NoError(t, err)
+	assert.Equal(t, -5, nice)
+
+	for _, input := range []string{"20", "-21", "low"} {
+		_, err := proc.ParseNice(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestSignalAndPriority(t *testing.T) {
+	t.Parallel()
+
+	cmd := exec.Command("sleep", "30")
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+	pid := cmd.Process.Pid
+
+	require.NoError(t, proc.Renice(pid, 10, nil))
+	nice, err := syscall.Getpriority(syscall.PRIO_PROCESS, pid)
+	require.NoError(t, err)
+	// The raw syscall returns 20 - nice
+	assert.Equal(t, 10, 20-nice)
+
+	require.NoError(t, proc.SetIOPriority(pid, proc.IOPriority{Class: proc.IOClassIdle}, nil))
+	priority, err := proc.GetIOPriority(pid)
+	require.NoError(t, err)
+	assert.Equal(t, proc.IOClassIdle, priority.Class)
+
+	require.NoError(t, proc.SendSignal(pid, syscall.SIGTERM, nil))
+	err = cmd.Wait()
+	require.Error(t, err)
+	status := err.(*exec.ExitError).Sys().(syscall.WaitStatus)
+	assert.Equal(t, syscall.SIGTERM, status.Signal())
+}
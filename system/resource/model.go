@@ -25,11 +25,18 @@ type MemoryInfo struct {
 }
 
 type DiskInfo struct {
-	Mountpoint string
-	Total      uint64
-	Used       uint64
-	Free       uint64
-	Usage      float64
+	Mountpoint  string
+	Device      string
+	Fstype      string
+	Options     []string
+	Total       uint64
+	Used        uint64
+	Free        uint64
+	Usage       float64
+	InodesTotal uint64
+	InodesUsed  uint64
+	InodesFree  uint64
+	InodesUsage float64
 }
 
 type NetworkInterface struct {
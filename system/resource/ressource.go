@@ -1,164 +1,281 @@
 package resource
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net"
+	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/System-Pulse/server-pulse/utils"
 
-	tea "github.com/charmbracelet/bubbletea"
 	"github.com/shirou/gopsutil/v4/cpu"
 	"github.com/shirou/gopsutil/v4/disk"
 	"github.com/shirou/gopsutil/v4/mem"
 	network "github.com/shirou/gopsutil/v4/net"
 )
 
-func UpdateCPUInfo() tea.Cmd {
-	return func() tea.Msg {
-		percent, err := cpu.Percent(time.Second, true)
-		if err != nil {
-			return utils.ErrMsg(err)
-		}
+// CollectCPU samples per-core and overall usage over one second each.
+func CollectCPU(ctx context.Context) (CPUInfo, error) {
+	percent, err := cpu.PercentWithContext(ctx, time.Second, true)
+	if err != nil {
+		return CPUInfo{}, err
+	}
 
-		avg, err := cpu.Percent(time.Second, false)
-		if err != nil {
-			return utils.ErrMsg(err)
-		}
+	avg, err := cpu.PercentWithContext(ctx, time.Second, false)
+	if err != nil {
+		return CPUInfo{}, err
+	}
 
-		loadAvg, err := utils.LoadAvg()
-		if err != nil {
-			return utils.ErrMsg(err)
-		}
+	loadAvg, err := utils.LoadAvg()
+	if err != nil {
+		return CPUInfo{}, err
+	}
 
-		return CpuMsg{
-			Usage:     avg[0],
-			PerCore:   percent,
-			LoadAvg1:  loadAvg[0],
-			LoadAvg5:  loadAvg[1],
-			LoadAvg15: loadAvg[2],
-		}
+	return CPUInfo{
+		Usage:     avg[0],
+		PerCore:   percent,
+		LoadAvg1:  loadAvg[0],
+		LoadAvg5:  loadAvg[1],
+		LoadAvg15: loadAvg[2],
+	}, nil
+}
+
+func CollectMemory(ctx context.Context) (MemoryInfo, error) {
+	vmem, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return MemoryInfo{}, err
+	}
+
+	swap, err := mem.SwapMemoryWithContext(ctx)
+	if err != nil {
+		return MemoryInfo{}, err
 	}
+
+	return MemoryInfo{
+		Total:     vmem.Total,
+		Used:      vmem.Used,
+		Free:      vmem.Free,
+		Usage:     vmem.UsedPercent,
+		SwapTotal: swap.Total,
+		SwapUsed:  swap.Used,
+		SwapFree:  swap.Free,
+		SwapUsage: swap.UsedPercent,
+	}, nil
 }
 
-func UpdateMemoryInfo() tea.Cmd {
-	return func() tea.Msg {
-		vmem, err := mem.VirtualMemory()
-		if err != nil {
-			return utils.ErrMsg(err)
-		}
+// DiskFilter excludes pseudo and virtual filesystems from CollectDisks.
+type DiskFilter struct {
+	ExcludeFstypes     []string
+	ExcludeMountpoints []string // filepath.Match patterns
+}
 
-		swap, err := mem.SwapMemory()
-		if err != nil {
-			return utils.ErrMsg(err)
-		}
+// DiskExclusions can be overridden from the configuration file.
+var DiskExclusions = DefaultDiskFilter()
 
-		return MemoryMsg{
-			Total:     vmem.Total,
-			Used:      vmem.Used,
-			Free:      vmem.Free,
-			Usage:     vmem.UsedPercent,
-			SwapTotal: swap.Total,
-			SwapUsed:  swap.Used,
-			SwapFree:  swap.Free,
-			SwapUsage: swap.UsedPercent,
-		}
+func DefaultDiskFilter() DiskFilter {
+	return DiskFilter{
+		ExcludeFstypes: []string{
+			"autofs", "binfmt_misc", "bpf", "cgroup", "cgroup2", "configfs",
+			"debugfs", "devpts", "devtmpfs", "efivarfs", "fusectl", "hugetlbfs",
+			"mqueue", "nsfs", "overlay", "proc", "pstore", "ramfs", "rpc_pipefs",
+			"securityfs", "squashfs", "sysfs", "tmpfs", "tracefs",
+			"fuse.lxcfs", "fuse.gvfsd-fuse", "fuse.portal",
+		},
+		ExcludeMountpoints: []string{},
 	}
 }
 
-func UpdateDiskInfo() tea.Cmd {
-	return func() tea.Msg {
-		partitions, err := disk.Partitions(false)
-		if err != nil {
-			return utils.ErrMsg(err)
+// Excludes reports whether a filesystem should be left out.
+func (f DiskFilter) Excludes(fstype, mountpoint string) bool {
+	if slices.Contains(f.ExcludeFstypes, fstype) {
+		return true
+	}
+	for _, pattern := range f.ExcludeMountpoints {
+		if ok, _ := filepath.Match(pattern, mountpoint); ok {
+			return true
 		}
+	}
+	return false
+}
 
-		var disks []DiskInfo
-		for _, p := range partitions {
-			usage, err := disk.Usage(p.Mountpoint)
-			if err != nil {
-				continue
-			}
-			if p.Mountpoint == "/" || p.Mountpoint == "/home" || p.Mountpoint == "/efi" {
-				disks = append(disks, DiskInfo{
-					Mountpoint: p.Mountpoint,
-					Total:      usage.Total,
-					Used:       usage.Used,
-					Free:       usage.Free,
-					Usage:      usage.UsedPercent,
-				})
-			}
+// DefaultInodeThreshold is the inode usage, in percent, above which a
+// filesystem is reported as critical.
+const DefaultInodeThreshold = 90.0
+
+// InodeThreshold can be overridden from the configuration file.
+var InodeThreshold = DefaultInodeThreshold
+
+// InodesCritical reports whether the filesystem is running out of inodes.
+// Filesystems without a fixed inode table (btrfs, some network mounts)
+// report zero inodes and are never critical.
+func (d DiskInfo) InodesCritical() bool {
+	return d.InodesTotal > 0 && d.InodesUsage >= InodeThreshold
+}
+
+// diskUsageTimeout bounds statfs on network filesystems whose server is gone.
+const diskUsageTimeout = 2 * time.Second
+
+// ErrStatfsPending is returned for a mountpoint whose previous statfs has
+// timed out and not yet returned.
+var ErrStatfsPending = errors.New("statfs still pending")
+
+// pendingStatfs holds the mountpoints with a statfs call in flight. A call
+// on a hung mount never returns, so starting a new one every tick would pile
+// up goroutines and OS threads; the mount is skipped until the call finishes.
+var pendingStatfs = struct {
+	sync.Mutex
+	mounts map[string]bool
+}{mounts: make(map[string]bool)}
+
+// CollectDisks reports every mounted filesystem that DiskExclusions does not
+// exclude, sorted by mountpoint.
+func CollectDisks(ctx context.Context) ([]DiskInfo, error) {
+	partitions, err := disk.PartitionsWithContext(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := DiskExclusions
+	seen := make(map[string]bool)
+	var disks []DiskInfo
+	for _, p := range partitions {
+		if seen[p.Mountpoint] || filter.Excludes(p.Fstype, p.Mountpoint) {
+			continue
 		}
+		seen[p.Mountpoint] = true
 
-		return DiskMsg(disks)
+		usage, err := diskUsage(ctx, p.Mountpoint)
+		if err != nil || usage.Total == 0 {
+			continue
+		}
+		disks = append(disks, DiskInfo{
+			Mountpoint:  p.Mountpoint,
+			Device:      p.Device,
+			Fstype:      p.Fstype,
+			Options:     p.Opts,
+			Total:       usage.Total,
+			Used:        usage.Used,
+			Free:        usage.Free,
+			Usage:       usage.UsedPercent,
+			InodesTotal: usage.InodesTotal,
+			InodesUsed:  usage.InodesUsed,
+			InodesFree:  usage.InodesFree,
+			InodesUsage: usage.InodesUsedPercent,
+		})
 	}
+
+	sort.Slice(disks, func(i, j int) bool { return disks[i].Mountpoint < disks[j].Mountpoint })
+	return disks, nil
 }
 
-func UpdateNetworkInfo() tea.Cmd {
-	return func() tea.Msg {
-		connected := true
+// diskUsage runs statfs in the background so that a hung NFS mount only
+// costs diskUsageTimeout instead of blocking the collector forever. While an
+// earlier call on the same mountpoint is still blocked it returns
+// ErrStatfsPending without starting another one.
+func diskUsage(ctx context.Context, mountpoint string) (*disk.UsageStat, error) {
+	pendingStatfs.Lock()
+	if pendingStatfs.mounts[mountpoint] {
+		pendingStatfs.Unlock()
+		return nil, fmt.Errorf("%s: %w", mountpoint, ErrStatfsPending)
+	}
+	pendingStatfs.mounts[mountpoint] = true
+	pendingStatfs.Unlock()
 
-		interfaces, err := net.Interfaces()
-		if err != nil {
-			return utils.ErrMsg(err)
-		}
+	ctx, cancel := context.WithTimeout(ctx, diskUsageTimeout)
+	defer cancel()
 
-		var privateIPs []string
-		var networkInterfaces []NetworkInterface
+	type result struct {
+		usage *disk.UsageStat
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		usage, err := disk.UsageWithContext(ctx, mountpoint)
+		pendingStatfs.Lock()
+		delete(pendingStatfs.mounts, mountpoint)
+		pendingStatfs.Unlock()
+		done <- result{usage, err}
+	}()
 
-		for _, iface := range interfaces {
-			var interfaceIPs []string
-			status := "down"
-			if iface.Flags&net.FlagUp != 0 {
-				status = "up"
-			}
+	select {
+	case r := <-done:
+		return r.usage, r.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%s: %w", mountpoint, ctx.Err())
+	}
+}
 
-			addrs, err := iface.Addrs()
-			if err == nil {
-				for _, addr := range addrs {
-					ipNet, ok := addr.(*net.IPNet)
-					if ok /*&& !ipNet.IP.IsLoopback()*/ {
-						if ipNet.IP.To4() != nil {
-							ipStr := ipNet.IP.String()
-							interfaceIPs = append(interfaceIPs, ipStr)
-
-							if !strings.HasPrefix(ipStr, "127.") {
-								privateIPs = append(privateIPs, ipStr)
-							}
-						}
-					}
-				}
-			}
+// CollectNetwork lists local interfaces with their IPv4 addresses and
+// cumulative traffic counters.
+func CollectNetwork(ctx context.Context) (NetworkInfo, error) {
+	connected := true
 
-			networkInterfaces = append(networkInterfaces, NetworkInterface{
-				Name:   iface.Name,
-				IPs:    interfaceIPs,
-				Status: status,
-			})
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return NetworkInfo{}, err
+	}
+
+	var privateIPs []string
+	var networkInterfaces []NetworkInterface
+
+	for _, iface := range interfaces {
+		var interfaceIPs []string
+		status := "down"
+		if iface.Flags&net.FlagUp != 0 {
+			status = "up"
 		}
 
-		netIO, err := network.IOCounters(true)
+		addrs, err := iface.Addrs()
 		if err == nil {
-			for i := range networkInterfaces {
-				for _, io := range netIO {
-					if networkInterfaces[i].Name == io.Name {
-						networkInterfaces[i].RxBytes = io.BytesRecv
-						networkInterfaces[i].TxBytes = io.BytesSent
-						break
+			for _, addr := range addrs {
+				ipNet, ok := addr.(*net.IPNet)
+				if ok /*&& !ipNet.IP.IsLoopback()*/ {
+					if ipNet.IP.To4() != nil {
+						ipStr := ipNet.IP.String()
+						interfaceIPs = append(interfaceIPs, ipStr)
+
+						if !strings.HasPrefix(ipStr, "127.") {
+							privateIPs = append(privateIPs, ipStr)
+						}
 					}
 				}
 			}
 		}
 
-		publicIPv4 := "N/A"
-		publicIPv6 := "N/A"
+		networkInterfaces = append(networkInterfaces, NetworkInterface{
+			Name:   iface.Name,
+			IPs:    interfaceIPs,
+			Status: status,
+		})
+	}
 
-		return NetworkMsg{
-			Connected:  connected,
-			PrivateIPs: privateIPs,
-			PublicIPv4: publicIPv4,
-			PublicIPv6: publicIPv6,
-			Interfaces: networkInterfaces,
+	netIO, err := network.IOCountersWithContext(ctx, true)
+	if err == nil {
+		for i := range networkInterfaces {
+			for _, io := range netIO {
+				if networkInterfaces[i].Name == io.Name {
+					networkInterfaces[i].RxBytes = io.BytesRecv
+					networkInterfaces[i].TxBytes = io.BytesSent
+					break
+				}
+			}
 		}
 	}
+
+	publicIPv4 := "N/A"
+	publicIPv6 := "N/A"
+
+	return NetworkInfo{
+		Connected:  connected,
+		PrivateIPs: privateIPs,
+		PublicIPv4: publicIPv4,
+		PublicIPv6: publicIPv6,
+		Interfaces: networkInterfaces,
+	}, nil
 }
@@ -2,6 +2,9 @@ package test
 
 import (
 	"testing"
+
+	resource "github.com/System-Pulse/server-pulse/system/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestUpdateCPUInfo(t *testing.T) {
@@ -43,3 +46,31 @@ func TestLoadAvg(t *testing.T) {
 		t.Skip("Load average tests require system access - skipping integration tests")
 	})
 }
+
+func TestDiskFilterExcludes(t *testing.T) {
+	t.Parallel()
+
+	filter := resource.DefaultDiskFilter()
+	filter.ExcludeMountpoints = []string{"/mnt/backup/*"}
+
+	tests := []struct {
+		name       string
+		fstype     string
+		mountpoint string
+		expected   bool
+	}{
+		{"Root filesystem", "ext4", "/", false},
+		{"Separate /var", "xfs", "/var", false},
+		{"NFS mount", "nfs4", "/data", false},
+		{"tmpfs", "tmpfs", "/run", true},
+		{"Docker overlay", "overlay", "/var/lib/docker/overlay2/abc/merged", true},
+		{"Excluded pattern", "ext4", "/mnt/backup/daily", true},
+		{"Pattern does not match parent", "ext4", "/mnt/backup", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, filter.Excludes(tt.fstype, tt.mountpoint))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Package schedule decides when each periodic collector refreshes.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultInterval is the refresh period used when no interval is configured.
+const DefaultInterval = 2 * time.Second
+
+// Collector identifies one of the periodic data sources refreshed on tick.
+type Collector string
+
+const (
+	CollectorSystem         Collector = "system"
+	CollectorCPU            Collector = "cpu"
+	CollectorMemory         Collector = "memory"
+	CollectorDisk           Collector = "disk"
+	CollectorNetwork        Collector = "network"
+	CollectorProcesses      Collector = "processes"
+	CollectorContainers     Collector = "containers"
+	CollectorImages         Collector = "images"
+	CollectorVolumes        Collector = "volumes"
+	CollectorDockerNetworks Collector = "docker_networks"
+)
+
+var Collectors = []Collector{
+	CollectorSystem,
+	CollectorCPU,
+	CollectorMemory,
+	CollectorDisk,
+	CollectorNetwork,
+	CollectorProcesses,
+	CollectorContainers,
+	CollectorImages,
+	CollectorVolumes,
+	CollectorDockerNetworks,
+}
+
+// Schedule decides which collectors run on a tick. Every collector runs at
+// Interval unless it has its own entry in Intervals.
+type Schedule struct {
+	Interval  time.Duration
+	Intervals map[Collector]time.Duration
+	lastRun   map[Collector]time.Time
+}
+
+// New returns a schedule running every collector at interval, or at its own
+// entry in intervals.
+func New(interval time.Duration, intervals map[Collector]time.Duration) Schedule {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	if intervals == nil {
+		intervals = make(map[Collector]time.Duration)
+	}
+	return Schedule{
+		Interval:  interval,
+		Intervals: intervals,
+		lastRun:   make(map[Collector]time.Time),
+	}
+}
+
+// IntervalFor returns the refresh period of a collector.
+func (s Schedule) IntervalFor(c Collector) time.Duration {
+	if d, ok := s.Intervals[c]; ok && d > 0 {
+		return d
+	}
+	if s.Interval <= 0 {
+		return DefaultInterval
+	}
+	return s.Interval
+}
+
+// TickInterval is the shortest configured interval, so that no collector
+// waits longer than it asked for.
+func (s Schedule) TickInterval() time.Duration {
+	tick := s.Interval
+	if tick <= 0 {
+		tick = DefaultInterval
+	}
+	for _, d := range s.Intervals {
+		if d > 0 && d < tick {
+			tick = d
+		}
+	}
+	return tick
+}
+
+// Due reports whether c should run at now and, if so, records the run.
+// Collectors are never due while paused, so they refresh on the first tick
+// after their view becomes visible again.
+func (s Schedule) Due(c Collector, now time.Time, visible bool) bool {
+	if !visible {
+		return false
+	}
+	// Ticks drift by a few milliseconds; allow for it so a collector on the
+	// base interval does not skip every other tick.
+	if last, ok := s.lastRun[c]; ok && now.Sub(last) < s.IntervalFor(c)-s.TickInterval()/4 {
+		return false
+	}
+	if s.lastRun != nil {
+		s.lastRun[c] = now
+	}
+	return true
+}
+
+// ParseCollectorIntervals parses a comma-separated list of collector=duration
+// pairs, e.g. "processes=10s,containers=5s".
+func ParseCollectorIntervals(spec string) (map[Collector]time.Duration, error) {
+	intervals := make(map[Collector]time.Duration)
+	if strings.TrimSpace(spec) == "" {
+		return intervals, nil
+	}
+
+	for _, entry := range strings.Split(spec, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid collector interval %q: expected name=duration", entry)
+		}
+		collector, err := ParseCollector(name)
+		if err != nil {
+			return nil, err
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval for %s: %w", collector, err)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("interval for %s must be positive", collector)
+		}
+		intervals[collector] = d
+	}
+
+	return intervals, nil
+}
+
+func ParseCollector(name string) (Collector, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, c := range Collectors {
+		if string(c) == name {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("unknown collector %q", name)
+}
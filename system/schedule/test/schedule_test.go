@@ -0,0 +1,76 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/schedule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCollectorIntervals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		spec     string
+		expected map[schedule.Collector]time.Duration
+		wantErr  bool
+	}{
+		{"Empty", "", map[schedule.Collector]time.Duration{}, false},
+		{"Single", "processes=10s", map[schedule.Collector]time.Duration{schedule.CollectorProcesses: 10 * time.Second}, false},
+		{"Multiple with spaces", "Processes=10s, containers=1m", map[schedule.Collector]time.Duration{
+			schedule.CollectorProcesses:  10 * time.Second,
+			schedule.CollectorContainers: time.Minute,
+		}, false},
+		{"Unknown collector", "gpu=1s", nil, true},
+		{"Missing duration", "cpu", nil, true},
+		{"Negative duration", "cpu=-1s", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			intervals, err := schedule.ParseCollectorIntervals(tt.spec)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, intervals)
+		})
+	}
+}
+
+func TestScheduleDue(t *testing.T) {
+	t.Parallel()
+
+	sched := schedule.New(2*time.Second, map[schedule.Collector]time.Duration{
+		schedule.CollectorProcesses: 10 * time.Second,
+	})
+	assert.Equal(t, 2*time.Second, sched.TickInterval())
+
+	start := time.Now()
+	assert.True(t, sched.Due(schedule.CollectorCPU, start, true))
+	assert.True(t, sched.Due(schedule.CollectorProcesses, start, true))
+
+	next := start.Add(2 * time.Second)
+	assert.True(t, sched.Due(schedule.CollectorCPU, next, true))
+	assert.False(t, sched.Due(schedule.CollectorProcesses, next, true))
+
+	// A hidden collector is skipped and runs as soon as it is visible again
+	later := start.Add(20 * time.Second)
+	assert.False(t, sched.Due(schedule.CollectorProcesses, later, false))
+	assert.True(t, sched.Due(schedule.CollectorProcesses, later.Add(time.Second), true))
+}
+
+func TestScheduleTickInterval(t *testing.T) {
+	t.Parallel()
+
+	sched := schedule.New(5*time.Second, map[schedule.Collector]time.Duration{
+		schedule.CollectorCPU: time.Second,
+	})
+	assert.Equal(t, time.Second, sched.TickInterval())
+	assert.Equal(t, 5*time.Second, sched.IntervalFor(schedule.CollectorMemory))
+	assert.Equal(t, schedule.DefaultInterval, schedule.Schedule{}.TickInterval())
+}
@@ -1,11 +1,10 @@
 package security
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"strings"
-
-	tea "github.com/charmbracelet/bubbletea"
 )
 
 type AutoBanJail struct {
@@ -210,51 +209,53 @@ func (sm *SecurityManager) checkRunningProcesses() SecurityCheck {
 	return SecurityCheck{}
 }
 
-// DisplayAutoBanInfos retrieves detailed auto-ban/intrusion prevention information
-func (sm *SecurityManager) DisplayAutoBanInfos() tea.Cmd {
-	return func() tea.Msg {
-		// Check which service is active
-		services := sm.getServiceDefinitions()
+// CollectAutoBan retrieves detailed auto-ban/intrusion prevention information
+// from the first active service.
+func (sm *SecurityManager) CollectAutoBan(ctx context.Context) (AutoBanInfos, error) {
+	// Check which service is active
+	services := sm.getServiceDefinitions()
 
-		for _, service := range services {
-			cmd := exec.Command(service.command[0], service.command[1:]...)
-			output, err := cmd.Output()
-			if err != nil {
-				continue
+	for _, service := range services {
+		cmd := exec.CommandContext(ctx, service.command[0], service.command[1:]...)
+		output, err := cmd.Output()
+		if err != nil {
+			if ctx.Err() != nil {
+				return AutoBanInfos{}, ctx.Err()
 			}
+			continue
+		}
 
-			status := strings.TrimSpace(string(output))
-			if status == "active" {
-				// Get detailed info for this service
-				switch service.name {
-				case "fail2ban":
-					return AutoBanMsg(sm.getFail2banDetails())
-				case "crowdsec":
-					return AutoBanMsg(sm.getCrowdsecDetails())
-				case "ossec":
-					return AutoBanMsg(sm.getOSSECDetails())
-				case "denyhosts":
-					return AutoBanMsg(sm.getDenyhostsDetails())
-				case "sshguard":
-					return AutoBanMsg(sm.getSSHGuardDetails())
-				case "suricata":
-					return AutoBanMsg(sm.getSuricataDetails())
-				case "snort":
-					return AutoBanMsg(sm.getSnortDetails())
-				}
+		status := strings.TrimSpace(string(output))
+		if status == "active" {
+			// Get detailed info for this service
+			switch service.name {
+			case "fail2ban":
+				return sm.getFail2banDetails(), nil
+			case "crowdsec":
+				return sm.getCrowdsecDetails(), nil
+			case "ossec":
+				return sm.getOSSECDetails(), nil
+			case "denyhosts":
+				return sm.getDenyhostsDetails(), nil
+			case "sshguard":
+				return sm.getSSHGuardDetails(), nil
+			case "suricata":
+				return sm.getSuricataDetails(), nil
+			case "snort":
+				return sm.getSnortDetails(), nil
 			}
 		}
-
-		// No active service found
-		return AutoBanMsg(AutoBanInfos{
-			ServiceType: "None",
-			Status:      "Disabled",
-			Details:     "No intrusion prevention service detected",
-			Jails:       []AutoBanJail{},
-			BannedIPs:   []string{},
-			RawOutput:   "",
-		})
 	}
+
+	// No active service found
+	return AutoBanInfos{
+		ServiceType: "None",
+		Status:      "Disabled",
+		Details:     "No intrusion prevention service detected",
+		Jails:       []AutoBanJail{},
+		BannedIPs:   []string{},
+		RawOutput:   "",
+	}, nil
 }
 
 // getFail2banDetails retrieves detailed fail2ban information
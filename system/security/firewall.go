@@ -1,11 +1,10 @@
 package security
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"strings"
-
-	tea "github.com/charmbracelet/bubbletea"
 )
 
 type FirewallRule struct {
@@ -151,45 +150,47 @@ func (sm *SecurityManager) createInactiveFirewallCheck() SecurityCheck {
 	}
 }
 
-// DisplayFirewallInfos retrieves detailed firewall rules and information
-func (sm *SecurityManager) DisplayFirewallInfos() tea.Cmd {
-	return func() tea.Msg {
-		firewalls := sm.getFirewallConfigs()
-
-		for _, fw := range firewalls {
-			output, err := sm.executeFirewallCommand(fw)
-			if err != nil {
-				continue
-			}
+// CollectFirewall retrieves the rules of the first active firewall. Firewall
+// commands are not interruptible, so ctx is only consulted between them.
+func (sm *SecurityManager) CollectFirewall(ctx context.Context) (FirewallInfos, error) {
+	firewalls := sm.getFirewallConfigs()
 
-			// Check if this firewall is active
-			check := sm.analyzeFirewallOutput(fw.name, output)
-			if check != nil && check.Status == "Active" {
-				// Get detailed rules for this firewall
-				rules := sm.getFirewallRules(fw.name)
-
-				// Get raw output for advanced view
-				rawOutput := sm.getRawFirewallOutput(fw.name)
-
-				return FirewallMsg(FirewallInfos{
-					FirewallType: fw.name,
-					Status:       check.Status,
-					Rules:        rules,
-					Details:      check.Details,
-					RawOutput:    rawOutput,
-				})
-			}
+	for _, fw := range firewalls {
+		if err := ctx.Err(); err != nil {
+			return FirewallInfos{}, err
+		}
+		output, err := sm.executeFirewallCommand(fw)
+		if err != nil {
+			continue
 		}
 
-		// No active firewall found
-		return FirewallMsg(FirewallInfos{
-			FirewallType: "None",
-			Status:       "Inactive",
-			Rules:        []FirewallRule{},
-			Details:      "No active firewall detected",
-			RawOutput:    "",
-		})
+		// Check if this firewall is active
+		check := sm.analyzeFirewallOutput(fw.name, output)
+		if check != nil && check.Status == "Active" {
+			// Get detailed rules for this firewall
+			rules := sm.getFirewallRules(fw.name)
+
+			// Get raw output for advanced view
+			rawOutput := sm.getRawFirewallOutput(fw.name)
+
+			return FirewallInfos{
+				FirewallType: fw.name,
+				Status:       check.Status,
+				Rules:        rules,
+				Details:      check.Details,
+				RawOutput:    rawOutput,
+			}, nil
+		}
 	}
+
+	// No active firewall found
+	return FirewallInfos{
+		FirewallType: "None",
+		Status:       "Inactive",
+		Rules:        []FirewallRule{},
+		Details:      "No active firewall detected",
+		RawOutput:    "",
+	}, nil
 }
 
 // getRawFirewallOutput gets the complete raw output of firewall rules
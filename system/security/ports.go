@@ -1,12 +1,12 @@
 package security
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
+	"slices"
 	"strconv"
 	"strings"
-
-	tea "github.com/charmbracelet/bubbletea"
 )
 
 type OpenedPorts struct{}
@@ -83,6 +83,29 @@ const (
 	HighRisk
 )
 
+func (r RiskLevel) String() string {
+	switch r {
+	case Warning:
+		return "warning"
+	case HighRisk:
+		return "high"
+	default:
+		return "secure"
+	}
+}
+
+func ParseRiskLevel(s string) (RiskLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "secure":
+		return Secure, nil
+	case "warning":
+		return Warning, nil
+	case "high", "high risk", "high_risk":
+		return HighRisk, nil
+	}
+	return Secure, fmt.Errorf("unknown risk level %q (want secure, warning or high)", s)
+}
+
 func (sm *SecurityManager) checkOpenPorts() SecurityCheck {
 	o := NewOpenedPortsChecker()
 
@@ -124,27 +147,38 @@ func (sm *SecurityManager) checkOpenPorts() SecurityCheck {
 	return buildSecurityCheckResult(maxRisk, openPorts, riskPorts, criticalFindings)
 }
 
+// PortRule flags a set of listening ports with a risk level and the message
+// shown in the Open Ports check.
+type PortRule struct {
+	Ports   []int
+	Risk    RiskLevel
+	Message string
+}
+
+// PortRules is the risk table used by the Open Ports check. Ports without a
+// rule are considered secure.
+var PortRules = DefaultPortRules()
+
+func DefaultPortRules() []PortRule {
+	return []PortRule{
+		{[]int{22}, Warning, "Port 22 (SSH) is open. Change default ssh port for more security."},
+		{[]int{20, 21}, Warning, "Port 20/21 (FTP) is open. FTP is insecure, consider using SFTP or FTPS."},
+		{[]int{23}, HighRisk, "Port 23 (Telnet) is open. Telnet is insecure and should be closed."},
+		{[]int{161, 162}, Warning, "Port 161/162 (SNMP) is open. SNMP can expose sensitive information."},
+		{[]int{137, 138, 139}, HighRisk, "Port 137/138/139 (NetBIOS), you can be attacked by null sessions."},
+		{[]int{445}, HighRisk, "Port 445 (SMB) is open. SMB has had many vulnerabilities."},
+		{[]int{3389}, HighRisk, "Port 3389 (RDP) is open. RDP is often targeted by attackers."},
+		{[]int{3306, 5432, 6379, 27017}, HighRisk, "Database port exposed. Databases should not be accessible from internet."},
+	}
+}
+
 func analyzePortRisk(port int) (RiskLevel, string) {
-	switch port {
-	case 22:
-		return Warning, "Port 22 (SSH) is open. Change default ssh port for more security."
-	case 20, 21:
-		return Warning, "Port 20/21 (FTP) is open. FTP is insecure, consider using SFTP or FTPS."
-	case 23:
-		return HighRisk, "Port 23 (Telnet) is open. Telnet is insecure and should be closed."
-	case 161, 162:
-		return Warning, "Port 161/162 (SNMP) is open. SNMP can expose sensitive information."
-	case 137, 138, 139:
-		return HighRisk, "Port 137/138/139 (NetBIOS), you can be attacked by null sessions."
-	case 445:
-		return HighRisk, "Port 445 (SMB) is open. SMB has had many vulnerabilities."
-	case 3389:
-		return HighRisk, "Port 3389 (RDP) is open. RDP is often targeted by attackers."
-	case 3306, 5432, 6379, 27017:
-		return HighRisk, "Database port exposed. Databases should not be accessible from internet."
-	default:
-		return Secure, ""
+	for _, rule := range PortRules {
+		if slices.Contains(rule.Ports, port) {
+			return rule.Risk, rule.Message
+		}
 	}
+	return Secure, ""
 }
 
 func buildSecurityCheckResult(maxRisk RiskLevel, allPorts map[int]bool, riskPorts []string, findings []string) SecurityCheck {
@@ -183,25 +217,27 @@ func ShowOpenedPorts(ports map[int]bool) string {
 	return fmt.Sprintf("%s", strings.Join(portList, ", "))
 }
 
-func (sm *SecurityManager) DisplayOpenedPortsInfos() tea.Cmd {
+// CollectOpenedPorts lists the listening ports with the open ports check.
+func (sm *SecurityManager) CollectOpenedPorts(ctx context.Context) (OpenedPortsInfos, error) {
 	o := NewOpenedPortsChecker()
 	var AllPorts []int
 	value, err := o.GetOpenedPorts(sm)
 	if err != nil {
-		return nil
+		return OpenedPortsInfos{}, err
 	}
 	for port, b := range value {
 		if b {
 			AllPorts = append(AllPorts, port)
 		}
 	}
-
-	return func() tea.Msg {
-		openedPorts := sm.checkOpenPorts()
-		return OpenedPortsMsg(OpenedPortsInfos{
-			Ports:   AllPorts,
-			Details: openedPorts.Details,
-			Status:  openedPorts.Status,
-		})
+	if err := ctx.Err(); err != nil {
+		return OpenedPortsInfos{}, err
 	}
+
+	openedPorts := sm.checkOpenPorts()
+	return OpenedPortsInfos{
+		Ports:   AllPorts,
+		Details: openedPorts.Details,
+		Status:  openedPorts.Status,
+	}, nil
 }
@@ -1,9 +1,10 @@
 package security
 
 import (
+	"context"
+	"slices"
+	"strings"
 	"time"
-
-	tea "github.com/charmbracelet/bubbletea"
 )
 
 func NewSecurityManager() *SecurityManager {
@@ -16,6 +17,70 @@ type SecurityCheck struct {
 	Details string
 }
 
+// Check outcomes as classified by SecurityCheck.Result.
+const (
+	CheckPassed  = "passed"
+	CheckWarning = "warning"
+	CheckFailed  = "failed"
+	CheckUnknown = "unknown"
+)
+
+// Result classifies the check-specific status into CheckPassed,
+// CheckWarning or CheckFailed. Checks that could not be evaluated are
+// reported as CheckUnknown.
+func (c SecurityCheck) Result() string {
+	switch c.Status {
+	case "Error", "Unknown":
+		return CheckUnknown
+	}
+
+	switch c.Name {
+	case "SSL Certificate":
+		return classify(c.Status, []string{"Valid", "Valide"}, []string{"Warning"})
+	case "SSH Root Login":
+		if c.Status == "Disabled" {
+			return CheckPassed
+		}
+		if strings.Contains(c.Status, "with password") {
+			return CheckFailed
+		}
+		return CheckWarning
+	case "SSH Password Authentication":
+		return classify(c.Status, []string{"Disabled"}, []string{"Enabled"})
+	case "Password Policy":
+		return classify(c.Status, []string{"Enabled"}, []string{"Disabled"})
+	case "Open Ports":
+		return classify(c.Status, []string{"Secure"}, []string{"Warning"})
+	case "Firewall Status":
+		return classify(c.Status, []string{"Active"}, nil)
+	case "Auto Ban":
+		return classify(c.Status, []string{"Enabled", "Active"}, []string{"Disabled"})
+	case "System Updates":
+		return classify(c.Status, []string{"Up to date"}, []string{"Updates Available"})
+	case "System Restart":
+		return classify(c.Status, []string{"Not Required"}, []string{"Required"})
+	}
+
+	switch {
+	case strings.Contains(c.Status, "OK") || strings.Contains(c.Status, "Valid"):
+		return CheckPassed
+	case strings.Contains(c.Status, "Warning"):
+		return CheckWarning
+	default:
+		return CheckFailed
+	}
+}
+
+func classify(status string, passed, warning []string) string {
+	if slices.Contains(passed, status) {
+		return CheckPassed
+	}
+	if slices.Contains(warning, status) {
+		return CheckWarning
+	}
+	return CheckFailed
+}
+
 type SecurityCheckResult struct {
 	Checks     []SecurityCheck
 	LastUpdate time.Time
@@ -23,19 +88,27 @@ type SecurityCheckResult struct {
 
 type SecurityMsg []SecurityCheck
 
-func (sm *SecurityManager) RunSecurityChecks(domain string) tea.Cmd {
-	return func() tea.Msg {
-		checks := []SecurityCheck{
-			sm.checkSSLCertificate(domain),
-			sm.checkSSHRootLogin(),
-			sm.checkSSHPasswordAuthentication(),
-			sm.checkPasswordPolicy(),
-			sm.checkOpenPorts(),
-			sm.checkFirewallStatus(),
-			sm.checkAutoBan(),
-			sm.checkSystemUpdates(),
-			sm.checkSystemRestart(),
+// CollectSecurityChecks runs every check in order. Checks shell out and are
+// not interruptible, so ctx is only consulted between them.
+func (sm *SecurityManager) CollectSecurityChecks(ctx context.Context, domain string) ([]SecurityCheck, error) {
+	runners := []func() SecurityCheck{
+		func() SecurityCheck { return sm.checkSSLCertificate(domain) },
+		sm.checkSSHRootLogin,
+		sm.checkSSHPasswordAuthentication,
+		sm.checkPasswordPolicy,
+		sm.checkOpenPorts,
+		sm.checkFirewallStatus,
+		sm.checkAutoBan,
+		sm.checkSystemUpdates,
+		sm.checkSystemRestart,
+	}
+
+	checks := make([]SecurityCheck, 0, len(runners))
+	for _, run := range runners {
+		if err := ctx.Err(); err != nil {
+			return checks, err
 		}
-		return SecurityMsg(checks)
+		checks = append(checks, run())
 	}
+	return checks, nil
 }
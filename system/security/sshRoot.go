@@ -1,11 +1,10 @@
 package security
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"strings"
-
-	tea "github.com/charmbracelet/bubbletea"
 )
 
 // SSHSystemChecker retrieves the active SSH daemon configuration.
@@ -107,12 +106,14 @@ func (sm *SecurityManager) checkSSHRootLogin() SecurityCheck {
 	}
 }
 
-func (sm *SecurityManager) DisplaySSHRootInfos() tea.Cmd {
-	return func() tea.Msg {
-		check := sm.checkSSHRootLogin()
-		return SSHRootMsg(SSHRootInfos{
-			Status:  check.Status,
-			Details: check.Details,
-		})
+// CollectSSHRoot reports whether root may log in over SSH.
+func (sm *SecurityManager) CollectSSHRoot(ctx context.Context) (SSHRootInfos, error) {
+	if err := ctx.Err(); err != nil {
+		return SSHRootInfos{}, err
 	}
+	check := sm.checkSSHRootLogin()
+	return SSHRootInfos{
+		Status:  check.Status,
+		Details: check.Details,
+	}, nil
 }
@@ -1,12 +1,12 @@
 package security
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"time"
-
-	tea "github.com/charmbracelet/bubbletea"
 )
 
 type SecurityManager struct {
@@ -121,13 +121,15 @@ func DisplayCertificateInfo(cert *x509.Certificate, hostname string) Certificate
 	}
 }
 
-func (sm *SecurityManager) RunCertificateDisplay() tea.Cmd {
-	if sm.Certificate == nil {
-		return nil
-	}
+// ErrNoCertificate is returned by CollectCertificate before the SSL
+// certificate check has fetched a certificate.
+var ErrNoCertificate = errors.New("no certificate checked yet")
 
-	return func() tea.Msg {
-		info := DisplayCertificateInfo(sm.Certificate, sm.Hostname)
-		return CertificateDisplayMsg(info)
+// CollectCertificate describes the certificate fetched by the last SSL
+// certificate check.
+func (sm *SecurityManager) CollectCertificate(ctx context.Context) (CertificateInfos, error) {
+	if sm.Certificate == nil {
+		return CertificateInfos{}, ErrNoCertificate
 	}
+	return DisplayCertificateInfo(sm.Certificate, sm.Hostname), ctx.Err()
 }
@@ -1,7 +1,11 @@
 package test
 
 import (
+	"context"
 	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/security"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCheckSSLCertificate(t *testing.T) {
@@ -27,3 +31,53 @@ func TestDisplayCertificateInfo(t *testing.T) {
 		t.Skip("Certificate info tests require network access - skipping integration tests")
 	})
 }
+
+func TestSecurityCheckResult(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		check    security.SecurityCheck
+		expected string
+	}{
+		{"Valid certificate", security.SecurityCheck{Name: "SSL Certificate", Status: "Valid"}, security.CheckPassed},
+		{"Expiring certificate", security.SecurityCheck{Name: "SSL Certificate", Status: "Warning"}, security.CheckWarning},
+		{"Invalid certificate", security.SecurityCheck{Name: "SSL Certificate", Status: "Invalid"}, security.CheckFailed},
+		{"Root login disabled", security.SecurityCheck{Name: "SSH Root Login", Status: "Disabled"}, security.CheckPassed},
+		{"Root login key-only", security.SecurityCheck{Name: "SSH Root Login", Status: "Enabled (key-only)"}, security.CheckWarning},
+		{"Root login with password", security.SecurityCheck{Name: "SSH Root Login", Status: "Enabled (with password)"}, security.CheckFailed},
+		{"Password auth enabled", security.SecurityCheck{Name: "SSH Password Authentication", Status: "Enabled"}, security.CheckWarning},
+		{"Firewall active", security.SecurityCheck{Name: "Firewall Status", Status: "Active"}, security.CheckPassed},
+		{"Firewall inactive", security.SecurityCheck{Name: "Firewall Status", Status: "Inactive"}, security.CheckFailed},
+		{"High risk ports", security.SecurityCheck{Name: "Open Ports", Status: "High Risk"}, security.CheckFailed},
+		{"Updates available", security.SecurityCheck{Name: "System Updates", Status: "Updates Available"}, security.CheckWarning},
+		{"No restart needed", security.SecurityCheck{Name: "System Restart", Status: "Not Required"}, security.CheckPassed},
+		{"Check error", security.SecurityCheck{Name: "SSH Root Login", Status: "Error"}, security.CheckUnknown},
+		{"Unknown check OK", security.SecurityCheck{Name: "Custom", Status: "OK"}, security.CheckPassed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.check.Result())
+		})
+	}
+}
+
+func TestCollectSecurityChecksCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	checks, err := security.NewSecurityManager().CollectSecurityChecks(ctx, "")
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, checks)
+}
+
+func TestCollectCertificateBeforeCheck(t *testing.T) {
+	t.Parallel()
+
+	sm := security.NewSecurityManager()
+	_, err := sm.CollectCertificate(context.Background())
+	assert.ErrorIs(t, err, security.ErrNoCertificate)
+}
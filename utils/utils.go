@@ -173,16 +173,33 @@ func CheckDockerPermissions() (bool, string) {
 
 func FormatOperationMessage(operation string, success bool, err error) string {
 	operationLabels := map[string]string{
-		"restart":      "Container restarted",
-		"start":        "Container started",
-		"stop":         "Container stopped",
-		"pause":        "Container paused",
-		"unpause":      "Container resumed",
-		"delete":       "Container deleted",
-		"toggle_start": "Container state changed",
-		"toggle_pause": "Container pause state changed",
-		"exec":         "Shell opened",
-		"logs":         "Logs loaded",
+		"restart":         "Container restarted",
+		"start":           "Container started",
+		"stop":            "Container stopped",
+		"pause":           "Container paused",
+		"unpause":         "Container resumed",
+		"delete":          "Container deleted",
+		"toggle_start":    "Container state changed",
+		"toggle_pause":    "Container pause state changed",
+		"exec":            "Shell opened",
+		"logs":            "Logs loaded",
+		"kill":            "Processes stopped",
+		"signal":          "Signal sent",
+		"renice":          "Priority changed",
+		"ionice":          "I/O priority changed",
+		"project_restart": "Project restarted",
+		"project_start":   "Project started",
+		"project_stop":    "Project stopped",
+		"update_limits":   "Container limits updated",
+		"copy":            "Files copied",
+		"logs_export":     "Logs exported",
+		"image_remove":    "Image removed",
+		"image_prune":     "Dangling images pruned",
+		"image_pull":      "Image pulled",
+		"volume_remove":   "Volume removed",
+		"volume_prune":    "Unused volumes pruned",
+		"network_remove":  "Network removed",
+		"network_prune":   "Unused networks pruned",
 	}
 
 	label, exists := operationLabels[operation]
@@ -0,0 +1,447 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func UpdateApp(dm *app.DockerManager) tea.Cmd {
+	return func() tea.Msg {
+		cont, err := dm.RefreshContainers()
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return app.ContainerMsg(cont)
+	}
+}
+
+// GetContainerCmd inspects one container after an event. Failures are not
+// reported: the periodic refresh catches up.
+func GetContainerCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		c, found, err := dm.GetContainer(ctx, containerID)
+		if err != nil {
+			return nil
+		}
+		return app.ContainerUpdateMsg{ID: containerID, Container: c, Found: found}
+	}
+}
+
+// WatchEventsCmd subscribes to container events recorded since the given
+// time.
+func WatchEventsCmd(dm *app.DockerManager, since time.Time) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		events, errs := dm.WatchEvents(ctx, since)
+		return app.EventsStreamMsg{Events: events, Errors: errs, CancelFunc: cancel}
+	}
+}
+
+// NextEventCmd waits for the next event of a subscription.
+func NextEventCmd(events <-chan app.ContainerEvent, errs <-chan error) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-events
+		if !ok {
+			return app.EventsStopMsg{Error: <-errs}
+		}
+		return app.ContainerEventMsg(event)
+	}
+}
+
+// ContainerNamesCmd looks up container names. Failures are not reported:
+// processes simply stay unlabelled until the next refresh.
+func ContainerNamesCmd(dm *app.DockerManager) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		names, err := dm.ContainerNames(ctx)
+		if err != nil {
+			return nil
+		}
+		return app.ContainerNamesMsg(names)
+	}
+}
+
+// ContainerStatsSampleCmd samples the stats of the given containers.
+func ContainerStatsSampleCmd(dm *app.DockerManager, containerIDs []string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		return app.ContainerStatsSampleMsg(dm.SampleContainerStats(ctx, containerIDs))
+	}
+}
+
+// ProjectOperationCmd restarts, starts or stops every container of a
+// Compose project.
+func ProjectOperationCmd(dm *app.DockerManager, project, operation string, containerIDs []string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.ProjectOperation(operation, containerIDs)
+		return app.ContainerOperationMsg{
+			ContainerID: project,
+			Operation:   "project_" + operation,
+			Success:     err == nil,
+			Error:       err,
+		}
+	}
+}
+
+func GetProjectLogsCmd(dm *app.DockerManager, project string, containers map[string]string, window app.LogsWindow) tea.Cmd {
+	return func() tea.Msg {
+		lines, err := dm.GetProjectLogs(containers, window)
+		return app.ContainerLogsMsg{
+			ContainerID: project,
+			Lines:       lines,
+			Error:       err,
+		}
+	}
+}
+
+func UpdateImagesCmd(dm *app.DockerManager) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		images, err := dm.ListImages(ctx)
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return app.ImagesMsg(images)
+	}
+}
+
+func RemoveImageCmd(dm *app.DockerManager, ref string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.RemoveImage(ref)
+		return app.ResourceOperationMsg{Ref: ref, Operation: "image_remove", Error: err}
+	}
+}
+
+func PruneDanglingImagesCmd(dm *app.DockerManager) tea.Cmd {
+	return func() tea.Msg {
+		deleted, reclaimed, err := dm.PruneDanglingImages()
+		msg := app.ResourceOperationMsg{Operation: "image_prune", Error: err}
+		if err == nil {
+			msg.Detail = fmt.Sprintf("%d removed, %s reclaimed", deleted, utils.FormatBytes(reclaimed))
+		}
+		return msg
+	}
+}
+
+func PullImageCmd(dm *app.DockerManager, ref string) tea.Cmd {
+	return func() tea.Msg {
+		status, err := dm.PullImage(ref)
+		return app.ResourceOperationMsg{Ref: ref, Operation: "image_pull", Detail: status, Error: err}
+	}
+}
+
+func UpdateVolumesCmd(dm *app.DockerManager, withSizes bool) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		volumes, err := dm.ListVolumes(ctx, withSizes)
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return app.VolumesMsg{Volumes: volumes, HasSizes: withSizes}
+	}
+}
+
+func RemoveVolumeCmd(dm *app.DockerManager, name string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.RemoveVolume(name)
+		return app.ResourceOperationMsg{Ref: name, Operation: "volume_remove", Error: err}
+	}
+}
+
+func PruneVolumesCmd(dm *app.DockerManager) tea.Cmd {
+	return func() tea.Msg {
+		deleted, reclaimed, err := dm.PruneVolumes()
+		msg := app.ResourceOperationMsg{Operation: "volume_prune", Error: err}
+		if err == nil {
+			msg.Detail = fmt.Sprintf("%d removed, %s reclaimed", deleted, utils.FormatBytes(reclaimed))
+		}
+		return msg
+	}
+}
+
+func UpdateNetworksCmd(dm *app.DockerManager) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		networks, err := dm.ListNetworks(ctx)
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return app.NetworksMsg(networks)
+	}
+}
+
+func RemoveNetworkCmd(dm *app.DockerManager, id string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.RemoveNetwork(id)
+		return app.ResourceOperationMsg{Ref: id, Operation: "network_remove", Error: err}
+	}
+}
+
+func PruneNetworksCmd(dm *app.DockerManager) tea.Cmd {
+	return func() tea.Msg {
+		deleted, err := dm.PruneNetworks()
+		msg := app.ResourceOperationMsg{Operation: "network_prune", Error: err}
+		if err == nil {
+			msg.Detail = fmt.Sprintf("%d removed", len(deleted))
+		}
+		return msg
+	}
+}
+
+func RestartContainerCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.RestartContainer(containerID)
+		return app.ContainerOperationMsg{
+			ContainerID: containerID,
+			Operation:   "restart",
+			Success:     err == nil,
+			Error:       err,
+		}
+	}
+}
+
+func StartContainerCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.StartContainer(containerID)
+		return app.ContainerOperationMsg{
+			ContainerID: containerID,
+			Operation:   "start",
+			Success:     err == nil,
+			Error:       err,
+		}
+	}
+}
+
+func StopContainerCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.StopContainer(containerID)
+		return app.ContainerOperationMsg{
+			ContainerID: containerID,
+			Operation:   "stop",
+			Success:     err == nil,
+			Error:       err,
+		}
+	}
+}
+
+func PauseContainerCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.PauseContainer(containerID)
+		return app.ContainerOperationMsg{
+			ContainerID: containerID,
+			Operation:   "pause",
+			Success:     err == nil,
+			Error:       err,
+		}
+	}
+}
+
+func UnpauseContainerCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.UnpauseContainer(containerID)
+		return app.ContainerOperationMsg{
+			ContainerID: containerID,
+			Operation:   "unpause",
+			Success:     err == nil,
+			Error:       err,
+		}
+	}
+}
+
+func DeleteContainerCmd(dm *app.DockerManager, containerID string, force bool) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.DeleteContainer(containerID, force)
+		return app.ContainerOperationMsg{
+			ContainerID: containerID,
+			Operation:   "delete",
+			Success:     err == nil,
+			Error:       err,
+		}
+	}
+}
+
+func ToggleContainerStateCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.ToggleContainerState(containerID)
+		return app.ContainerOperationMsg{
+			ContainerID: containerID,
+			Operation:   "toggle_start",
+			Success:     err == nil,
+			Error:       err,
+		}
+	}
+}
+
+func ToggleContainerPauseCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		err := dm.ToggleContainerPause(containerID)
+		return app.ContainerOperationMsg{
+			ContainerID: containerID,
+			Operation:   "toggle_pause",
+			Success:     err == nil,
+			Error:       err,
+		}
+	}
+}
+
+func GetContainerLimitsCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		limits, err := dm.GetContainerLimits(containerID)
+		return app.ContainerLimitsMsg{
+			ContainerID: containerID,
+			Limits:      limits,
+			Error:       err,
+		}
+	}
+}
+
+func UpdateContainerLimitsCmd(dm *app.DockerManager, containerID string, limits app.ContainerLimits) tea.Cmd {
+	return func() tea.Msg {
+		applied, warnings, err := dm.UpdateContainerLimits(containerID, limits)
+		return app.ContainerLimitsUpdatedMsg{
+			ContainerID: containerID,
+			Limits:      applied,
+			Warnings:    warnings,
+			Error:       err,
+		}
+	}
+}
+
+func GetFilesystemDiffCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		changes, err := dm.GetFilesystemDiff(containerID)
+		return app.ContainerDiffMsg{ContainerID: containerID, Changes: changes, Error: err}
+	}
+}
+
+func ListContainerDirCmd(dm *app.DockerManager, containerID, dir string) tea.Cmd {
+	return func() tea.Msg {
+		listing, err := dm.ListContainerDir(containerID, dir)
+		return app.ContainerDirMsg{ContainerID: containerID, Listing: listing, Error: err}
+	}
+}
+
+func CopyFromContainerCmd(dm *app.DockerManager, containerID, srcPath, destDir string) tea.Cmd {
+	return func() tea.Msg {
+		result, err := dm.CopyFromContainer(containerID, srcPath, destDir)
+		return app.ContainerCopyMsg{ContainerID: containerID, Source: srcPath, Result: result, Error: err}
+	}
+}
+
+// RunCommandCmd starts a command in a container. Its output is read with
+// NextExecOutputCmd.
+func RunCommandCmd(dm *app.DockerManager, containerID string, argv []string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		execID, lines, result, err := dm.RunCommand(ctx, containerID, argv)
+		if err != nil {
+			cancel()
+			return app.ExecStartedMsg{ContainerID: containerID, Error: err}
+		}
+		return app.ExecStartedMsg{
+			ContainerID: containerID,
+			ExecID:      execID,
+			Lines:       lines,
+			Result:      result,
+			CancelFunc:  cancel,
+		}
+	}
+}
+
+// maxExecBatch bounds the output lines delivered in one message.
+const maxExecBatch = 500
+
+// NextExecOutputCmd waits for more output of a command, batching the lines
+// already available, and reports the result once the output ends.
+func NextExecOutputCmd(execID string, lines <-chan app.ExecLine, result <-chan app.ExecResult) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-lines
+		if !ok {
+			return app.ExecDoneMsg{ExecID: execID, Result: <-result}
+		}
+		batch := []app.ExecLine{line}
+		for len(batch) < maxExecBatch {
+			select {
+			case line, ok := <-lines:
+				if !ok {
+					return app.ExecOutputMsg{ExecID: execID, Lines: batch}
+				}
+				batch = append(batch, line)
+			default:
+				return app.ExecOutputMsg{ExecID: execID, Lines: batch}
+			}
+		}
+		return app.ExecOutputMsg{ExecID: execID, Lines: batch}
+	}
+}
+
+func StartLogsStreamCmd(dm *app.DockerManager, containerID, since string) tea.Cmd {
+	return func() tea.Msg {
+		logChan, cancelFunc, err := dm.StreamContainerLogs(containerID, since)
+		if err != nil {
+			return app.ContainerLogsMsg{
+				ContainerID: containerID,
+				Error:       fmt.Errorf("streaming unavailable: %w", err),
+			}
+		}
+
+		return app.ContainerLogsStreamMsg{
+			ContainerID: containerID,
+			LogChan:     logChan,
+			CancelFunc:  cancelFunc,
+		}
+	}
+}
+
+func StopLogsStreamCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		return app.ContainerLogsStopMsg{
+			ContainerID: containerID,
+		}
+	}
+}
+
+func GetContainerLogsCmd(dm *app.DockerManager, containerID string, window app.LogsWindow) tea.Cmd {
+	return func() tea.Msg {
+		lines, err := dm.GetContainerLogs(containerID, window)
+		return app.ContainerLogsMsg{
+			ContainerID: containerID,
+			Lines:       lines,
+			Error:       err,
+		}
+	}
+}
+
+// ExportLogsCmd writes log lines to a new file in dir.
+func ExportLogsCmd(dir, name string, lines []app.LogLine, compress bool) tea.Cmd {
+	return func() tea.Msg {
+		path, err := app.ExportLogs(dir, name, lines, compress)
+		return app.LogsExportedMsg{Path: path, Lines: len(lines), Error: err}
+	}
+}
+
+func ExecShellCmd(dm *app.DockerManager, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		return app.ExecShellMsg{
+			ContainerID: containerID,
+		}
+	}
+}
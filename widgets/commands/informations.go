@@ -0,0 +1,19 @@
+package commands
+
+import (
+	"context"
+
+	info "github.com/System-Pulse/server-pulse/system/informations"
+	"github.com/System-Pulse/server-pulse/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func UpdateSystemInfo() tea.Cmd {
+	return func() tea.Msg {
+		system, err := info.CollectSystemInfo(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return info.SystemMsg(system)
+	}
+}
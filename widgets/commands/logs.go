@@ -0,0 +1,20 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/System-Pulse/server-pulse/system/logs"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// GetSystemLogs retrieves system logs based on filters
+func GetSystemLogs(lm *logs.LogManager, filters logs.LogFilters) tea.Cmd {
+	return func() tea.Msg {
+		infos, err := lm.CollectSystemLogs(context.Background(), filters)
+		if err != nil {
+			infos.ErrorMsg = fmt.Sprintf("Error loading logs: %v", err)
+		}
+		return logs.LogsMsg(infos)
+	}
+}
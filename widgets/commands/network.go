@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/System-Pulse/server-pulse/system/network"
+	"github.com/System-Pulse/server-pulse/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func GetConnections() tea.Cmd {
+	return func() tea.Msg {
+		connections, err := network.CollectConnections(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return network.ConnectionsMsg(connections)
+	}
+}
+
+func GetDNS() tea.Cmd {
+	return func() tea.Msg {
+		servers, err := network.CollectDNS(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		if len(servers) == 0 {
+			return network.DNSMsg([]network.DNSInfo{{Server: "No DNS servers found"}})
+		}
+		return network.DNSMsg(servers)
+	}
+}
+
+func GetRoutes() tea.Cmd {
+	return func() tea.Msg {
+		routes, err := network.CollectRoutes(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return network.RoutesMsg(routes)
+	}
+}
+
+func Ping(target string, count int) tea.Cmd {
+	return func() tea.Msg {
+		return network.PingMsg(network.RunPing(context.Background(), target, count))
+	}
+}
+
+// Traceroute asks to install traceroute when it is missing.
+func Traceroute(target string) tea.Cmd {
+	return func() tea.Msg {
+		if network.ValidTarget(target) && !network.TracerouteInstalled() {
+			return network.TracerouteInstallPromptMsg{Target: target}
+		}
+		return network.TracerouteMsg(network.RunTraceroute(context.Background(), target))
+	}
+}
+
+func InstallTraceroute(target string, sudoPassword string) tea.Cmd {
+	return func() tea.Msg {
+		if err := network.RunTracerouteInstall(context.Background(), sudoPassword); err != nil {
+			return network.TracerouteInstallResultMsg{
+				Target:          target,
+				Error:           err.Error(),
+				PasswordInvalid: errors.Is(err, network.ErrIncorrectPassword),
+			}
+		}
+		return network.TracerouteInstallResultMsg{Success: true, Target: target}
+	}
+}
+
+// RunSpeedTest performs a complete network speed test
+func RunSpeedTest() tea.Cmd {
+	return RunSpeedTestWithProgress(nil)
+}
+
+// RunSpeedTestWithProgress performs a speed test with progress updates
+func RunSpeedTestWithProgress(progressChan chan<- network.SpeedTestProgressMsg) tea.Cmd {
+	return func() tea.Msg {
+		result, err := network.MeasureSpeed(context.Background(), progressChan)
+		if err != nil {
+			return network.SpeedTestErrorMsg{Error: err.Error()}
+		}
+		return network.SpeedTestMsg(result)
+	}
+}
+
+// SpeedTest runs a complete network speed test
+func SpeedTest() tea.Cmd {
+	return RunSpeedTest()
+}
+
+// SpeedTestWithProgress runs a speed test with progress updates
+func SpeedTestWithProgress() tea.Cmd {
+	return RunSpeedTestWithProgress(nil)
+}
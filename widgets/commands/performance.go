@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/System-Pulse/server-pulse/system/performance"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// GetHealthMetrics collects health metrics and scores them. A failed
+// collection yields an empty message so the view leaves its loading state.
+func GetHealthMetrics() tea.Cmd {
+	return func() tea.Msg {
+		metrics, err := performance.CollectHealthMetrics(context.Background())
+		if err != nil {
+			return performance.HealthMetricsMsg{}
+		}
+		return performance.HealthMetricsMsg{Metrics: metrics, Score: performance.CalculateHealthScore(metrics)}
+	}
+}
+
+func GetIOMetrics() tea.Cmd {
+	return func() tea.Msg {
+		metrics, err := performance.CollectIOMetrics(context.Background())
+		return performance.IOMetricsMsg{Metrics: metrics, Error: err}
+	}
+}
+
+func GetCPUMetrics() tea.Cmd {
+	return func() tea.Msg {
+		metrics, err := performance.CollectCPUMetrics(context.Background())
+		return performance.CPUMetricsMsg{Metrics: metrics, Error: err}
+	}
+}
+
+func GetMemoryMetrics() tea.Cmd {
+	return func() tea.Msg {
+		metrics, err := performance.CollectMemoryMetrics(context.Background())
+		return performance.MemoryMetricsMsg{Metrics: metrics, Error: err}
+	}
+}
+
+func GetHealthMetricsCmd() tea.Cmd {
+	return func() tea.Msg {
+		return performance.GetHealthMetricsMsg{}
+	}
+}
+
+func GetIOMetricsCmd() tea.Cmd {
+	return func() tea.Msg {
+		return performance.GetIOMetricsMsg{}
+	}
+}
+
+func GetCPUMetricsCmd() tea.Cmd {
+	return func() tea.Msg {
+		return performance.GetCPUMetricsMsg{}
+	}
+}
+
+func GetMemoryMetricsCmd() tea.Cmd {
+	return func() tea.Msg {
+		return performance.GetMemoryMetricsMsg{}
+	}
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	proc "github.com/System-Pulse/server-pulse/system/process"
+	"github.com/System-Pulse/server-pulse/system/security"
+	"github.com/System-Pulse/server-pulse/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func UpdateProcesses() tea.Cmd {
+	return func() tea.Msg {
+		processes, err := proc.CollectProcesses(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return proc.ProcessMsg(processes)
+	}
+}
+
+// StopProcessesCmd stops pids in the background and reports the outcome.
+// The process list is refreshed by the receiver.
+func StopProcessesCmd(pids []int) tea.Cmd {
+	return func() tea.Msg {
+		msg := proc.ProcessOperationMsg{Operation: "kill"}
+		if len(pids) > 0 {
+			msg.PID = int32(pids[0])
+		}
+		if len(pids) > 1 {
+			msg.Detail = fmt.Sprintf("%d processes", len(pids))
+		}
+		msg.Error = proc.StopProcesses(pids)
+		return msg
+	}
+}
+
+func SendSignalCmd(pid int32, signal proc.SignalOption, sm *security.SecurityManager) tea.Cmd {
+	return func() tea.Msg {
+		return proc.ProcessOperationMsg{
+			PID:       pid,
+			Operation: "signal",
+			Detail:    fmt.Sprintf("SIG%s to PID %d", signal.Name, pid),
+			Error:     proc.SendSignal(int(pid), signal.Signal, sm),
+		}
+	}
+}
+
+func ReniceCmd(pid int32, nice int, sm *security.SecurityManager) tea.Cmd {
+	return func() tea.Msg {
+		return proc.ProcessOperationMsg{
+			PID:       pid,
+			Operation: "renice",
+			Detail:    fmt.Sprintf("PID %d to nice %d", pid, nice),
+			Error:     proc.Renice(int(pid), nice, sm),
+		}
+	}
+}
+
+func SetIOPriorityCmd(pid int32, priority proc.IOPriority, sm *security.SecurityManager) tea.Cmd {
+	return func() tea.Msg {
+		return proc.ProcessOperationMsg{
+			PID:       pid,
+			Operation: "ionice",
+			Detail:    fmt.Sprintf("PID %d to %s", pid, priority),
+			Error:     proc.SetIOPriority(int(pid), priority, sm),
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/System-Pulse/server-pulse/system/resource"
+	"github.com/System-Pulse/server-pulse/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func UpdateCPUInfo() tea.Cmd {
+	return func() tea.Msg {
+		info, err := resource.CollectCPU(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return resource.CpuMsg(info)
+	}
+}
+
+func UpdateMemoryInfo() tea.Cmd {
+	return func() tea.Msg {
+		info, err := resource.CollectMemory(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return resource.MemoryMsg(info)
+	}
+}
+
+func UpdateDiskInfo() tea.Cmd {
+	return func() tea.Msg {
+		disks, err := resource.CollectDisks(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return resource.DiskMsg(disks)
+	}
+}
+
+func UpdateNetworkInfo() tea.Cmd {
+	return func() tea.Msg {
+		info, err := resource.CollectNetwork(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return resource.NetworkMsg(info)
+	}
+}
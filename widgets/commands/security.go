@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/System-Pulse/server-pulse/system/security"
+	"github.com/System-Pulse/server-pulse/utils"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func RunSecurityChecks(sm *security.SecurityManager, domain string) tea.Cmd {
+	return func() tea.Msg {
+		checks, _ := sm.CollectSecurityChecks(context.Background(), domain)
+		return security.SecurityMsg(checks)
+	}
+}
+
+func RunCertificateDisplay(sm *security.SecurityManager) tea.Cmd {
+	if sm.Certificate == nil {
+		return nil
+	}
+
+	return func() tea.Msg {
+		info, err := sm.CollectCertificate(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return security.CertificateDisplayMsg(info)
+	}
+}
+
+func DisplaySSHRootInfos(sm *security.SecurityManager) tea.Cmd {
+	return func() tea.Msg {
+		info, err := sm.CollectSSHRoot(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return security.SSHRootMsg(info)
+	}
+}
+
+func DisplayOpenedPortsInfos(sm *security.SecurityManager) tea.Cmd {
+	return func() tea.Msg {
+		info, err := sm.CollectOpenedPorts(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return security.OpenedPortsMsg(info)
+	}
+}
+
+// DisplayFirewallInfos retrieves detailed firewall rules and information
+func DisplayFirewallInfos(sm *security.SecurityManager) tea.Cmd {
+	return func() tea.Msg {
+		info, err := sm.CollectFirewall(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return security.FirewallMsg(info)
+	}
+}
+
+// DisplayAutoBanInfos retrieves detailed auto-ban/intrusion prevention information
+func DisplayAutoBanInfos(sm *security.SecurityManager) tea.Cmd {
+	return func() tea.Msg {
+		info, err := sm.CollectAutoBan(context.Background())
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+		return security.AutoBanMsg(info)
+	}
+}
@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/charmbracelet/x/term"
+	"github.com/moby/moby/api/types/container"
+	"github.com/muesli/cancelreader"
+)
+
+// shellCommand starts bash when the image has it and sh otherwise.
+var shellCommand = []string{"sh", "-c", "command -v bash >/dev/null 2>&1 && exec bash || exec sh"}
+
+// ExecInteractiveShell attaches the terminal to a shell in a container through
+// the exec API, so that the docker CLI does not need to be installed. It must
+// be called while the TUI is suspended.
+func ExecInteractiveShell(dm *app.DockerManager, containerID string) error {
+	if !app.ValidContainerID(containerID) {
+		return fmt.Errorf("invalid container ID: %s", containerID)
+	}
+
+	fmt.Print("\033[?1049l")
+	fmt.Print("\033[2J\033[H")
+
+	fmt.Println("Type 'exit' to return to Server-Pulse")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	options := container.ExecOptions{
+		Tty:          true,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		Env:          []string{"TERM=" + shellTerm()},
+		Cmd:          shellCommand,
+	}
+	if width, height, err := term.GetSize(os.Stdout.Fd()); err == nil {
+		options.ConsoleSize = &[2]uint{uint(height), uint(width)}
+	}
+
+	created, err := dm.Cli.ContainerExecCreate(ctx, containerID, options)
+	if err != nil {
+		return fmt.Errorf("failed to create shell in container %s: %w", containerID, err)
+	}
+	resp, err := dm.Cli.ContainerExecAttach(ctx, created.ID, container.ExecAttachOptions{Tty: true, ConsoleSize: options.ConsoleSize})
+	if err != nil {
+		return fmt.Errorf("failed to attach to shell in container %s: %w", containerID, err)
+	}
+	defer resp.Close()
+
+	err = attachTerminal(ctx, dm, created.ID, resp.Conn, resp.Reader, resp.CloseWrite)
+	forceTerminalReset()
+	if err != nil {
+		fmt.Printf("Shell session ended with error: %v\n", err)
+		return err
+	}
+
+	inspectCtx, inspectCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer inspectCancel()
+	inspect, err := dm.Cli.ContainerExecInspect(inspectCtx, created.ID)
+	if err != nil {
+		return fmt.Errorf("failed to inspect shell in container %s: %w", containerID, err)
+	}
+	if inspect.ExitCode != 0 {
+		return fmt.Errorf("shell exited with code %d", inspect.ExitCode)
+	}
+	return nil
+}
+
+// attachTerminal copies the terminal to and from an attached exec session in
+// raw mode, forwarding window size changes, until the session output ends.
+func attachTerminal(ctx context.Context, dm *app.DockerManager, execID string, conn io.Writer, output io.Reader, closeWrite func() error) error {
+	fd := os.Stdin.Fd()
+	if term.IsTerminal(fd) {
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return fmt.Errorf("failed to set terminal to raw mode: %w", err)
+		}
+		defer term.Restore(fd, state)
+	}
+
+	// Stdin is read through a cancelable reader: a read still pending when
+	// the shell exits would otherwise swallow the first key of the TUI.
+	stdin, err := cancelreader.NewReader(os.Stdin)
+	if err != nil {
+		return err
+	}
+	defer stdin.Close()
+	defer stdin.Cancel()
+
+	resize := make(chan os.Signal, 1)
+	signal.Notify(resize, syscall.SIGWINCH)
+	defer signal.Stop(resize)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-resize:
+				resizeExec(ctx, dm, execID)
+			}
+		}
+	}()
+
+	go func() {
+		io.Copy(conn, stdin)
+		closeWrite()
+	}()
+
+	_, err = io.Copy(os.Stdout, output)
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+	return err
+}
+
+func resizeExec(ctx context.Context, dm *app.DockerManager, execID string) {
+	width, height, err := term.GetSize(os.Stdout.Fd())
+	if err != nil {
+		return
+	}
+	dm.Cli.ContainerExecResize(ctx, execID, container.ResizeOptions{Height: uint(height), Width: uint(width)})
+}
+
+func shellTerm() string {
+	if t := os.Getenv("TERM"); t != "" {
+		return t
+	}
+	return "xterm"
+}
+
+func forceTerminalReset() {
+	// Reset complet du terminal
+	fmt.Print("\033c") // Full terminal reset
+	time.Sleep(100 * time.Millisecond)
+	fmt.Print("\033[2J\033[H") // Clear and home
+}
@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/security"
+	"github.com/System-Pulse/server-pulse/widgets/commands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunCertificateDisplayBeforeCheck(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, commands.RunCertificateDisplay(security.NewSecurityManager()))
+}
@@ -7,34 +7,22 @@ import (
 	"time"
 
 	system "github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/utils"
 	model "github.com/System-Pulse/server-pulse/widgets/model"
+	v "github.com/System-Pulse/server-pulse/widgets/vars"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
-func (m *Model) setLiveContainerLogs(line string) {
-	if m.Monitor.ContainerLogsPagination.Lines == nil {
-		m.Monitor.ContainerLogsPagination.Lines = []string{}
-		m.Monitor.ContainerLogsPagination.PageSize = 50
-	}
-
-	m.Monitor.ContainerLogsPagination.Lines = append(m.Monitor.ContainerLogsPagination.Lines, line)
+// maxLiveLogLines bounds the lines kept while streaming logs.
+const maxLiveLogLines = 1000
 
-	maxLines := 1000
-	if len(m.Monitor.ContainerLogsPagination.Lines) > maxLines {
-		m.Monitor.ContainerLogsPagination.Lines = m.Monitor.ContainerLogsPagination.Lines[len(m.Monitor.ContainerLogsPagination.Lines)-maxLines:]
-	}
-
-	total := len(m.Monitor.ContainerLogsPagination.Lines)
+func (m *Model) setLiveContainerLogs(line system.LogLine) {
 	if m.Monitor.ContainerLogsPagination.PageSize <= 0 {
 		m.Monitor.ContainerLogsPagination.PageSize = 50
 	}
-
-	m.Monitor.ContainerLogsPagination.TotalPages = max(1, int(math.Ceil(float64(total)/float64(m.Monitor.ContainerLogsPagination.PageSize))))
-
-	m.Monitor.ContainerLogsPagination.CurrentPage = m.Monitor.ContainerLogsPagination.TotalPages
-
-	m.updateLogsViewport()
+	m.Monitor.Logs.Append(line, maxLiveLogLines)
+	m.updateContainerLogLines(true)
 }
 
 func (m Model) readNextLogLine() tea.Cmd {
@@ -67,6 +55,12 @@ func (m Model) handleLogLineMsg(msg system.ContainerLogLineMsg) (tea.Model, tea.
 		m.Monitor.ContainerLogsStreaming {
 
 		m.setLiveContainerLogs(msg.Line)
+		if m.Monitor.LogsTee != nil {
+			if err := m.Monitor.LogsTee.WriteLine(msg.Line); err != nil {
+				m.stopLogsTee()
+				return m, tea.Batch(m.readNextLogLine(), clearOperationMessage())
+			}
+		}
 
 		return m, m.readNextLogLine()
 	}
@@ -80,31 +74,129 @@ func (m Model) handleLogsStopMsg() (tea.Model, tea.Cmd) {
 	m.Monitor.ContainerLogsStreaming = false
 	m.Monitor.ContainerLogsChan = nil
 	m.Monitor.LogsCancelFunc = nil
+	if m.stopLogsTee() {
+		return m, clearOperationMessage()
+	}
 
 	return m, nil
 }
 
-// static logs
+// StopLogsTee closes the file the live logs are copied to, if any. It is
+// called on exit so that a compressed file is complete.
+func (m *Model) StopLogsTee() {
+	m.stopLogsTee()
+}
 
-func (m *Model) setContainerLogs(logs string) {
-	lines := strings.Split(strings.TrimSpace(logs), "\n")
+// stopLogsTee closes the file the live logs are copied to and reports where
+// they were saved. It returns false when no logs were being recorded.
+func (m *Model) stopLogsTee() bool {
+	tee := m.Monitor.LogsTee
+	if tee == nil {
+		return false
+	}
+	m.Monitor.LogsTee = nil
+	err := tee.Close()
+	m.LastOperationMsg = utils.FormatOperationMessage("logs_export", err == nil, err)
+	if err == nil {
+		m.LastOperationMsg += fmt.Sprintf(" (%d lines → %s)", tee.Lines, tee.Path)
+	}
+	return true
+}
 
-	m.Monitor.ContainerLogsPagination.Lines = lines
+// static logs
+
+func (m *Model) setContainerLogs(lines []system.LogLine) {
 	m.Monitor.ContainerLogsPagination.PageSize = 12 // lines by page
-	m.Monitor.ContainerLogsPagination.CurrentPage = 1
-
-	total := len(lines)
-	if total == 0 {
-		m.Monitor.ContainerLogsPagination.TotalPages = 1
-	} else {
-		m.Monitor.ContainerLogsPagination.TotalPages =
-			(int(math.Ceil(float64(total) / float64(m.Monitor.ContainerLogsPagination.PageSize))))
-		m.Monitor.ContainerLogsPagination.CurrentPage = m.Monitor.ContainerLogsPagination.TotalPages
+	m.Monitor.Logs.SetLines(lines)
+	m.updateContainerLogLines(true)
+}
+
+// updateContainerLogLines renders the logs passing the filters into pages.
+// With follow the last page is shown, otherwise the page of the current
+// search match, if any.
+func (m *Model) updateContainerLogLines(follow bool) {
+	view := &m.Monitor.Logs
+	pagination := &m.Monitor.ContainerLogsPagination
+	if pagination.PageSize <= 0 {
+		pagination.PageSize = 12
 	}
 
+	current := view.CurrentMatch()
+	lines := make([]string, len(view.Visible))
+	for i, idx := range view.Visible {
+		lines[i] = formatLogLine(view.Lines[idx], *view, i == current)
+	}
+	if len(lines) == 0 && len(view.Lines) > 0 {
+		lines = []string{v.MetricLabelStyle.Render("No lines match the filters")}
+	}
+	pagination.Lines = lines
+	pagination.TotalPages = max(1, int(math.Ceil(float64(len(lines))/float64(pagination.PageSize))))
+
+	switch {
+	case current >= 0 && !follow:
+		pagination.CurrentPage = current/pagination.PageSize + 1
+	case follow:
+		pagination.CurrentPage = pagination.TotalPages
+	}
 	m.updateLogsViewport()
 }
 
+var (
+	logMatchStyle   = lipgloss.NewStyle().Background(lipgloss.Color("220")).Foreground(lipgloss.Color("0"))
+	logStderrMarker = lipgloss.NewStyle().Foreground(v.ErrorColor).Render("▌")
+	logLevelStyles  = map[string]lipgloss.Style{
+		"trace": lipgloss.NewStyle().Foreground(lipgloss.Color("243")),
+		"debug": lipgloss.NewStyle().Foreground(lipgloss.Color("245")),
+		"info":  lipgloss.NewStyle().Foreground(v.AccentColor),
+		"warn":  lipgloss.NewStyle().Foreground(lipgloss.Color("214")),
+		"error": lipgloss.NewStyle().Foreground(v.ErrorColor),
+		"fatal": lipgloss.NewStyle().Foreground(v.ErrorColor).Bold(true),
+	}
+)
+
+// formatLogLine renders a log line with a red marker for stderr, its
+// timestamp, service and level, and the search matches highlighted. The
+// current match is pointed at.
+func formatLogLine(line system.LogLine, view model.ContainerLogView, current bool) string {
+	var b strings.Builder
+	switch {
+	case current:
+		b.WriteString("▶")
+	case line.Stderr:
+		b.WriteString(logStderrMarker)
+	default:
+		b.WriteString(" ")
+	}
+	if view.Timestamps && !line.Time.IsZero() {
+		b.WriteString(v.MetricLabelStyle.Render(line.Time.Local().Format("2006-01-02 15:04:05.000")))
+		b.WriteString(" ")
+	}
+	if line.Service != "" {
+		b.WriteString(line.Service + " | ")
+	}
+	if style, ok := logLevelStyles[line.Level]; ok {
+		b.WriteString(style.Render(fmt.Sprintf("%-5s", strings.ToUpper(line.Level))))
+		b.WriteString(" ")
+	}
+
+	text := line.Text
+	if view.Search == nil {
+		b.WriteString(text)
+		return b.String()
+	}
+	last := 0
+	for _, match := range view.Search.FindAllStringIndex(text, -1) {
+		if match[0] == match[1] {
+			continue
+		}
+		b.WriteString(text[last:match[0]])
+		b.WriteString(logMatchStyle.Render(text[match[0]:match[1]]))
+		last = match[1]
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
 func (m *Model) updateLogsViewport() {
 	if len(m.Monitor.ContainerLogsPagination.Lines) == 0 {
 		m.LogsViewport.SetContent("No logs available")
@@ -129,6 +221,34 @@ func (m *Model) updateLogsViewport() {
 	m.LogsViewport.SetContent(pageContent)
 }
 
+// updateExecViewport shows the output of the last command, following it
+// while the view is scrolled to the bottom. Stderr lines are shown in red.
+func (m *Model) updateExecViewport() {
+	exec := &m.Monitor.Exec
+	follow := exec.Viewport.AtBottom()
+	stderr := lipgloss.NewStyle().Foreground(v.ErrorColor)
+
+	var content strings.Builder
+	if exec.Dropped > 0 {
+		content.WriteString(v.MetricLabelStyle.Render(fmt.Sprintf("… %d earlier lines dropped", exec.Dropped)))
+		content.WriteString("\n")
+	}
+	for i, line := range exec.Output {
+		if i > 0 {
+			content.WriteString("\n")
+		}
+		if line.Stderr {
+			content.WriteString(stderr.Render(line.Text))
+		} else {
+			content.WriteString(line.Text)
+		}
+	}
+	exec.Viewport.SetContent(content.String())
+	if follow {
+		exec.Viewport.GotoBottom()
+	}
+}
+
 func (m Model) handleLogsStreamMsg(msg system.ContainerLogsStreamMsg) (tea.Model, tea.Cmd) {
 	m.Monitor.ContainerLogsStreaming = true
 	m.Monitor.ContainerLogsLoading = false
@@ -136,6 +256,7 @@ func (m Model) handleLogsStreamMsg(msg system.ContainerLogsStreamMsg) (tea.Model
 	m.Monitor.LogsCancelFunc = msg.CancelFunc
 
 	m.Monitor.ContainerLogsPagination.Clear()
+	m.Monitor.Logs.SetLines(nil)
 	m.updateLogsViewport()
 
 	return m, m.readNextLogLine()
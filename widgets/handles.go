@@ -14,6 +14,7 @@ import (
 	logs "github.com/System-Pulse/server-pulse/system/logs"
 	"github.com/System-Pulse/server-pulse/system/performance"
 	proc "github.com/System-Pulse/server-pulse/system/process"
+	"github.com/System-Pulse/server-pulse/widgets/commands"
 	model "github.com/System-Pulse/server-pulse/widgets/model"
 	v "github.com/System-Pulse/server-pulse/widgets/vars"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/System-Pulse/server-pulse/system/security"
 	"github.com/System-Pulse/server-pulse/utils"
 	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -61,27 +63,35 @@ func (m Model) handleResourceAndProcessMsgs(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.Network.NetworkResource = resource.NetworkInfo(msg)
 		cmds = append(cmds, m.updateNetworkTable())
 	case proc.ProcessMsg:
-		m.Monitor.Processes = []proc.ProcessInfo(msg)
+		now := time.Now()
+		processes := []proc.ProcessInfo(msg)
+		if !m.Monitor.ProcessesUpdatedAt.IsZero() {
+			proc.FillIORates(m.Monitor.Processes, processes, now.Sub(m.Monitor.ProcessesUpdatedAt))
+		}
+		m.Monitor.Processes = processes
+		m.Monitor.ProcessesUpdatedAt = now
 		m.applySortPreference()
 		return m, m.updateProcessTable()
+	case proc.ProcessOperationMsg:
+		m.LastOperationMsg = utils.FormatOperationMessage(msg.Operation, msg.Error == nil, msg.Error)
+		if msg.Detail != "" {
+			m.LastOperationMsg += " (" + msg.Detail + ")"
+		}
+		return m, tea.Batch(commands.UpdateProcesses(), clearOperationMessage())
+	case proc.ProcessDetailsMsg:
+		m.Monitor.ProcessDetails = msg.Details
+		m.Monitor.ProcessContainerName = msg.ContainerName
+		if m.Ui.State != model.StateProcessDetails {
+			m.setState(model.StateProcessDetails)
+			m.Ui.Viewport.GotoTop()
+		}
+		return m, nil
 	case performance.HealthMetricsMsg:
 		if msg.Metrics != nil {
-			m.Diagnostic.Performance.HealthMetrics = &model.HealthMetrics{
-				IOWait:          msg.Metrics.IOWait,
-				ContextSwitches: msg.Metrics.ContextSwitches,
-				Interrupts:      msg.Metrics.Interrupts,
-				StealTime:       msg.Metrics.StealTime,
-				MajorFaults:     msg.Metrics.MajorFaults,
-				MinorFaults:     msg.Metrics.MinorFaults,
-			}
+			m.Diagnostic.Performance.HealthMetrics = msg.Metrics
 		}
 		if msg.Score != nil {
-			m.Diagnostic.Performance.HealthScore = &model.HealthScore{
-				Score:           msg.Score.Score,
-				Issues:          msg.Score.Issues,
-				Recommendations: msg.Score.Recommendations,
-				ChecksPerformed: msg.Score.ChecksPerformed,
-			}
+			m.Diagnostic.Performance.HealthScore = msg.Score
 		}
 		m.Diagnostic.Performance.HealthLoading = false
 		return m, nil
@@ -115,6 +125,10 @@ func (m Model) handleResourceAndProcessMsgs(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // ------------------------- Handlers for container-related messages -------------------------
 
+// eventsReconnectDelay is the wait before subscribing again to container
+// events after the stream ended, e.g. because the daemon restarted.
+const eventsReconnectDelay = 5 * time.Second
+
 func (m Model) handleContainerRelatedMsgs(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case system.ContainerMsg:
@@ -130,7 +144,57 @@ func (m Model) handleContainerRelatedMsgs(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 		}
-		return m, m.updateContainerTable(containers)
+		m.Monitor.Containers = containers
+		m.Monitor.ContainersUpdatedAt = time.Now()
+		return m, m.updateContainerTable()
+	case system.ContainerUpdateMsg:
+		m.Monitor.Containers = model.ReplaceContainer(m.Monitor.Containers, msg.ID, msg.Container, msg.Found)
+		return m, m.updateContainerTable()
+	case system.EventsStreamMsg:
+		m.Monitor.EventsChan = msg.Events
+		m.Monitor.EventsErrs = msg.Errors
+		m.Monitor.EventsCancelFunc = msg.CancelFunc
+		m.Monitor.EventsError = nil
+		return m, commands.NextEventCmd(msg.Events, msg.Errors)
+	case system.ContainerEventMsg:
+		event := system.ContainerEvent(msg)
+		m.Monitor.Events.Add(event)
+		cmds := []tea.Cmd{commands.NextEventCmd(m.Monitor.EventsChan, m.Monitor.EventsErrs), m.updateEventTable()}
+		// Replayed events older than the last full refresh are already
+		// reflected in the container list.
+		if event.Time.After(m.Monitor.ContainersUpdatedAt) {
+			if event.Action == "destroy" {
+				m.Monitor.Containers = model.ReplaceContainer(m.Monitor.Containers, event.ContainerID, system.Container{}, false)
+				cmds = append(cmds, m.updateContainerTable())
+			} else if m.Monitor.App != nil {
+				cmds = append(cmds, commands.GetContainerCmd(m.Monitor.App, event.ContainerID))
+			}
+		}
+		return m, tea.Batch(cmds...)
+	case system.EventsStopMsg:
+		if m.Monitor.EventsCancelFunc != nil {
+			m.Monitor.EventsCancelFunc()
+		}
+		m.Monitor.EventsChan = nil
+		m.Monitor.EventsErrs = nil
+		m.Monitor.EventsCancelFunc = nil
+		m.Monitor.EventsError = msg.Error
+		if m.Monitor.App == nil {
+			return m, nil
+		}
+		// Resume after the last event seen; the periodic container
+		// refresh covers the gap in the meantime.
+		since := m.eventsSince()
+		dm := m.Monitor.App
+		return m, tea.Tick(eventsReconnectDelay, func(time.Time) tea.Msg {
+			return commands.WatchEventsCmd(dm, since)()
+		})
+	case system.ContainerStatsSampleMsg:
+		m.Monitor.ContainerStats = map[string]system.ContainerStats(msg)
+		return m, m.updateContainerTable()
+	case system.ContainerNamesMsg:
+		m.Monitor.ContainerNames = map[string]string(msg)
+		return m, m.updateProcessTable()
 	case system.ContainerDetailsMsg:
 		details := system.ContainerDetails(msg)
 		m.Monitor.ContainerDetails = &details
@@ -140,7 +204,7 @@ func (m Model) handleContainerRelatedMsgs(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if logsMsg.Error != nil {
 			if strings.Contains(logsMsg.Error.Error(), "streaming unavailable") {
 				m.Monitor.ContainerLogs = fmt.Sprintf("Streaming not available: %v\nShowing static logs instead...", logsMsg.Error)
-				return m, m.Monitor.App.GetContainerLogsCmd(m.Monitor.SelectedContainer.ID)
+				return m, commands.GetContainerLogsCmd(m.Monitor.App, m.Monitor.SelectedContainer.ID, m.Monitor.Logs.Window)
 			} else {
 				m.Monitor.ContainerLogs = fmt.Sprintf("Error loading logs: %v", logsMsg.Error)
 			}
@@ -148,8 +212,8 @@ func (m Model) handleContainerRelatedMsgs(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.Monitor.ContainerLogsPagination.TotalPages = 1
 			m.Monitor.ContainerLogsPagination.CurrentPage = 1
 		} else {
-			m.Monitor.ContainerLogs = logsMsg.Logs
-			m.setContainerLogs(m.Monitor.ContainerLogs)
+			m.Monitor.ContainerLogs = ""
+			m.setContainerLogs(logsMsg.Lines)
 		}
 	case system.ContainerOperationMsg:
 		opMsg := system.ContainerOperationMsg(msg)
@@ -158,7 +222,142 @@ func (m Model) handleContainerRelatedMsgs(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		var refreshCmd tea.Cmd
 		if opMsg.Success && m.Monitor.App != nil {
-			refreshCmd = m.Monitor.App.UpdateApp()
+			refreshCmd = commands.UpdateApp(m.Monitor.App)
+		}
+		return m, tea.Batch(refreshCmd, clearOperationMessage())
+	case system.ContainerDiffMsg:
+		files := &m.Monitor.Files
+		if files.ContainerID != msg.ContainerID {
+			return m, nil
+		}
+		if files.View == model.FilesChanges {
+			files.Loading = false
+		}
+		if msg.Error != nil {
+			files.Error = msg.Error.Error()
+			return m, nil
+		}
+		files.Changes = msg.Changes
+		return m, m.updateFilesTable()
+	case system.ContainerDirMsg:
+		files := &m.Monitor.Files
+		if files.ContainerID != msg.ContainerID {
+			return m, nil
+		}
+		if files.View == model.FilesBrowse {
+			files.Loading = false
+		}
+		if msg.Error != nil {
+			files.Error = msg.Error.Error()
+			return m, nil
+		}
+		if files.Dir.Path != msg.Listing.Path && files.View == model.FilesBrowse {
+			files.Table.SetCursor(0)
+		}
+		files.Dir = msg.Listing
+		return m, m.updateFilesTable()
+	case system.ContainerCopyMsg:
+		m.OperationInProgress = false
+		m.LastOperationMsg = utils.FormatOperationMessage("copy", msg.Error == nil, msg.Error)
+		if msg.Error == nil {
+			m.LastOperationMsg += fmt.Sprintf(" (%s → %s, %d files, %s)",
+				msg.Source, msg.Result.Dest, msg.Result.Files, utils.FormatBytes(uint64(msg.Result.Bytes)))
+		}
+		return m, clearOperationMessage()
+	case system.LogsExportedMsg:
+		m.OperationInProgress = false
+		m.LastOperationMsg = utils.FormatOperationMessage("logs_export", msg.Error == nil, msg.Error)
+		if msg.Error == nil {
+			m.LastOperationMsg += fmt.Sprintf(" (%d lines → %s)", msg.Lines, msg.Path)
+		}
+		return m, clearOperationMessage()
+	case system.ExecStartedMsg:
+		exec := &m.Monitor.Exec
+		if msg.ContainerID != exec.ContainerID || !exec.Running || exec.ExecID != "" {
+			if msg.CancelFunc != nil {
+				msg.CancelFunc()
+			}
+			return m, nil
+		}
+		if msg.Error != nil {
+			exec.Stop()
+			exec.Error = msg.Error.Error()
+			return m, nil
+		}
+		exec.ExecID = msg.ExecID
+		exec.Lines = msg.Lines
+		exec.Results = msg.Result
+		exec.CancelFunc = msg.CancelFunc
+		return m, commands.NextExecOutputCmd(msg.ExecID, msg.Lines, msg.Result)
+	case system.ExecOutputMsg:
+		exec := &m.Monitor.Exec
+		if msg.ExecID != exec.ExecID {
+			return m, nil
+		}
+		exec.AppendOutput(msg.Lines)
+		m.updateExecViewport()
+		return m, commands.NextExecOutputCmd(exec.ExecID, exec.Lines, exec.Results)
+	case system.ExecDoneMsg:
+		exec := &m.Monitor.Exec
+		if msg.ExecID != exec.ExecID {
+			return m, nil
+		}
+		exec.Stop()
+		exec.Result = &msg.Result
+		m.updateExecViewport()
+		return m, nil
+	case system.ContainerLimitsMsg:
+		form := &m.Monitor.LimitsForm
+		if form.ContainerID != msg.ContainerID {
+			return m, nil
+		}
+		if msg.Error != nil {
+			form.Error = msg.Error.Error()
+			return m, nil
+		}
+		return m, form.SetLimits(msg.Limits)
+	case system.ContainerLimitsUpdatedMsg:
+		m.OperationInProgress = false
+		m.LastOperationMsg = utils.FormatOperationMessage("update_limits", msg.Error == nil, msg.Error)
+		form := &m.Monitor.LimitsForm
+		if form.ContainerID != msg.ContainerID {
+			return m, clearOperationMessage()
+		}
+		form.Applying = false
+		form.Warnings = msg.Warnings
+		if msg.Error != nil {
+			form.Error = msg.Error.Error()
+			return m, clearOperationMessage()
+		}
+		form.Error = ""
+		form.Before = form.Current
+		return m, tea.Batch(form.SetLimits(msg.Limits), clearOperationMessage())
+	case system.ImagesMsg:
+		m.Monitor.Images = []system.Image(msg)
+		return m, m.updateImageTable()
+	case system.VolumesMsg:
+		if !msg.HasSizes {
+			model.MergeVolumeSizes(m.Monitor.Volumes, msg.Volumes)
+		}
+		m.Monitor.Volumes = msg.Volumes
+		return m, m.updateVolumeTable()
+	case system.NetworksMsg:
+		m.Monitor.DockerNetworks = []system.Network(msg)
+		return m, m.updateDockerNetworkTable()
+	case system.ResourceOperationMsg:
+		m.OperationInProgress = false
+		m.LastOperationMsg = utils.FormatOperationMessage(msg.Operation, msg.Error == nil, msg.Error)
+		if msg.Detail != "" {
+			m.LastOperationMsg += " (" + msg.Detail + ")"
+		}
+		var refreshCmd tea.Cmd
+		switch {
+		case strings.HasPrefix(msg.Operation, "image_"):
+			refreshCmd = commands.UpdateImagesCmd(m.Monitor.App)
+		case strings.HasPrefix(msg.Operation, "volume_"):
+			refreshCmd = commands.UpdateVolumesCmd(m.Monitor.App, true)
+		case strings.HasPrefix(msg.Operation, "network_"):
+			refreshCmd = commands.UpdateNetworksCmd(m.Monitor.App)
 		}
 		return m, tea.Batch(refreshCmd, clearOperationMessage())
 	case system.ExecShellMsg:
@@ -196,10 +395,30 @@ func (m Model) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 	tableHeight := max(1, m.Ui.ContentHeight-3)
 
 	m.Monitor.ProcessTable.SetWidth(msg.Width)
-	m.Monitor.ProcessTable.SetHeight(tableHeight)
+	// One line is taken by the process count and page indicator.
+	m.Monitor.ProcessTable.SetHeight(max(1, tableHeight-1))
 
+	// One line is taken by the running count.
 	m.Monitor.Container.SetWidth(msg.Width)
-	m.Monitor.Container.SetHeight(tableHeight)
+	m.Monitor.Container.SetHeight(max(1, tableHeight-1))
+
+	// One line is taken by the disk usage summary.
+	m.Monitor.ImageTable.SetWidth(msg.Width)
+	m.Monitor.ImageTable.SetHeight(max(1, tableHeight-1))
+	m.Monitor.VolumeTable.SetWidth(msg.Width)
+	m.Monitor.VolumeTable.SetHeight(max(1, tableHeight-1))
+	// The attached containers of the selected network are listed below.
+	m.Monitor.DockerNetworkTable.SetWidth(msg.Width)
+	m.Monitor.DockerNetworkTable.SetHeight(max(1, tableHeight-networkEndpointLines-2))
+	// The failure summary takes up to eventAlertLines lines plus a header.
+	m.Monitor.EventTable.SetWidth(msg.Width)
+	m.Monitor.EventTable.SetHeight(max(1, tableHeight-eventAlertLines-1))
+	// The container tabs, the title, the summary and the hint line.
+	m.Monitor.Files.Table.SetWidth(msg.Width)
+	m.Monitor.Files.Table.SetHeight(max(1, tableHeight-filesHeaderLines))
+	m.Monitor.Exec.Viewport.Width = max(1, msg.Width-6)
+	m.Monitor.Exec.Viewport.Height = max(1, tableHeight-execHeaderLines)
+	m.Monitor.Exec.Input.Width = max(10, msg.Width-20)
 
 	m.Network.NetworkTable.SetWidth(msg.Width)
 	m.Network.NetworkTable.SetHeight(tableHeight)
@@ -239,12 +458,26 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleSystemKeys(msg)
 	case model.StateProcess:
 		return m.handleProcessKeys(msg)
+	case model.StateProcessDetails:
+		return m.handleProcessDetailsKeys(msg)
 	case model.StateContainers:
 		return m.handleContainersKeys(msg)
+	case model.StateImages:
+		return m.handleImagesKeys(msg)
+	case model.StateVolumes:
+		return m.handleVolumesKeys(msg)
+	case model.StateDockerNetworks:
+		return m.handleDockerNetworksKeys(msg)
+	case model.StateDockerEvents:
+		return m.handleDockerEventsKeys(msg)
 	case model.StateContainer:
 		return m.handleContainerSingleKeys(msg)
 	case model.StateContainerLogs:
 		return m.handleContainerLogsKeys(msg)
+	case model.StateContainerLimits:
+		return m.handleContainerLimitsKeys(msg)
+	case model.StateContainerExec:
+		return m.handleContainerExecKeys(msg)
 	case model.StateNetwork:
 		return m.handleNetworkKeys(msg)
 	case model.StateDiagnostics:
@@ -290,7 +523,7 @@ func (m Model) handleHomeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Auto-load security checks if we're on security tab and they're not loaded
 			if m.Diagnostic.SelectedItem == model.DiagnosticSecurityChecks && len(m.Diagnostic.SecurityChecks) == 0 {
 				domain := m.Diagnostic.DomainInput.Value()
-				return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+				return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 			}
 		case 2:
 			m.setState(model.StateNetwork)
@@ -306,42 +539,24 @@ func (m Model) handleHomeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// monitorStates are the states of the Monitor sub-tabs, in tab order.
+var monitorStates = []model.AppState{
+	model.StateSystem, model.StateProcess, model.StateContainers,
+	model.StateImages, model.StateVolumes, model.StateDockerNetworks,
+	model.StateDockerEvents,
+}
+
 func (m Model) handleGeneralKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "?":
 		m.HelpSystem.ToggleHelp()
-	case "1", "2", "3":
+	case "1", "2", "3", "4", "5", "6", "7":
 		monitorIndex, _ := strconv.Atoi(msg.String())
-		m.Ui.SelectedMonitor = monitorIndex - 1
-
-		switch m.Ui.SelectedMonitor {
-		case 0:
-			m.setState(model.StateSystem)
-		case 1:
-			m.setState(model.StateProcess)
-		case 2:
-			m.setState(model.StateContainers)
-		}
+		m.setState(monitorStates[monitorIndex-1])
 	case "tab", "right", "l":
-		m.Ui.SelectedMonitor = (m.Ui.SelectedMonitor + 1) % len(m.Ui.Tabs.Monitor)
-		switch m.Ui.SelectedMonitor {
-		case 0:
-			m.setState(model.StateSystem)
-		case 1:
-			m.setState(model.StateProcess)
-		case 2:
-			m.setState(model.StateContainers)
-		}
+		m.setState(monitorStates[(m.Ui.SelectedMonitor+1)%len(monitorStates)])
 	case "shift+tab", "left", "h":
-		m.Ui.SelectedMonitor = (m.Ui.SelectedMonitor - 1 + len(m.Ui.Tabs.Monitor)) % len(m.Ui.Tabs.Monitor)
-		switch m.Ui.SelectedMonitor {
-		case 0:
-			m.setState(model.StateSystem)
-		case 1:
-			m.setState(model.StateProcess)
-		case 2:
-			m.setState(model.StateContainers)
-		}
+		m.setState(monitorStates[(m.Ui.SelectedMonitor-1+len(monitorStates))%len(monitorStates)])
 	case "b", "esc":
 		m.goBack()
 	case "q", "ctrl+c":
@@ -372,6 +587,13 @@ func (m Model) handleSystemKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) handleProcessKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.Monitor.ProcessSignalMenu {
+		return m.handleProcessSignalMenuKeys(msg)
+	}
+	if m.Monitor.ProcessInputMode != model.ProcessInputNone {
+		return m.handleProcessInputKeys(msg)
+	}
+
 	switch msg.String() {
 	case "?":
 		m.HelpSystem.ToggleHelp()
@@ -382,64 +604,596 @@ func (m Model) handleProcessKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Monitor.ProcessTable.MoveUp(1)
 	case "down", "j":
 		m.Monitor.ProcessTable.MoveDown(1)
+	case "pgup":
+		m.Monitor.ProcessTable.MoveUp(m.Monitor.ProcessTable.Height())
+	case "pgdown":
+		m.Monitor.ProcessTable.MoveDown(m.Monitor.ProcessTable.Height())
+	case "home":
+		m.Monitor.ProcessTable.GotoTop()
+	case "end":
+		m.Monitor.ProcessTable.GotoBottom()
 	case "s":
-		m.Monitor.ProcessSort = model.ProcessSortByCPU
-		m.applySortPreference()
-		return m, m.updateProcessTable()
+		return m.sortProcessesBy(model.ProcessSortByCPU)
 	case "m":
-		m.Monitor.ProcessSort = model.ProcessSortByMem
+		return m.sortProcessesBy(model.ProcessSortByMem)
+	case ">", ".":
+		return m.sortProcessesBy(m.Monitor.ProcessSort.Next())
+	case "<", ",":
+		return m.sortProcessesBy(m.Monitor.ProcessSort.Prev())
+	case "r":
+		m.Monitor.ProcessSortReverse = !m.Monitor.ProcessSortReverse
 		m.applySortPreference()
 		return m, m.updateProcessTable()
+	case "t":
+		m.Monitor.ProcessTreeMode = !m.Monitor.ProcessTreeMode
+		return m, m.updateProcessTable()
+	case "g":
+		m.Monitor.ProcessGroupBy = m.Monitor.ProcessGroupBy.Next()
+		m.Monitor.ProcessGroupFilter = ""
+		m.Monitor.ProcessTable.SetCursor(0)
+		return m, m.updateProcessTable()
+	case "enter":
+		if m.showingProcessGroups() {
+			if row := m.Monitor.ProcessTable.SelectedRow(); len(row) > 0 {
+				m.Monitor.ProcessGroupFilter = row[0]
+				m.Monitor.ProcessTable.SetCursor(0)
+				return m, m.updateProcessTable()
+			}
+			return m, nil
+		}
+		if pid, ok := m.selectedProcessPID(); ok {
+			return m, loadProcessDetails(m.Monitor.App, pid)
+		}
+	case "b", "esc":
+		if m.Monitor.ProcessGroupFilter != "" {
+			m.Monitor.ProcessGroupFilter = ""
+			return m, m.updateProcessTable()
+		}
+		return m.handleGeneralKeys(msg)
+	case " ":
+		if pid, ok := m.selectedProcessPID(); ok && m.Monitor.ProcessTreeMode {
+			if m.Monitor.ProcessCollapsed == nil {
+				m.Monitor.ProcessCollapsed = make(map[int32]bool)
+			}
+			if m.Monitor.ProcessCollapsed[pid] {
+				delete(m.Monitor.ProcessCollapsed, pid)
+			} else {
+				m.Monitor.ProcessCollapsed[pid] = true
+			}
+			return m, m.updateProcessTable()
+		}
+	case "x":
+		if _, ok := m.selectedProcessPID(); ok {
+			m.Monitor.ProcessSignalMenu = true
+			m.Monitor.ProcessSignalSelected = 0
+		}
+	case "n":
+		return m.openProcessInput(model.ProcessInputNice, "Nice value (-20 to 19)...")
+	case "i":
+		return m.openProcessInput(model.ProcessInputIOPriority, "I/O priority: idle, be:0-7 or rt:0-7...")
+	case "K": // stop process and all of its descendants
+		return m.confirmStopSubtree()
 	case "k": // stop process
-		if len(m.Monitor.ProcessTable.SelectedRow()) > 0 {
-			pid, _ := strconv.Atoi(m.Monitor.ProcessTable.SelectedRow()[0])
-			if err := proc.StopProcess(pid); err == nil {
+		return m.confirmStopProcess()
+	default:
+		return m.handleGeneralKeys(msg)
+	}
+	return m, nil
+}
+
+func (m Model) handleProcessDetailsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "?":
+		m.HelpSystem.ToggleHelp()
+	case "b", "esc":
+		m.goBack()
+	case "up", "k":
+		m.Ui.Viewport.ScrollUp(1)
+	case "down", "j":
+		m.Ui.Viewport.ScrollDown(1)
+	case "pgup":
+		m.Ui.Viewport.PageUp()
+	case "pgdown":
+		m.Ui.Viewport.PageDown()
+	case "home":
+		m.Ui.Viewport.GotoTop()
+	case "end":
+		m.Ui.Viewport.GotoBottom()
+	case "r":
+		if m.Monitor.ProcessDetails != nil {
+			return m, loadProcessDetails(m.Monitor.App, m.Monitor.ProcessDetails.PID)
+		}
+	case "q", "ctrl+c":
+		m.Monitor.ShouldQuit = true
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m Model) handleProcessSignalMenuKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		m.Monitor.ProcessSignalSelected = (m.Monitor.ProcessSignalSelected - 1 + len(proc.Signals)) % len(proc.Signals)
+	case "down", "j":
+		m.Monitor.ProcessSignalSelected = (m.Monitor.ProcessSignalSelected + 1) % len(proc.Signals)
+	case "enter":
+		m.Monitor.ProcessSignalMenu = false
+		signal := proc.Signals[m.Monitor.ProcessSignalSelected]
+		return m.confirmProcessAction(model.ProcessAction{Operation: "signal", Signal: signal},
+			fmt.Sprintf("Send SIG%s (%s)", signal.Name, signal.Description))
+	case "esc", "b", "x":
+		m.Monitor.ProcessSignalMenu = false
+	case "q", "ctrl+c":
+		m.Monitor.ShouldQuit = true
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m Model) openProcessInput(mode model.ProcessInputMode, placeholder string) (tea.Model, tea.Cmd) {
+	if _, ok := m.selectedProcessPID(); !ok {
+		return m, nil
+	}
+	m.Monitor.ProcessInputMode = mode
+	m.Monitor.ProcessInput.SetValue("")
+	m.Monitor.ProcessInput.Placeholder = placeholder
+	m.Monitor.ProcessInput.Focus()
+	m.Monitor.ProcessTable.Blur()
+	return m, textinput.Blink
+}
+
+func (m Model) handleProcessInputKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.closeProcessInput()
+		return m, nil
+	case "enter":
+		value := m.Monitor.ProcessInput.Value()
+		mode := m.Monitor.ProcessInputMode
+		m.closeProcessInput()
+
+		switch mode {
+		case model.ProcessInputNice:
+			nice, err := proc.ParseNice(value)
+			if err != nil {
+				m.LastOperationMsg = "❌ " + err.Error()
+				return m, clearOperationMessage()
+			}
+			return m.confirmProcessAction(model.ProcessAction{Operation: "renice", Nice: nice},
+				fmt.Sprintf("Set nice value %d", nice))
+		case model.ProcessInputIOPriority:
+			priority, err := proc.ParseIOPriority(value)
+			if err != nil {
+				m.LastOperationMsg = "❌ " + err.Error()
+				return m, clearOperationMessage()
+			}
+			return m.confirmProcessAction(model.ProcessAction{Operation: "ionice", IOPriority: priority},
+				fmt.Sprintf("Set I/O priority %s", priority))
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.Monitor.ProcessInput, cmd = m.Monitor.ProcessInput.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) closeProcessInput() {
+	m.Monitor.ProcessInputMode = model.ProcessInputNone
+	m.Monitor.ProcessInput.Blur()
+	m.Monitor.ProcessTable.Focus()
+}
+
+// confirmProcessAction asks for confirmation before acting on the selected
+// process.
+func (m Model) confirmProcessAction(action model.ProcessAction, description string) (tea.Model, tea.Cmd) {
+	pid, ok := m.selectedProcessPID()
+	if !ok {
+		return m, nil
+	}
+	action.PID = pid
+	for _, p := range m.Monitor.Processes {
+		if p.PID == pid {
+			action.Command = p.Command
+			break
+		}
+	}
+
+	m.ConfirmationVisible = true
+	m.ConfirmationMessage = fmt.Sprintf("%s for process %d (%s)?", description, action.PID, action.Command)
+	m.ConfirmationAction = "process"
+	m.ConfirmationData = action
+	return m, nil
+}
+
+// confirmStopSubtree asks before stopping a process and all of its
+// descendants. PID 1 is refused: its subtree is the whole system.
+// confirmStopProcess asks before stopping the selected process. Group rows
+// are not processes and are ignored.
+func (m Model) confirmStopProcess() (tea.Model, tea.Cmd) {
+	pid, ok := m.selectedProcessPID()
+	if !ok {
+		return m, nil
+	}
+	if pid == 1 {
+		m.LastOperationMsg = "❌ Refusing to stop PID 1"
+		return m, clearOperationMessage()
+	}
+	var command string
+	for _, p := range m.Monitor.Processes {
+		if p.PID == pid {
+			command = p.Command
+			break
+		}
+	}
+
+	m.ConfirmationVisible = true
+	m.ConfirmationMessage = fmt.Sprintf("Stop process %d (%s)?", pid, command)
+	m.ConfirmationAction = "process_stop"
+	m.ConfirmationData = int(pid)
+	return m, nil
+}
+
+func (m Model) confirmStopSubtree() (tea.Model, tea.Cmd) {
+	pid, ok := m.selectedProcessPID()
+	if !ok {
+		return m, nil
+	}
+	if pid == 1 {
+		m.LastOperationMsg = "❌ Refusing to stop PID 1 and all of its descendants"
+		return m, clearOperationMessage()
+	}
+	subtree := model.SubtreePIDs(m.Monitor.Processes, pid)
+	if len(subtree) == 0 {
+		return m, nil
+	}
+	pids := make([]int, len(subtree))
+	for i, p := range subtree {
+		pids[i] = int(p)
+	}
+	var command string
+	for _, p := range m.Monitor.Processes {
+		if p.PID == pid {
+			command = p.Command
+			break
+		}
+	}
+
+	m.ConfirmationVisible = true
+	m.ConfirmationMessage = fmt.Sprintf("Stop process %d (%s) and its descendants, %d processes in all?", pid, command, len(pids))
+	m.ConfirmationAction = "process_subtree"
+	m.ConfirmationData = pids
+	return m, nil
+}
+
+// processActionCmd runs a confirmed action, escalating through sudo when the
+// process belongs to another user.
+func (m Model) processActionCmd(action model.ProcessAction) tea.Cmd {
+	sm := m.Diagnostic.SecurityManager
+	switch action.Operation {
+	case "signal":
+		return commands.SendSignalCmd(action.PID, action.Signal, sm)
+	case "renice":
+		return commands.ReniceCmd(action.PID, action.Nice, sm)
+	case "ionice":
+		return commands.SetIOPriorityCmd(action.PID, action.IOPriority, sm)
+	}
+	return nil
+}
+
+// sortProcessesBy switches the sort column, restoring the column's natural
+// order.
+func (m Model) sortProcessesBy(field model.ProcessSortField) (tea.Model, tea.Cmd) {
+	m.Monitor.ProcessSort = field
+	m.Monitor.ProcessSortReverse = false
+	m.applySortPreference()
+	return m, m.updateProcessTable()
+}
+
+func (m Model) handleContainersKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "?":
+		m.HelpSystem.ToggleHelp()
+	case "/":
+		m.Ui.SearchMode = true
+		m.Ui.SearchInput.Focus()
+	case "up", "k":
+		m.Monitor.Container.MoveUp(1)
+	case "down", "j":
+		m.Monitor.Container.MoveDown(1)
+	case "g":
+		m.Monitor.ContainerGroupByProject = !m.Monitor.ContainerGroupByProject
+		m.Monitor.ContainerProjectFilter = ""
+		m.Monitor.Container.SetCursor(0)
+		var statsCmd tea.Cmd
+		if m.Monitor.ContainerGroupByProject && m.Monitor.App != nil {
+			statsCmd = commands.ContainerStatsSampleCmd(m.Monitor.App, m.runningContainerIDs())
+		}
+		return m, tea.Batch(m.updateContainerTable(), statsCmd)
+	case "b", "esc":
+		if m.Monitor.ContainerProjectFilter != "" {
+			m.Monitor.ContainerProjectFilter = ""
+			return m, m.updateContainerTable()
+		}
+		return m.handleGeneralKeys(msg)
+	case "R", "S", "U", "L":
+		return m.handleProjectKeys(msg.String())
+	case "enter":
+		if m.showingContainerProjects() {
+			if row := m.Monitor.Container.SelectedRow(); len(row) > 0 {
+				m.Monitor.ContainerProjectFilter = row[0]
+				m.Monitor.Container.SetCursor(0)
+				return m, m.updateContainerTable()
+			}
+			return m, nil
+		}
+		if len(m.Monitor.Container.SelectedRow()) > 0 {
+			selectedRow := m.Monitor.Container.SelectedRow()
+			containerID := selectedRow[0]
+			containers, _ := m.Monitor.App.RefreshContainers()
+			found := false
+			for _, container := range containers {
+				if container.ID == containerID {
+					m.Monitor.SelectedContainer = &container
+					found = true
+					break
+				}
+			}
+			if !found {
+				m.Monitor.SelectedContainer = &system.Container{ID: containerID, Name: selectedRow[2]}
 			}
-			return m, proc.UpdateProcesses()
+			m.Monitor.ContainerMenuState = model.ContainerMenuState(1) // ContainerMenuVisible
+			m.Monitor.SelectedMenuItem = 0
+		}
+	default:
+		return m.handleGeneralKeys(msg)
+	}
+	return m, nil
+}
+
+// handleProjectKeys runs the project-wide actions of the Containers view on
+// the highlighted project, or on the project being drilled into.
+func (m Model) handleProjectKeys(key string) (tea.Model, tea.Cmd) {
+	if m.Monitor.App == nil || !m.Monitor.ContainerGroupByProject {
+		return m, nil
+	}
+	project, ok := m.selectedContainerProject()
+	if !ok {
+		return m, nil
+	}
+	if project.Standalone() {
+		m.LastOperationMsg = "❌ These containers do not belong to a Compose project"
+		return m, clearOperationMessage()
+	}
+
+	switch key {
+	case "R":
+		m.ConfirmationVisible = true
+		m.ConfirmationMessage = fmt.Sprintf("Restart the %d containers of project '%s'?", len(project.Containers), project.Name)
+		m.ConfirmationAction = "project_restart"
+		m.ConfirmationData = project
+	case "S":
+		m.ConfirmationVisible = true
+		m.ConfirmationMessage = fmt.Sprintf("Stop the %d containers of project '%s'?", len(project.Containers), project.Name)
+		m.ConfirmationAction = "project_stop"
+		m.ConfirmationData = project
+	case "U":
+		m.OperationInProgress = true
+		return m, commands.ProjectOperationCmd(m.Monitor.App, project.Name, "start", project.ContainerIDs())
+	case "L":
+		m.cleanupLogsStream()
+		m.Monitor.LogsProject = project.Name
+		m.Monitor.SelectedContainer = &system.Container{Name: "project " + project.Name, Project: project.Name}
+		m.Monitor.Logs.Reset()
+		m.setState(model.StateContainerLogs)
+		m.Monitor.ContainerLogsLoading = true
+		m.Monitor.ContainerLogsPagination.Clear()
+		return m, m.projectLogsCmd(project)
+	}
+	return m, nil
+}
+
+func (m Model) projectLogsCmd(project model.ContainerProject) tea.Cmd {
+	names := make(map[string]string, len(project.Containers))
+	for _, c := range project.Containers {
+		names[c.ID] = c.Name
+	}
+	return commands.GetProjectLogsCmd(m.Monitor.App, project.Name, names, m.Monitor.Logs.Window)
+}
+
+func (m Model) handleDockerEventsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "?":
+		m.HelpSystem.ToggleHelp()
+	case "/":
+		m.Ui.SearchMode = true
+		m.Ui.SearchInput.Focus()
+	case "up", "k", "down", "j", "pgup", "pgdown", "home", "end":
+		navigateTable(&m.Monitor.EventTable, msg.String())
+	case "c":
+		m.Monitor.Events = model.EventHistory{}
+		return m, m.updateEventTable()
+	default:
+		return m.handleGeneralKeys(msg)
+	}
+	return m, nil
+}
+
+func (m Model) handleImagesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.Monitor.App == nil {
+		return m.handleGeneralKeys(msg)
+	}
+
+	switch msg.String() {
+	case "?":
+		m.HelpSystem.ToggleHelp()
+	case "/":
+		m.Ui.SearchMode = true
+		m.Ui.SearchInput.Focus()
+	case "up", "k", "down", "j", "pgup", "pgdown", "home", "end":
+		navigateTable(&m.Monitor.ImageTable, msg.String())
+	case "r":
+		return m, commands.UpdateImagesCmd(m.Monitor.App)
+	case "d":
+		img, ok := m.selectedImage()
+		if !ok {
+			return m, nil
+		}
+		if len(img.Containers) > 0 {
+			m.LastOperationMsg = fmt.Sprintf("❌ %s is used by %s; remove the containers first", img.Ref(), strings.Join(img.Containers, ", "))
+			return m, clearOperationMessage()
+		}
+		m.ConfirmationVisible = true
+		m.ConfirmationMessage = fmt.Sprintf("Remove image '%s' (%s)?\nThis action cannot be undone.", img.Ref(), utils.FormatBytes(uint64(img.Size)))
+		m.ConfirmationAction = "image_remove"
+		m.ConfirmationData = img.Ref()
+	case "p":
+		usage := model.SummarizeImages(m.Monitor.Images)
+		if usage.Dangling == 0 {
+			m.LastOperationMsg = "No dangling images to prune"
+			return m, clearOperationMessage()
+		}
+		m.ConfirmationVisible = true
+		m.ConfirmationMessage = fmt.Sprintf("Prune %d dangling images (%s)?\nImages still used by containers are kept.", usage.Dangling, utils.FormatBytes(uint64(usage.DanglingSize)))
+		m.ConfirmationAction = "image_prune"
+	case "u":
+		img, ok := m.selectedImage()
+		if !ok {
+			return m, nil
+		}
+		if img.Ref() == img.ID {
+			m.LastOperationMsg = "❌ Untagged images cannot be pulled"
+			return m, clearOperationMessage()
+		}
+		m.OperationInProgress = true
+		return m, commands.PullImageCmd(m.Monitor.App, img.Ref())
+	default:
+		return m.handleGeneralKeys(msg)
+	}
+	return m, nil
+}
+
+func (m Model) handleVolumesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.Monitor.App == nil {
+		return m.handleGeneralKeys(msg)
+	}
+
+	switch msg.String() {
+	case "?":
+		m.HelpSystem.ToggleHelp()
+	case "/":
+		m.Ui.SearchMode = true
+		m.Ui.SearchInput.Focus()
+	case "up", "k", "down", "j", "pgup", "pgdown", "home", "end":
+		navigateTable(&m.Monitor.VolumeTable, msg.String())
+	case "r":
+		return m, commands.UpdateVolumesCmd(m.Monitor.App, true)
+	case "d":
+		vol, ok := m.selectedVolume()
+		if !ok {
+			return m, nil
+		}
+		if vol.InUse() {
+			m.LastOperationMsg = fmt.Sprintf("❌ %s is mounted by %s; remove the containers first", vol.Name, strings.Join(vol.Containers, ", "))
+			return m, clearOperationMessage()
+		}
+		m.ConfirmationVisible = true
+		m.ConfirmationMessage = fmt.Sprintf("Remove volume '%s'?\nThe data it holds will be lost.", vol.Name)
+		m.ConfirmationAction = "volume_remove"
+		m.ConfirmationData = vol.Name
+	case "p":
+		usage := model.SummarizeVolumes(m.Monitor.Volumes)
+		if usage.Unused == 0 {
+			m.LastOperationMsg = "No unused volumes to prune"
+			return m, clearOperationMessage()
 		}
+		m.ConfirmationVisible = true
+		m.ConfirmationMessage = fmt.Sprintf("Prune %d unused volumes (%s)?\nNamed volumes are included; the data they hold will be lost.", usage.Unused, utils.FormatBytes(uint64(usage.UnusedSize)))
+		m.ConfirmationAction = "volume_prune"
 	default:
 		return m.handleGeneralKeys(msg)
 	}
 	return m, nil
 }
 
-func (m Model) handleContainersKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+func (m Model) handleDockerNetworksKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.Monitor.App == nil {
+		return m.handleGeneralKeys(msg)
+	}
+
 	switch msg.String() {
 	case "?":
 		m.HelpSystem.ToggleHelp()
 	case "/":
 		m.Ui.SearchMode = true
 		m.Ui.SearchInput.Focus()
-	case "up", "k":
-		m.Monitor.Container.MoveUp(1)
-	case "down", "j":
-		m.Monitor.Container.MoveDown(1)
-	case "enter":
-		if len(m.Monitor.Container.SelectedRow()) > 0 {
-			selectedRow := m.Monitor.Container.SelectedRow()
-			containerID := selectedRow[0]
-			containers, _ := m.Monitor.App.RefreshContainers()
-			found := false
-			for _, container := range containers {
-				if container.ID == containerID {
-					m.Monitor.SelectedContainer = &container
-					found = true
-					break
-				}
-			}
-			if !found {
-				m.Monitor.SelectedContainer = &system.Container{ID: containerID, Name: selectedRow[2]}
+	case "up", "k", "down", "j", "pgup", "pgdown", "home", "end":
+		navigateTable(&m.Monitor.DockerNetworkTable, msg.String())
+	case "r":
+		return m, commands.UpdateNetworksCmd(m.Monitor.App)
+	case "d":
+		n, ok := m.selectedDockerNetwork()
+		if !ok {
+			return m, nil
+		}
+		if n.Builtin() {
+			m.LastOperationMsg = fmt.Sprintf("❌ %s is a predefined network and cannot be removed", n.Name)
+			return m, clearOperationMessage()
+		}
+		if n.InUse() {
+			m.LastOperationMsg = fmt.Sprintf("❌ %s has %d containers attached; remove them first", n.Name, len(n.Endpoints))
+			return m, clearOperationMessage()
+		}
+		m.ConfirmationVisible = true
+		m.ConfirmationMessage = fmt.Sprintf("Remove network '%s'?", n.Name)
+		m.ConfirmationAction = "network_remove"
+		m.ConfirmationData = n.ID
+	case "p":
+		unused := 0
+		for _, n := range m.Monitor.DockerNetworks {
+			if !n.Builtin() && !n.InUse() {
+				unused++
 			}
-			m.Monitor.ContainerMenuState = model.ContainerMenuState(1) // ContainerMenuVisible
-			m.Monitor.SelectedMenuItem = 0
 		}
+		if unused == 0 {
+			m.LastOperationMsg = "No unused networks to prune"
+			return m, clearOperationMessage()
+		}
+		m.ConfirmationVisible = true
+		m.ConfirmationMessage = fmt.Sprintf("Prune %d unused networks?\nPredefined networks are kept.", unused)
+		m.ConfirmationAction = "network_prune"
 	default:
 		return m.handleGeneralKeys(msg)
 	}
 	return m, nil
 }
 
+// navigateTable moves the cursor of a table view for the arrow, page and
+// home/end keys.
+func navigateTable(t *table.Model, key string) {
+	switch key {
+	case "up", "k":
+		t.MoveUp(1)
+	case "down", "j":
+		t.MoveDown(1)
+	case "pgup":
+		t.MoveUp(t.Height())
+	case "pgdown":
+		t.MoveDown(t.Height())
+	case "home":
+		t.GotoTop()
+	case "end":
+		t.GotoBottom()
+	}
+}
+
 func (m Model) handleContainerSingleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.ContainerTab == model.ContainerTabFiles {
+		if handled, cmd := m.handleContainerFilesKeys(msg); handled {
+			return m, cmd
+		}
+	}
+
 	switch msg.String() {
 	case "?":
 		m.HelpSystem.ToggleHelp()
@@ -449,14 +1203,14 @@ func (m Model) handleContainerSingleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case "tab", "right", "l":
 		m.ContainerTab = model.ContainerTab((int(m.ContainerTab) + 1) % len(m.Monitor.ContainerTabs))
-		return m, nil
+		return m, m.containerTabCmd()
 	case "shift+tab", "left", "h":
 		newTab := int(m.ContainerTab) - 1
 		if newTab < 0 {
 			newTab = len(m.Monitor.ContainerTabs) - 1
 		}
 		m.ContainerTab = model.ContainerTab(newTab)
-		return m, nil
+		return m, m.containerTabCmd()
 	case "1":
 		m.ContainerTab = model.ContainerTabGeneral
 		return m, nil
@@ -475,6 +1229,12 @@ func (m Model) handleContainerSingleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "5":
 		m.ContainerTab = model.ContainerTabEnv
 		return m, nil
+	case "6":
+		m.ContainerTab = model.ContainerTabProcesses
+		return m, nil
+	case "7":
+		m.ContainerTab = model.ContainerTabFiles
+		return m, m.containerTabCmd()
 	case "r":
 		if m.Monitor.SelectedContainer != nil {
 			return m, m.loadContainerDetails(m.Monitor.SelectedContainer.ID)
@@ -486,14 +1246,279 @@ func (m Model) handleContainerSingleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// containerTabCmd loads the files of the selected container the first time
+// the Files tab is shown for it.
+func (m *Model) containerTabCmd() tea.Cmd {
+	if m.ContainerTab != model.ContainerTabFiles || m.Monitor.SelectedContainer == nil ||
+		m.Monitor.Files.ContainerID == m.Monitor.SelectedContainer.ID {
+		return nil
+	}
+	m.Monitor.Files.Reset(m.Monitor.SelectedContainer.ID)
+	return m.loadContainerFiles()
+}
+
+// loadContainerFiles reloads the filesystem changes and the directory shown
+// in the Files tab.
+func (m *Model) loadContainerFiles() tea.Cmd {
+	files := &m.Monitor.Files
+	files.Loading = true
+	files.Error = ""
+	return tea.Batch(
+		commands.GetFilesystemDiffCmd(m.Monitor.App, files.ContainerID),
+		commands.ListContainerDirCmd(m.Monitor.App, files.ContainerID, files.Dir.Path),
+	)
+}
+
+// browseContainerDir switches the Files tab to the directory browser and
+// lists dir.
+func (m *Model) browseContainerDir(dir string) tea.Cmd {
+	files := &m.Monitor.Files
+	if files.View != model.FilesBrowse {
+		files.View = model.FilesBrowse
+		files.Table.SetCursor(0)
+		m.updateFilesTable()
+	}
+	files.Loading = true
+	files.Error = ""
+	return commands.ListContainerDirCmd(m.Monitor.App, files.ContainerID, dir)
+}
+
+// handleContainerFilesKeys handles the keys of the Files tab. It reports
+// false for the keys left to the container view, such as switching tabs.
+func (m *Model) handleContainerFilesKeys(msg tea.KeyMsg) (bool, tea.Cmd) {
+	files := &m.Monitor.Files
+
+	// While the destination is asked for, keys are typed into it.
+	if files.CopySource != "" {
+		switch msg.String() {
+		case "ctrl+c":
+			return false, nil
+		case "esc":
+			files.CopySource = ""
+			files.CopyInput.Blur()
+			return true, nil
+		case "enter":
+			dest := strings.TrimSpace(files.CopyInput.Value())
+			if strings.HasPrefix(dest, "~/") {
+				if home, err := os.UserHomeDir(); err == nil {
+					dest = filepath.Join(home, dest[2:])
+				}
+			}
+			if dest == "" {
+				dest = "."
+			}
+			source := files.CopySource
+			files.CopySource = ""
+			files.CopyInput.Blur()
+			m.OperationInProgress = true
+			m.LastOperationMsg = fmt.Sprintf("Copying %s...", source)
+			return true, commands.CopyFromContainerCmd(m.Monitor.App, files.ContainerID, source, dest)
+		}
+		var cmd tea.Cmd
+		files.CopyInput, cmd = files.CopyInput.Update(msg)
+		return true, cmd
+	}
+
+	switch msg.String() {
+	case "up", "k", "down", "j", "pgup", "pgdown", "home", "end":
+		var cmd tea.Cmd
+		files.Table, cmd = files.Table.Update(msg)
+		return true, cmd
+	case "v":
+		if files.View == model.FilesChanges {
+			files.View = model.FilesBrowse
+		} else {
+			files.View = model.FilesChanges
+		}
+		files.Table.SetCursor(0)
+		return true, m.updateFilesTable()
+	case "enter":
+		selected, isDir, ok := files.Selected()
+		if !ok {
+			return true, nil
+		}
+		switch {
+		case isDir:
+			return true, m.browseContainerDir(selected)
+		case files.View == model.FilesChanges:
+			// Show the changed path among its siblings.
+			return true, m.browseContainerDir(model.ParentDir(selected))
+		}
+		return true, nil
+	case "backspace":
+		if files.View == model.FilesBrowse && files.Dir.Path != "/" {
+			return true, m.browseContainerDir(model.ParentDir(files.Dir.Path))
+		}
+		return true, nil
+	case "c":
+		selected, _, ok := files.Selected()
+		if !ok {
+			return true, nil
+		}
+		if change, ok := files.SelectedChange(); ok && change.Kind == "deleted" {
+			m.LastOperationMsg = "❌ Deleted paths cannot be copied"
+			return true, clearOperationMessage()
+		}
+		dest, err := os.Getwd()
+		if err != nil {
+			dest = "."
+		}
+		files.CopySource = selected
+		files.CopyInput.SetValue(dest)
+		files.CopyInput.CursorEnd()
+		return true, files.CopyInput.Focus()
+	case "r":
+		return true, m.loadContainerFiles()
+	}
+	return false, nil
+}
+
+// openContainerLimits shows the limits form for the selected container and
+// loads its current limits.
+func (m Model) openContainerLimits() (tea.Model, tea.Cmd) {
+	c := m.Monitor.SelectedContainer
+	m.Monitor.LimitsForm = model.NewContainerLimitsForm(c.ID, c.Name)
+	m.setState(model.StateContainerLimits)
+	return m, commands.GetContainerLimitsCmd(m.Monitor.App, c.ID)
+}
+
+// handleContainerLimitsKeys edits the limits form. Printable keys go to the
+// focused field, so only esc leaves the form.
+func (m Model) handleContainerLimitsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	form := &m.Monitor.LimitsForm
+	switch msg.String() {
+	case "ctrl+c":
+		m.Monitor.ShouldQuit = true
+		return m, tea.Quit
+	case "esc":
+		m.goBack()
+		return m, nil
+	case "?":
+		m.HelpSystem.ToggleHelp()
+		return m, nil
+	}
+	if form.Current == nil || form.Applying {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "tab", "down":
+		return m, form.MoveFocus(1)
+	case "shift+tab", "up":
+		return m, form.MoveFocus(-1)
+	case "ctrl+r":
+		form.Error = ""
+		return m, form.SetLimits(*form.Current)
+	case "enter":
+		limits, err := form.Limits()
+		if err != nil {
+			form.Error = err.Error()
+			return m, nil
+		}
+		form.Error = ""
+		changes := model.DiffLimits(*form.Current, limits)
+		if len(changes) == 0 {
+			m.LastOperationMsg = "No limits changed"
+			return m, clearOperationMessage()
+		}
+		lines := make([]string, len(changes))
+		for i, c := range changes {
+			lines[i] = fmt.Sprintf("%s: %s → %s", c.Field, c.Before, c.After)
+		}
+		m.ConfirmationVisible = true
+		m.ConfirmationMessage = fmt.Sprintf("Update container '%s'?\n%s", form.Name, strings.Join(lines, "\n"))
+		m.ConfirmationAction = "update_limits"
+		m.ConfirmationData = limits
+		return m, nil
+	}
+	return m, form.Update(msg)
+}
+
+// openContainerExec shows the Run command view for the selected container.
+func (m Model) openContainerExec() (tea.Model, tea.Cmd) {
+	c := m.Monitor.SelectedContainer
+	m.Monitor.Exec.Open(c.ID, c.Name)
+	m.updateExecViewport()
+	m.setState(model.StateContainerExec)
+	return m, m.Monitor.Exec.Input.Focus()
+}
+
+// handleContainerExecKeys edits and runs commands. Printable keys go to the
+// command input, so only esc leaves the view.
+func (m Model) handleContainerExecKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	exec := &m.Monitor.Exec
+	switch msg.String() {
+	case "ctrl+c":
+		m.Monitor.ShouldQuit = true
+		return m, tea.Quit
+	case "esc":
+		m.goBack()
+		return m, nil
+	case "enter":
+		line := strings.TrimSpace(exec.Input.Value())
+		if line == "" {
+			return m, nil
+		}
+		if exec.Running {
+			exec.Error = "a command is still running, press ctrl+x to stop following it"
+			return m, nil
+		}
+		argv, err := system.SplitCommand(line)
+		if err != nil {
+			exec.Error = err.Error()
+			return m, nil
+		}
+		exec.Start(line)
+		m.updateExecViewport()
+		return m, commands.RunCommandCmd(m.Monitor.App, exec.ContainerID, argv)
+	case "ctrl+x":
+		// The exec is only known once started; stopping before would leave
+		// its start message unmatched.
+		if exec.Running && exec.ExecID != "" {
+			exec.Stop()
+			exec.Error = "stopped following the output; the command may still be running"
+		}
+		return m, nil
+	case "up":
+		exec.RecallHistory(-1)
+		return m, nil
+	case "down":
+		exec.RecallHistory(1)
+		return m, nil
+	case "pgup":
+		exec.Viewport.PageUp()
+		return m, nil
+	case "pgdown":
+		exec.Viewport.PageDown()
+		return m, nil
+	case "ctrl+l":
+		exec.ClearOutput()
+		m.updateExecViewport()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	exec.Input, cmd = exec.Input.Update(msg)
+	return m, cmd
+}
+
 func (m Model) handleContainerLogsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	view := &m.Monitor.Logs
+	if view.PromptKind != model.LogPromptNone {
+		return m.handleLogPromptKeys(msg)
+	}
+
 	switch msg.String() {
 	case "?":
 		m.HelpSystem.ToggleHelp()
 	case "b", "esc":
 		m.Monitor.ContainerLogs = ""
+		recording := m.Monitor.LogsTee != nil
 		m.cleanupLogsStream() // 🔥
 		m.goBack()
+		if recording {
+			return m, clearOperationMessage()
+		}
 	case "up", "k":
 		if m.Monitor.ContainerLogsPagination.CurrentPage > 1 {
 			m.Monitor.ContainerLogsPagination.CurrentPage--
@@ -507,22 +1532,52 @@ func (m Model) handleContainerLogsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 	case "s": // toggle streaming
+		if m.Monitor.LogsProject != "" {
+			m.LastOperationMsg = "Live streaming is not available for project logs, press 'r' to reload"
+			return m, clearOperationMessage()
+		}
 		if m.Monitor.ContainerLogsStreaming {
 			m.cleanupLogsStream()
 			m.Monitor.ContainerLogsLoading = true
-			return m, m.Monitor.App.GetContainerLogsCmd(m.Monitor.SelectedContainer.ID)
+			return m, commands.GetContainerLogsCmd(m.Monitor.App, m.Monitor.SelectedContainer.ID, view.Window)
 		} else {
 			m.Monitor.ContainerLogsLoading = true
 			m.Monitor.ContainerLogsPagination.Clear()
-			return m, m.Monitor.App.StartLogsStreamCmd(m.Monitor.SelectedContainer.ID)
+			return m, commands.StartLogsStreamCmd(m.Monitor.App, m.Monitor.SelectedContainer.ID, view.Window.Since)
 		}
 	case "r": // refresh
-		if m.Monitor.ContainerLogsStreaming {
-			m.cleanupLogsStream()
+		return m, m.reloadContainerLogs()
+	case "/":
+		view.OpenPrompt(model.LogPromptSearch)
+		return m, textinput.Blink
+	case "w":
+		view.OpenPrompt(model.LogPromptWindow)
+		return m, textinput.Blink
+	case "n", "N":
+		if view.Search == nil {
+			return m, nil
 		}
-		m.Monitor.ContainerLogsLoading = true
-		m.Monitor.ContainerLogsPagination.Clear()
-		return m, m.Monitor.App.GetContainerLogsCmd(m.Monitor.SelectedContainer.ID)
+		if msg.String() == "n" {
+			view.NextMatch(1)
+		} else {
+			view.NextMatch(-1)
+		}
+		m.updateContainerLogLines(false)
+		return m, nil
+	case "l":
+		view.CycleLevel()
+		m.updateContainerLogLines(m.Monitor.ContainerLogsStreaming)
+		return m, nil
+	case "o":
+		view.CycleStream()
+		m.updateContainerLogLines(m.Monitor.ContainerLogsStreaming)
+		return m, nil
+	case "t":
+		view.Timestamps = !view.Timestamps
+		m.updateContainerLogLines(false)
+		return m, nil
+	case "e", "E":
+		return m, m.exportContainerLogs(msg.String() == "E")
 	case "pageup":
 		m.Ui.Viewport.PageUp()
 		return m, nil
@@ -544,6 +1599,106 @@ func (m Model) handleContainerLogsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleLogPromptKeys edits the search or the time window of the logs view.
+// A new window reloads the logs.
+func (m Model) handleLogPromptKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	view := &m.Monitor.Logs
+	switch msg.String() {
+	case "ctrl+c":
+		m.Monitor.ShouldQuit = true
+		return m, tea.Quit
+	case "esc":
+		view.ClosePrompt()
+		return m, nil
+	case "enter":
+		value := strings.TrimSpace(view.Prompt.Value())
+		switch view.PromptKind {
+		case model.LogPromptSearch:
+			if err := view.SetSearch(value); err != nil {
+				m.LastOperationMsg = fmt.Sprintf("❌ Invalid search: %v", err)
+				return m, clearOperationMessage()
+			}
+			view.ClosePrompt()
+			m.updateContainerLogLines(view.Search == nil && m.Monitor.ContainerLogsStreaming)
+			if view.Search != nil && len(view.Matches) == 0 {
+				m.LastOperationMsg = "No matches"
+				return m, clearOperationMessage()
+			}
+			return m, nil
+		case model.LogPromptWindow:
+			window, err := system.ParseLogsWindow(value)
+			if err != nil {
+				m.LastOperationMsg = fmt.Sprintf("❌ Invalid time window: %v", err)
+				return m, clearOperationMessage()
+			}
+			view.ClosePrompt()
+			view.Window = window
+			return m, m.reloadContainerLogs()
+		}
+	}
+
+	var cmd tea.Cmd
+	view.Prompt, cmd = view.Prompt.Update(msg)
+	return m, cmd
+}
+
+// exportContainerLogs writes the lines shown to a file in the data directory
+// or, while streaming, starts or stops copying the live logs to one.
+func (m *Model) exportContainerLogs(compress bool) tea.Cmd {
+	if m.Monitor.LogsTee != nil {
+		m.stopLogsTee()
+		return clearOperationMessage()
+	}
+
+	name := m.Monitor.SelectedContainer.Name
+	if m.Monitor.LogsProject != "" {
+		name = m.Monitor.LogsProject
+	}
+	if m.Monitor.ContainerLogsStreaming {
+		tee, err := system.CreateLogFile(system.LogExportDirectory(), name, compress)
+		if err != nil {
+			m.LastOperationMsg = utils.FormatOperationMessage("logs_export", false, err)
+			return clearOperationMessage()
+		}
+		m.Monitor.LogsTee = tee
+		m.LastOperationMsg = "⏺ Recording live logs to " + tee.Path
+		return clearOperationMessage()
+	}
+
+	view := m.Monitor.Logs
+	if m.Monitor.ContainerLogsLoading || len(view.Visible) == 0 {
+		m.LastOperationMsg = "No log lines to export"
+		return clearOperationMessage()
+	}
+	lines := make([]system.LogLine, len(view.Visible))
+	for i, index := range view.Visible {
+		lines[i] = view.Lines[index]
+	}
+	m.OperationInProgress = true
+	m.LastOperationMsg = fmt.Sprintf("Exporting %d log lines...", len(lines))
+	return commands.ExportLogsCmd(system.LogExportDirectory(), name, lines, compress)
+}
+
+// reloadContainerLogs fetches the logs again within the current window,
+// stopping a live stream.
+func (m *Model) reloadContainerLogs() tea.Cmd {
+	if m.Monitor.ContainerLogsStreaming {
+		m.cleanupLogsStream()
+	}
+	m.Monitor.ContainerLogsLoading = true
+	m.Monitor.ContainerLogsPagination.Clear()
+	if m.Monitor.LogsProject != "" {
+		for _, p := range model.GroupContainers(m.Monitor.Containers, nil) {
+			if p.Name == m.Monitor.LogsProject {
+				return m.projectLogsCmd(p)
+			}
+		}
+		m.Monitor.ContainerLogsLoading = false
+		return nil
+	}
+	return commands.GetContainerLogsCmd(m.Monitor.App, m.Monitor.SelectedContainer.ID, m.Monitor.Logs.Window)
+}
+
 func (m Model) handleNetworkKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.Network.AuthState == model.AuthRequired {
 		switch msg.String() {
@@ -573,7 +1728,7 @@ func (m Model) handleNetworkKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					m.Diagnostic.Password.Blur()
 
 					// Refresh connections with admin privileges
-					return m, network.GetConnections()
+					return m, commands.GetConnections()
 				}
 			}
 			return m, nil
@@ -650,7 +1805,7 @@ func (m Model) handleNetworkKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.Network.ConnectionsTable.Focus()
 		}
 		if m.Network.SelectedItem == model.NetworkTabProtocol && len(m.Network.Connections) == 0 {
-			return m, network.GetConnections()
+			return m, commands.GetConnections()
 		}
 		return m, nil
 	case "down", "j":
@@ -703,7 +1858,7 @@ func (m Model) handleNetworkKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.Network.ConnectionsTable.Focus()
 		}
 		if m.Network.SelectedItem == model.NetworkTabProtocol && len(m.Network.Connections) == 0 {
-			return m, network.GetConnections()
+			return m, commands.GetConnections()
 		}
 		return m, nil
 
@@ -738,7 +1893,7 @@ func (m Model) handleNetworkKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.resetSpinner()
 			spinnerCmd := m.Ui.Spinner.Tick
 			return m, tea.Batch(
-				network.SpeedTest(),
+				commands.SpeedTest(),
 				spinnerCmd,
 				func() tea.Msg { return model.ForceRefreshMsg{} },
 			)
@@ -757,14 +1912,14 @@ func (m Model) handleNetworkKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Network.RoutesTable.Focus()
 		m.Network.DNSTable.Blur()
 		if len(m.Network.Routes) == 0 && len(m.Network.DNS) == 0 {
-			return m, tea.Batch(network.GetRoutes(), network.GetDNS())
+			return m, tea.Batch(commands.GetRoutes(), commands.GetDNS())
 		}
 		return m, nil
 	case "4":
 		m.Network.SelectedItem = model.NetworkTabProtocol
 		// Set focus to connections table
 		m.Network.ConnectionsTable.Focus()
-		return m, network.GetConnections()
+		return m, commands.GetConnections()
 	case "a":
 		// Request authentication for detailed network information
 		if m.Network.SelectedItem == model.NetworkTabProtocol && !m.AsRoot && !m.CanRunSudo {
@@ -861,7 +2016,7 @@ func (m Model) handleConnectivityInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				spinnerCmd := m.Ui.Spinner.Tick
 				// Force UI refresh by returning a command that will trigger update
 				return m, tea.Batch(
-					network.Ping(target, 3),
+					commands.Ping(target, 3),
 					spinnerCmd,
 					func() tea.Msg { return model.ForceRefreshMsg{} },
 				)
@@ -876,7 +2031,7 @@ func (m Model) handleConnectivityInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				spinnerCmd := m.Ui.Spinner.Tick
 				// Force UI refresh by returning a command that will trigger update
 				return m, tea.Batch(
-					network.Traceroute(target),
+					commands.Traceroute(target),
 					spinnerCmd,
 					func() tea.Msg { return model.ForceRefreshMsg{} },
 				)
@@ -898,7 +2053,7 @@ func (m Model) handleConnectivityInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.LastOperationMsg = "Installing traceroute..."
 				m.resetSpinner()
 				return m, tea.Batch(
-					network.InstallTraceroute(target, password),
+					commands.InstallTraceroute(target, password),
 					m.Ui.Spinner.Tick,
 				)
 			}
@@ -993,15 +2148,15 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Auto-load data when switching to I/O, CPU, or Memory tab
 			if m.Diagnostic.Performance.SelectedItem == model.InputOutput && m.Diagnostic.Performance.IOMetrics == nil {
 				m.Diagnostic.Performance.IOLoading = true
-				return m, performance.GetIOMetrics()
+				return m, commands.GetIOMetrics()
 			}
 			if m.Diagnostic.Performance.SelectedItem == model.CPU && m.Diagnostic.Performance.CPUMetrics == nil {
 				m.Diagnostic.Performance.CPULoading = true
-				return m, performance.GetCPUMetrics()
+				return m, commands.GetCPUMetrics()
 			}
 			if m.Diagnostic.Performance.SelectedItem == model.Memory && m.Diagnostic.Performance.MemoryMetrics == nil {
 				m.Diagnostic.Performance.MemoryLoading = true
-				return m, performance.GetMemoryMetrics()
+				return m, commands.GetMemoryMetrics()
 			}
 			return m, nil
 		case "left", "h":
@@ -1014,15 +2169,15 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Auto-load data when switching to I/O, CPU, or Memory tab
 			if m.Diagnostic.Performance.SelectedItem == model.InputOutput && m.Diagnostic.Performance.IOMetrics == nil {
 				m.Diagnostic.Performance.IOLoading = true
-				return m, performance.GetIOMetrics()
+				return m, commands.GetIOMetrics()
 			}
 			if m.Diagnostic.Performance.SelectedItem == model.CPU && m.Diagnostic.Performance.CPUMetrics == nil {
 				m.Diagnostic.Performance.CPULoading = true
-				return m, performance.GetCPUMetrics()
+				return m, commands.GetCPUMetrics()
 			}
 			if m.Diagnostic.Performance.SelectedItem == model.Memory && m.Diagnostic.Performance.MemoryMetrics == nil {
 				m.Diagnostic.Performance.MemoryLoading = true
-				return m, performance.GetMemoryMetrics()
+				return m, commands.GetMemoryMetrics()
 			}
 			return m, nil
 		case "esc", "b":
@@ -1074,7 +2229,7 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					m.Diagnostic.Password.SetValue("")
 					m.Diagnostic.Password.Blur()
 					domain := m.Diagnostic.DomainInput.Value()
-					return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+					return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 				}
 			}
 			return m, nil
@@ -1100,7 +2255,7 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			if domain != "" {
 				m.Diagnostic.DomainInputMode = false
 				m.Diagnostic.DomainInput.Blur()
-				return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+				return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 			}
 		case "esc":
 			// Cancel domain input
@@ -1197,27 +2352,27 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		m.Diagnostic.SelectedItem = model.ContainerTab(newTab)
 		if m.Diagnostic.SelectedItem == model.DiagnosticSecurityChecks && len(m.Diagnostic.SecurityChecks) == 0 {
-			return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+			return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabLogs && m.Diagnostic.LogsInfo == nil {
 			return m, m.loadLogs()
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances && m.Diagnostic.Performance.HealthMetrics == nil {
 			m.Diagnostic.Performance.HealthLoading = true
-			return m, performance.GetHealthMetrics()
+			return m, commands.GetHealthMetrics()
 		}
 		// Auto-load I/O or CPU data if respective tab is selected and no data exists
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances &&
 			m.Diagnostic.Performance.SelectedItem == model.InputOutput &&
 			m.Diagnostic.Performance.IOMetrics == nil {
 			m.Diagnostic.Performance.IOLoading = true
-			return m, performance.GetIOMetrics()
+			return m, commands.GetIOMetrics()
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances &&
 			m.Diagnostic.Performance.SelectedItem == model.CPU &&
 			m.Diagnostic.Performance.CPUMetrics == nil {
 			m.Diagnostic.Performance.CPULoading = true
-			return m, performance.GetCPUMetrics()
+			return m, commands.GetCPUMetrics()
 		}
 		return m, nil
 	case "shift+tab":
@@ -1229,7 +2384,7 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Diagnostic.SelectedItem = model.ContainerTab(newTab)
 
 		if m.Diagnostic.SelectedItem == model.DiagnosticSecurityChecks && len(m.Diagnostic.SecurityChecks) == 0 {
-			return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+			return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 		}
 
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabLogs && m.Diagnostic.LogsInfo == nil {
@@ -1237,20 +2392,20 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances && m.Diagnostic.Performance.HealthMetrics == nil {
 			m.Diagnostic.Performance.HealthLoading = true
-			return m, performance.GetHealthMetrics()
+			return m, commands.GetHealthMetrics()
 		}
 		// Auto-load I/O or CPU data if respective tab is selected and no data exists
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances &&
 			m.Diagnostic.Performance.SelectedItem == model.InputOutput &&
 			m.Diagnostic.Performance.IOMetrics == nil {
 			m.Diagnostic.Performance.IOLoading = true
-			return m, performance.GetIOMetrics()
+			return m, commands.GetIOMetrics()
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances &&
 			m.Diagnostic.Performance.SelectedItem == model.CPU &&
 			m.Diagnostic.Performance.CPUMetrics == nil {
 			m.Diagnostic.Performance.CPULoading = true
-			return m, performance.GetCPUMetrics()
+			return m, commands.GetCPUMetrics()
 		}
 		return m, nil
 	case "right", "l":
@@ -1262,7 +2417,7 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 			m.Diagnostic.SelectedItem = model.ContainerTab(newTab)
 			if m.Diagnostic.SelectedItem == model.DiagnosticSecurityChecks && len(m.Diagnostic.SecurityChecks) == 0 {
-				return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+				return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 			}
 			if m.Diagnostic.SelectedItem == model.DiagnosticTabLogs && m.Diagnostic.LogsInfo == nil {
 				return m, m.loadLogs()
@@ -1276,27 +2431,27 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		m.Diagnostic.SelectedItem = model.ContainerTab(newTab)
 		if m.Diagnostic.SelectedItem == model.DiagnosticSecurityChecks && len(m.Diagnostic.SecurityChecks) == 0 {
-			return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+			return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabLogs && m.Diagnostic.LogsInfo == nil {
 			return m, m.loadLogs()
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances && m.Diagnostic.Performance.HealthMetrics == nil {
 			m.Diagnostic.Performance.HealthLoading = true
-			return m, performance.GetHealthMetrics()
+			return m, commands.GetHealthMetrics()
 		}
 		// Auto-load I/O or CPU data if respective tab is selected and no data exists
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances &&
 			m.Diagnostic.Performance.SelectedItem == model.InputOutput &&
 			m.Diagnostic.Performance.IOMetrics == nil {
 			m.Diagnostic.Performance.IOLoading = true
-			return m, performance.GetIOMetrics()
+			return m, commands.GetIOMetrics()
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances &&
 			m.Diagnostic.Performance.SelectedItem == model.CPU &&
 			m.Diagnostic.Performance.CPUMetrics == nil {
 			m.Diagnostic.Performance.CPULoading = true
-			return m, performance.GetCPUMetrics()
+			return m, commands.GetCPUMetrics()
 		}
 		return m, nil
 	case "left", "h":
@@ -1308,7 +2463,7 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 			m.Diagnostic.SelectedItem = model.ContainerTab(newTab)
 			if m.Diagnostic.SelectedItem == model.DiagnosticSecurityChecks && len(m.Diagnostic.SecurityChecks) == 0 {
-				return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+				return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 			}
 			if m.Diagnostic.SelectedItem == model.DiagnosticTabLogs && m.Diagnostic.LogsInfo == nil {
 				return m, m.loadLogs()
@@ -1322,27 +2477,27 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		m.Diagnostic.SelectedItem = model.ContainerTab(newTab)
 		if m.Diagnostic.SelectedItem == model.DiagnosticSecurityChecks && len(m.Diagnostic.SecurityChecks) == 0 {
-			return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+			return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabLogs && m.Diagnostic.LogsInfo == nil {
 			return m, m.loadLogs()
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances && m.Diagnostic.Performance.HealthMetrics == nil {
 			m.Diagnostic.Performance.HealthLoading = true
-			return m, performance.GetHealthMetrics()
+			return m, commands.GetHealthMetrics()
 		}
 		// Auto-load I/O or CPU data if respective tab is selected and no data exists
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances &&
 			m.Diagnostic.Performance.SelectedItem == model.InputOutput &&
 			m.Diagnostic.Performance.IOMetrics == nil {
 			m.Diagnostic.Performance.IOLoading = true
-			return m, performance.GetIOMetrics()
+			return m, commands.GetIOMetrics()
 		}
 		if m.Diagnostic.SelectedItem == model.DiagnosticTabPerformances &&
 			m.Diagnostic.Performance.SelectedItem == model.CPU &&
 			m.Diagnostic.Performance.CPUMetrics == nil {
 			m.Diagnostic.Performance.CPULoading = true
-			return m, performance.GetCPUMetrics()
+			return m, commands.GetCPUMetrics()
 		}
 		return m, nil
 	case "shift+right", "shift+l":
@@ -1382,7 +2537,7 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "1":
 		m.Diagnostic.SelectedItem = model.DiagnosticSecurityChecks
 		if len(m.Diagnostic.SecurityChecks) == 0 {
-			return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+			return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 		}
 		return m, nil
 	case "2":
@@ -1457,12 +2612,12 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "r":
 		switch m.Diagnostic.SelectedItem {
 		case model.DiagnosticSecurityChecks:
-			return m, m.Diagnostic.SecurityManager.RunSecurityChecks(domain)
+			return m, commands.RunSecurityChecks(m.Diagnostic.SecurityManager, domain)
 		case model.DiagnosticTabLogs:
 			return m, m.loadLogs()
 		case model.DiagnosticTabPerformances:
 			m.Diagnostic.Performance.HealthLoading = true
-			return m, performance.GetHealthMetrics()
+			return m, commands.GetHealthMetrics()
 		}
 	case "a":
 		if m.Diagnostic.SelectedItem == model.DiagnosticSecurityChecks && !m.AsRoot && !m.CanRunSudo {
@@ -1559,15 +2714,15 @@ func (m Model) handleDiagnosticsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				// Execute the diagnostic check
 				switch checkName {
 				case "SSL Certificate":
-					return m, m.Diagnostic.SecurityManager.RunCertificateDisplay()
+					return m, commands.RunCertificateDisplay(m.Diagnostic.SecurityManager)
 				case "SSH Root Login":
-					return m, m.Diagnostic.SecurityManager.DisplaySSHRootInfos()
+					return m, commands.DisplaySSHRootInfos(m.Diagnostic.SecurityManager)
 				case "Open Ports":
-					return m, m.Diagnostic.SecurityManager.DisplayOpenedPortsInfos()
+					return m, commands.DisplayOpenedPortsInfos(m.Diagnostic.SecurityManager)
 				case "Firewall Status":
-					return m, m.Diagnostic.SecurityManager.DisplayFirewallInfos()
+					return m, commands.DisplayFirewallInfos(m.Diagnostic.SecurityManager)
 				case "Auto Ban":
-					return m, m.Diagnostic.SecurityManager.DisplayAutoBanInfos()
+					return m, commands.DisplayAutoBanInfos(m.Diagnostic.SecurityManager)
 				}
 			}
 		}
@@ -1860,12 +3015,17 @@ func (m Model) handleReportingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Tick(time.Millisecond*100, func(t time.Time) tea.Msg {
 			securityChecks := m.Diagnostic.SecurityChecks
 			report := m.Reporting.GenerateReport(m.Monitor, m.Diagnostic, securityChecks)
-			return model.ReportGenerationMsg{Report: report}
+			return model.ReportGenerationMsg{Report: report, Document: m.Reporting.CurrentDocument}
 		})
-	case "s":
+	case "s", "e":
 		if m.Reporting.CurrentReport != "" {
-			filepath, err := (&m.Reporting).SaveReport()
+			format := model.ReportFormatMarkdown
+			if msg.String() == "e" {
+				format = model.ReportFormatJSON
+			}
+			filepath, err := (&m.Reporting).SaveReport(format)
 			m.Reporting.CurrentReport = ""
+			m.Reporting.CurrentDocument = nil
 			if err != nil {
 				m.Reporting.SaveNotification = fmt.Sprintf("❌ Error saving report: %v", err)
 			} else {
@@ -1881,6 +3041,7 @@ func (m Model) handleReportingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Reporting.RefreshSavedReports()
 		m.Reporting.SelectedReport = 0
 		m.Reporting.ShowSavedReports = true
+		m.Reporting.CompareSelection = nil
 		m.setState(model.StateReporting)
 	case "enter":
 		if m.Reporting.ShowSavedReports && len(m.Reporting.SavedReports) > 0 && m.Reporting.SelectedReport >= 0 && m.Reporting.SelectedReport < len(m.Reporting.SavedReports) {
@@ -1894,6 +3055,27 @@ func (m Model) handleReportingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.setState(model.StateViewingReport)
 			}
 		}
+	case "c":
+		if m.Reporting.ShowSavedReports && len(m.Reporting.SavedReports) > 0 && m.Reporting.SelectedReport >= 0 && m.Reporting.SelectedReport < len(m.Reporting.SavedReports) {
+			m.Reporting.ToggleCompareSelection(m.Reporting.SavedReports[m.Reporting.SelectedReport])
+			if len(m.Reporting.CompareSelection) < 2 {
+				return m, nil
+			}
+			selection := m.Reporting.CompareSelection
+			m.Reporting.CompareSelection = nil
+			content, err := m.Reporting.CompareReports(selection[0], selection[1])
+			if err != nil {
+				m.Reporting.SaveNotification = fmt.Sprintf("❌ Cannot compare reports: %v", err)
+				m.Reporting.SaveNotificationTime = time.Now()
+				return m, tea.Tick(time.Second*2, func(t time.Time) tea.Msg {
+					return model.ClearSaveNotificationMsg{}
+				})
+			}
+			m.Reporting.ShowSavedReports = false
+			m.Ui.Viewport.SetContent(content)
+			m.Ui.Viewport.YPosition = 0
+			m.setState(model.StateViewingReport)
+		}
 	case "d":
 		if m.Reporting.ShowSavedReports && len(m.Reporting.SavedReports) > 0 && m.Reporting.SelectedReport >= 0 && m.Reporting.SelectedReport < len(m.Reporting.SavedReports) {
 			filename := m.Reporting.SavedReports[m.Reporting.SelectedReport]
@@ -1937,11 +3119,12 @@ func (m Model) handleContainerMenuKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.setState(model.StateContainerLogs)
 		m.Monitor.ContainerLogsLoading = true
+		m.Monitor.Logs.Reset()
 		if m.Monitor.ContainerLogsStreaming {
-			return m, m.Monitor.App.StopLogsStreamCmd(m.Monitor.SelectedContainer.ID)
+			return m, commands.StopLogsStreamCmd(m.Monitor.App, m.Monitor.SelectedContainer.ID)
 		}
-		return m, m.Monitor.App.GetContainerLogsCmd(
-			m.Monitor.SelectedContainer.ID)
+		return m, commands.GetContainerLogsCmd(m.Monitor.App,
+			m.Monitor.SelectedContainer.ID, m.Monitor.Logs.Window)
 	case "r":
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.ConfirmationVisible = true
@@ -1966,16 +3149,27 @@ func (m Model) handleContainerMenuKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "s":
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.OperationInProgress = true
-		return m, m.Monitor.App.ToggleContainerStateCmd(m.Monitor.SelectedContainer.ID)
+		return m, commands.ToggleContainerStateCmd(m.Monitor.App, m.Monitor.SelectedContainer.ID)
 	case "p":
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.OperationInProgress = true
-		return m, m.Monitor.App.ToggleContainerPauseCmd(m.Monitor.SelectedContainer.ID)
+		return m, commands.ToggleContainerPauseCmd(m.Monitor.App, m.Monitor.SelectedContainer.ID)
 	case "e":
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.Monitor.PendingShellExec = &model.ShellExecRequest{ContainerID: m.Monitor.SelectedContainer.ID}
 		m.Monitor.ShouldQuit = false
 		return m, tea.Quit
+	case "t":
+		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
+		m.setState(model.StateContainer)
+		m.ContainerTab = model.ContainerTabProcesses
+		return m, m.loadContainerDetails(m.Monitor.SelectedContainer.ID)
+	case "m":
+		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
+		return m.openContainerLimits()
+	case "!":
+		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
+		return m.openContainerExec()
 	case "c":
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.LastOperationMsg = "Commit functionality not yet implemented"
@@ -2002,11 +3196,20 @@ func (m Model) executeContainerMenuAction() (tea.Model, tea.Cmd) {
 		m.setState(model.StateContainer)
 		m.ContainerTab = model.ContainerTabGeneral
 		return m, m.loadContainerDetails(m.Monitor.SelectedContainer.ID)
+	case "processes":
+		m.setState(model.StateContainer)
+		m.ContainerTab = model.ContainerTabProcesses
+		return m, m.loadContainerDetails(m.Monitor.SelectedContainer.ID)
+	case "limits":
+		return m.openContainerLimits()
+	case "run":
+		return m.openContainerExec()
 	case "logs":
 		m.setState(model.StateContainerLogs)
 		m.Monitor.ContainerLogsLoading = true
-		return m, m.Monitor.App.GetContainerLogsCmd(
-			m.Monitor.SelectedContainer.ID)
+		m.Monitor.Logs.Reset()
+		return m, commands.GetContainerLogsCmd(m.Monitor.App,
+			m.Monitor.SelectedContainer.ID, m.Monitor.Logs.Window)
 	case "restart":
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.ConfirmationVisible = true
@@ -2031,11 +3234,11 @@ func (m Model) executeContainerMenuAction() (tea.Model, tea.Cmd) {
 	case "toggle_start":
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.OperationInProgress = true
-		return m, m.Monitor.App.ToggleContainerStateCmd(m.Monitor.SelectedContainer.ID)
+		return m, commands.ToggleContainerStateCmd(m.Monitor.App, m.Monitor.SelectedContainer.ID)
 	case "toggle_pause":
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.OperationInProgress = true
-		return m, m.Monitor.App.ToggleContainerPauseCmd(m.Monitor.SelectedContainer.ID)
+		return m, commands.ToggleContainerPauseCmd(m.Monitor.App, m.Monitor.SelectedContainer.ID)
 	case "exec":
 		m.Monitor.ContainerMenuState = model.ContainerMenuState(0) // ContainerMenuHidden
 		m.Monitor.PendingShellExec = &model.ShellExecRequest{ContainerID: m.Monitor.SelectedContainer.ID}
@@ -2063,21 +3266,90 @@ func (m Model) handleConfirmationKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.OperationInProgress = true
 				m.ConfirmationAction = ""
 				m.ConfirmationData = nil
-				return m, m.Monitor.App.DeleteContainerCmd(containerID, false)
+				return m, commands.DeleteContainerCmd(m.Monitor.App, containerID, false)
 			}
 		case "remove":
 			if containerID, ok := m.ConfirmationData.(string); ok {
 				m.OperationInProgress = true
 				m.ConfirmationAction = ""
 				m.ConfirmationData = nil
-				return m, m.Monitor.App.DeleteContainerCmd(containerID, true)
+				return m, commands.DeleteContainerCmd(m.Monitor.App, containerID, true)
 			}
 		case "restart":
 			if containerID, ok := m.ConfirmationData.(string); ok {
 				m.OperationInProgress = true
 				m.ConfirmationAction = ""
 				m.ConfirmationData = nil
-				return m, m.Monitor.App.RestartContainerCmd(containerID)
+				return m, commands.RestartContainerCmd(m.Monitor.App, containerID)
+			}
+		case "update_limits":
+			if limits, ok := m.ConfirmationData.(system.ContainerLimits); ok {
+				m.OperationInProgress = true
+				m.Monitor.LimitsForm.Applying = true
+				m.ConfirmationAction = ""
+				m.ConfirmationData = nil
+				return m, commands.UpdateContainerLimitsCmd(m.Monitor.App, m.Monitor.LimitsForm.ContainerID, limits)
+			}
+		case "project_restart", "project_stop":
+			if project, ok := m.ConfirmationData.(model.ContainerProject); ok {
+				operation := strings.TrimPrefix(m.ConfirmationAction, "project_")
+				m.OperationInProgress = true
+				m.ConfirmationAction = ""
+				m.ConfirmationData = nil
+				return m, commands.ProjectOperationCmd(m.Monitor.App, project.Name, operation, project.ContainerIDs())
+			}
+		case "image_remove":
+			if ref, ok := m.ConfirmationData.(string); ok {
+				m.OperationInProgress = true
+				m.ConfirmationAction = ""
+				m.ConfirmationData = nil
+				return m, commands.RemoveImageCmd(m.Monitor.App, ref)
+			}
+		case "image_prune":
+			m.OperationInProgress = true
+			m.ConfirmationAction = ""
+			return m, commands.PruneDanglingImagesCmd(m.Monitor.App)
+		case "volume_remove":
+			if name, ok := m.ConfirmationData.(string); ok {
+				m.OperationInProgress = true
+				m.ConfirmationAction = ""
+				m.ConfirmationData = nil
+				return m, commands.RemoveVolumeCmd(m.Monitor.App, name)
+			}
+		case "volume_prune":
+			m.OperationInProgress = true
+			m.ConfirmationAction = ""
+			return m, commands.PruneVolumesCmd(m.Monitor.App)
+		case "network_remove":
+			if id, ok := m.ConfirmationData.(string); ok {
+				m.OperationInProgress = true
+				m.ConfirmationAction = ""
+				m.ConfirmationData = nil
+				return m, commands.RemoveNetworkCmd(m.Monitor.App, id)
+			}
+		case "network_prune":
+			m.OperationInProgress = true
+			m.ConfirmationAction = ""
+			return m, commands.PruneNetworksCmd(m.Monitor.App)
+		case "process":
+			if action, ok := m.ConfirmationData.(model.ProcessAction); ok {
+				m.ConfirmationAction = ""
+				m.ConfirmationData = nil
+				return m, m.processActionCmd(action)
+			}
+		case "process_stop":
+			if pid, ok := m.ConfirmationData.(int); ok {
+				m.ConfirmationAction = ""
+				m.ConfirmationData = nil
+				m.LastOperationMsg = fmt.Sprintf("Stopping process %d...", pid)
+				return m, tea.Batch(commands.StopProcessesCmd([]int{pid}), clearOperationMessage())
+			}
+		case "process_subtree":
+			if pids, ok := m.ConfirmationData.([]int); ok {
+				m.ConfirmationAction = ""
+				m.ConfirmationData = nil
+				m.LastOperationMsg = fmt.Sprintf("Stopping %d processes under PID %d...", len(pids), pids[0])
+				return m, tea.Batch(commands.StopProcessesCmd(pids), clearOperationMessage())
 			}
 		case "install_traceroute":
 			if target, ok := m.ConfirmationData.(string); ok {
@@ -2118,8 +3390,16 @@ func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Monitor.Container.Focus()
 		if m.Ui.SelectedMonitor == 1 {
 			tcmd = m.updateProcessTable()
+		} else if m.Ui.State == model.StateImages {
+			tcmd = m.updateImageTable()
+		} else if m.Ui.State == model.StateVolumes {
+			tcmd = m.updateVolumeTable()
+		} else if m.Ui.State == model.StateDockerNetworks {
+			tcmd = m.updateDockerNetworkTable()
+		} else if m.Ui.State == model.StateDockerEvents {
+			tcmd = m.updateEventTable()
 		} else if m.Monitor.App != nil {
-			tcmd = m.Monitor.App.UpdateApp()
+			tcmd = commands.UpdateApp(m.Monitor.App)
 		}
 		return m, tcmd
 	default:
@@ -2131,30 +3411,16 @@ func (m Model) handleSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // ------------------------- Tick -------------------------
 
-func (m Model) handleTickMsg() (tea.Model, tea.Cmd) {
-	cmds := []tea.Cmd{
-		tick(),
-		info.UpdateSystemInfo(),
-		resource.UpdateCPUInfo(),
-		resource.UpdateMemoryInfo(),
-		resource.UpdateDiskInfo(),
-		resource.UpdateNetworkInfo(),
-		proc.UpdateProcesses(),
-		// Refresh network connectivity data when in network view
-		func() tea.Cmd {
-			if m.Ui.State == model.StateNetwork {
-				switch m.Network.SelectedItem {
-				case model.NetworkTabProtocol:
-					return network.GetConnections()
-				case model.NetworkTabConfiguration:
-					return tea.Batch(network.GetRoutes(), network.GetDNS())
-				}
-			}
-			return nil
-		}(),
-	}
-	if m.Monitor.App != nil {
-		cmds = append(cmds, m.Monitor.App.UpdateApp())
+func (m Model) handleTickMsg(now time.Time) (tea.Model, tea.Cmd) {
+	cmds := append([]tea.Cmd{tick(m.Schedule.TickInterval())}, m.scheduledCmds(now)...)
+	// Refresh network connectivity data when in network view
+	if m.Ui.State == model.StateNetwork {
+		switch m.Network.SelectedItem {
+		case model.NetworkTabProtocol:
+			cmds = append(cmds, commands.GetConnections())
+		case model.NetworkTabConfiguration:
+			cmds = append(cmds, commands.GetRoutes(), commands.GetDNS())
+		}
 	}
 
 	if m.Diagnostic.AuthState == model.AuthSuccess && m.Diagnostic.AuthTimer > 0 {
@@ -2236,7 +3502,7 @@ func (m Model) handleMouseMsg(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 
 func (m Model) handleScrollUp() (tea.Model, tea.Cmd) {
 	switch m.Ui.State {
-	case model.StateSystem, model.StateContainer,
+	case model.StateSystem, model.StateContainer, model.StateProcessDetails,
 		model.StateDiagnostics,
 		model.StateCertificateDetails,
 		model.StateSSHRootDetails,
@@ -2253,10 +3519,20 @@ func (m Model) handleScrollUp() (tea.Model, tea.Cmd) {
 		m.Ui.Viewport.ScrollUp(m.ScrollSensitivity)
 	case model.StateContainerLogs:
 		m.LogsViewport.ScrollUp(m.ScrollSensitivity)
+	case model.StateContainerExec:
+		m.Monitor.Exec.Viewport.ScrollUp(m.ScrollSensitivity)
 	case model.StateProcess:
 		m.Monitor.ProcessTable.MoveUp(m.ScrollSensitivity)
 	case model.StateContainers:
 		m.Monitor.Container.MoveUp(m.ScrollSensitivity)
+	case model.StateImages:
+		m.Monitor.ImageTable.MoveUp(m.ScrollSensitivity)
+	case model.StateVolumes:
+		m.Monitor.VolumeTable.MoveUp(m.ScrollSensitivity)
+	case model.StateDockerNetworks:
+		m.Monitor.DockerNetworkTable.MoveUp(m.ScrollSensitivity)
+	case model.StateDockerEvents:
+		m.Monitor.EventTable.MoveUp(m.ScrollSensitivity)
 	case model.StateNetwork:
 		switch m.Network.SelectedItem {
 		case model.NetworkTabConnectivity:
@@ -2278,7 +3554,7 @@ func (m Model) handleScrollUp() (tea.Model, tea.Cmd) {
 
 func (m Model) handleScrollDown() (tea.Model, tea.Cmd) {
 	switch m.Ui.State {
-	case model.StateSystem, model.StateContainer,
+	case model.StateSystem, model.StateContainer, model.StateProcessDetails,
 		model.StateDiagnostics,
 		model.StateCertificateDetails,
 		model.StateSSHRootDetails,
@@ -2295,10 +3571,20 @@ func (m Model) handleScrollDown() (tea.Model, tea.Cmd) {
 		m.Ui.Viewport.ScrollDown(m.ScrollSensitivity)
 	case model.StateContainerLogs:
 		m.LogsViewport.ScrollDown(m.ScrollSensitivity)
+	case model.StateContainerExec:
+		m.Monitor.Exec.Viewport.ScrollDown(m.ScrollSensitivity)
 	case model.StateProcess:
 		m.Monitor.ProcessTable.MoveDown(m.ScrollSensitivity)
 	case model.StateContainers:
 		m.Monitor.Container.MoveDown(m.ScrollSensitivity)
+	case model.StateImages:
+		m.Monitor.ImageTable.MoveDown(m.ScrollSensitivity)
+	case model.StateVolumes:
+		m.Monitor.VolumeTable.MoveDown(m.ScrollSensitivity)
+	case model.StateDockerNetworks:
+		m.Monitor.DockerNetworkTable.MoveDown(m.ScrollSensitivity)
+	case model.StateDockerEvents:
+		m.Monitor.EventTable.MoveDown(m.ScrollSensitivity)
 	case model.StateNetwork:
 		switch m.Network.SelectedItem {
 		case model.NetworkTabProtocol:
@@ -2365,7 +3651,7 @@ func (m Model) handleNetworkMsgs(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.resetSpinner()
 			// Now run traceroute with the original target
 			return m, tea.Batch(
-				network.Traceroute(msg.Target),
+				commands.Traceroute(msg.Target),
 				m.Ui.Spinner.Tick,
 				clearOperationMessage(),
 			)
@@ -2460,16 +3746,16 @@ func (m Model) handlePerformanceKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		switch m.Diagnostic.Performance.SelectedItem {
 		case model.SystemHealth:
 			m.Diagnostic.Performance.HealthLoading = true
-			return m, performance.GetHealthMetrics()
+			return m, commands.GetHealthMetrics()
 		case model.InputOutput:
 			m.Diagnostic.Performance.IOLoading = true
-			return m, performance.GetIOMetrics()
+			return m, commands.GetIOMetrics()
 		case model.CPU:
 			m.Diagnostic.Performance.CPULoading = true
-			return m, performance.GetCPUMetrics()
+			return m, commands.GetCPUMetrics()
 		default:
 			m.Diagnostic.Performance.HealthLoading = true
-			return m, performance.GetHealthMetrics()
+			return m, commands.GetHealthMetrics()
 		}
 	default:
 		return m.handleGeneralKeys(msg)
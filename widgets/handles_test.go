@@ -0,0 +1,98 @@
+package widgets
+
+import (
+	"strconv"
+	"testing"
+
+	proc "github.com/System-Pulse/server-pulse/system/process"
+	model "github.com/System-Pulse/server-pulse/widgets/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func selectProcess(t *testing.T, m *Model, pid int32) {
+	t.Helper()
+	for i, row := range m.Monitor.ProcessTable.Rows() {
+		if row[0] == strconv.Itoa(int(pid)) {
+			m.Monitor.ProcessTable.SetCursor(i)
+			return
+		}
+	}
+	t.Fatalf("PID %d not in the process table", pid)
+}
+
+func TestStopSubtreeAsksForConfirmation(t *testing.T) {
+	m := InitialModelWithManager(nil)
+	m.Monitor.Processes = []proc.ProcessInfo{
+		{PID: 1, PPID: 0, Command: "init"},
+		{PID: 100, PPID: 1, Command: "supervisord"},
+		{PID: 101, PPID: 100, Command: "worker"},
+		{PID: 102, PPID: 101, Command: "helper"},
+		{PID: 200, PPID: 1, Command: "sshd"},
+	}
+	m.updateProcessTable()
+
+	selectProcess(t, &m, 100)
+	updated, cmd := m.handleProcessKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("K")})
+	m = updated.(Model)
+	assert.Nil(t, cmd, "nothing is stopped before confirmation")
+	require.True(t, m.ConfirmationVisible)
+	assert.Equal(t, "process_subtree", m.ConfirmationAction)
+	assert.Equal(t, []int{100, 101, 102}, m.ConfirmationData)
+	assert.Contains(t, m.ConfirmationMessage, "supervisord")
+	assert.Contains(t, m.ConfirmationMessage, "3 processes")
+
+	m.ConfirmationVisible = false
+	m.ConfirmationAction = ""
+	m.ConfirmationData = nil
+	selectProcess(t, &m, 1)
+	updated, _ = m.handleProcessKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("K")})
+	m = updated.(Model)
+	assert.False(t, m.ConfirmationVisible)
+	assert.Contains(t, m.LastOperationMsg, "Refusing")
+}
+
+func TestStopProcessAsksForConfirmation(t *testing.T) {
+	m := InitialModelWithManager(nil)
+	m.Monitor.Processes = []proc.ProcessInfo{
+		{PID: 1, PPID: 0, User: "root", Command: "init"},
+		{PID: 101, PPID: 1, User: "1000", Command: "worker"},
+	}
+	m.updateProcessTable()
+	k := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")}
+
+	selectProcess(t, &m, 101)
+	updated, cmd := m.handleProcessKeys(k)
+	m = updated.(Model)
+	assert.Nil(t, cmd, "nothing is stopped before confirmation")
+	require.True(t, m.ConfirmationVisible)
+	assert.Equal(t, "process_stop", m.ConfirmationAction)
+	assert.Equal(t, 101, m.ConfirmationData)
+	assert.Contains(t, m.ConfirmationMessage, "worker")
+
+	m.ConfirmationVisible = false
+	m.ConfirmationAction = ""
+	m.ConfirmationData = nil
+	selectProcess(t, &m, 1)
+	updated, _ = m.handleProcessKeys(k)
+	m = updated.(Model)
+	assert.False(t, m.ConfirmationVisible)
+	assert.Contains(t, m.LastOperationMsg, "Refusing")
+
+	// Group rows hold a user name, even a numeric one, and not a PID.
+	m.LastOperationMsg = ""
+	m.Monitor.ProcessGroupBy = model.ProcessGroupUser
+	m.updateProcessTable()
+	for i, row := range m.Monitor.ProcessTable.Rows() {
+		if row[0] == "1000" {
+			m.Monitor.ProcessTable.SetCursor(i)
+		}
+	}
+	require.Equal(t, "1000", m.Monitor.ProcessTable.SelectedRow()[0])
+	updated, cmd = m.handleProcessKeys(k)
+	m = updated.(Model)
+	assert.Nil(t, cmd)
+	assert.False(t, m.ConfirmationVisible)
+	assert.Empty(t, m.LastOperationMsg)
+}
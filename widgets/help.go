@@ -61,6 +61,10 @@ type MonitorKeyMap struct {
 	Quick1   key.Binding
 	Quick2   key.Binding
 	Quick3   key.Binding
+	Quick4   key.Binding
+	Quick5   key.Binding
+	Quick6   key.Binding
+	Quick7   key.Binding
 }
 
 func (k MonitorKeyMap) ShortHelp() []key.Binding {
@@ -70,7 +74,7 @@ func (k MonitorKeyMap) ShortHelp() []key.Binding {
 func (k MonitorKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Select, k.Navigate},
-		{k.Quick1, k.Quick2, k.Quick3},
+		{k.Quick1, k.Quick2, k.Quick3, k.Quick4, k.Quick5, k.Quick6, k.Quick7},
 		{k.Help, k.Back, k.Quit},
 	}
 }
@@ -97,9 +101,20 @@ type ProcessKeyMap struct {
 	BaseKeyMap
 	Navigate key.Binding
 	Search   key.Binding
+	Page     key.Binding
 	Kill     key.Binding
 	SortMem  key.Binding
 	SortCPU  key.Binding
+	SortBy   key.Binding
+	Reverse  key.Binding
+	Tree     key.Binding
+	Collapse key.Binding
+	KillTree key.Binding
+	Details  key.Binding
+	Signal   key.Binding
+	Renice   key.Binding
+	IONice   key.Binding
+	Group    key.Binding
 }
 
 func (k ProcessKeyMap) ShortHelp() []key.Binding {
@@ -108,8 +123,28 @@ func (k ProcessKeyMap) ShortHelp() []key.Binding {
 
 func (k ProcessKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Navigate, k.Search},
-		{k.Kill, k.SortMem, k.SortCPU},
+		{k.Navigate, k.Page, k.Search, k.Details},
+		{k.Kill, k.SortMem, k.SortCPU, k.SortBy, k.Reverse},
+		{k.Tree, k.Group, k.Collapse, k.KillTree},
+		{k.Signal, k.Renice, k.IONice},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
+// ProcessDetailsKeyMap defines keybindings for the process detail view
+type ProcessDetailsKeyMap struct {
+	BaseKeyMap
+	Scroll key.Binding
+	Reload key.Binding
+}
+
+func (k ProcessDetailsKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Scroll, k.Reload, k.Help, k.Back, k.Quit}
+}
+
+func (k ProcessDetailsKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Scroll, k.Reload},
 		{k.Help, k.Back, k.Quit},
 	}
 }
@@ -120,19 +155,116 @@ type ContainersKeyMap struct {
 	Navigate key.Binding
 	Select   key.Binding
 	Search   key.Binding
+	Group    key.Binding
+	Restart  key.Binding
+	Stop     key.Binding
+	Start    key.Binding
+	Logs     key.Binding
 }
 
 func (k ContainersKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Navigate, k.Select, k.Search, k.Help, k.Back, k.Quit}
+	return []key.Binding{k.Navigate, k.Select, k.Search, k.Group, k.Help, k.Back, k.Quit}
 }
 
 func (k ContainersKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Navigate, k.Select, k.Search},
+		{k.Navigate, k.Select, k.Search, k.Group},
+		{k.Restart, k.Stop, k.Start, k.Logs},
 		{k.Help, k.Back, k.Quit},
 	}
 }
 
+// DockerResourceKeyMap defines keybindings for volumes and networks states
+type DockerResourceKeyMap struct {
+	BaseKeyMap
+	Navigate key.Binding
+	Page     key.Binding
+	Search   key.Binding
+	Remove   key.Binding
+	Prune    key.Binding
+	Reload   key.Binding
+}
+
+func (k DockerResourceKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Navigate, k.Remove, k.Prune, k.Search, k.Help, k.Back, k.Quit}
+}
+
+func (k DockerResourceKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Navigate, k.Page, k.Search, k.Reload},
+		{k.Remove, k.Prune},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
+// EventsKeyMap defines keybindings for the Docker events state
+type EventsKeyMap struct {
+	BaseKeyMap
+	Navigate key.Binding
+	Page     key.Binding
+	Search   key.Binding
+	Clear    key.Binding
+}
+
+func (k EventsKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Navigate, k.Search, k.Clear, k.Help, k.Back, k.Quit}
+}
+
+func (k EventsKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Navigate, k.Page, k.Search, k.Clear},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
+// ImagesKeyMap defines keybindings for images state
+type ImagesKeyMap struct {
+	DockerResourceKeyMap
+	Pull key.Binding
+}
+
+func (k ImagesKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Navigate, k.Remove, k.Prune, k.Pull, k.Search, k.Help, k.Back, k.Quit}
+}
+
+func (k ImagesKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Navigate, k.Page, k.Search, k.Reload},
+		{k.Remove, k.Prune, k.Pull},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
+func dockerResourceKeys(baseKeys BaseKeyMap, prune string) DockerResourceKeyMap {
+	return DockerResourceKeyMap{
+		BaseKeyMap: baseKeys,
+		Navigate: key.NewBinding(
+			key.WithKeys("up", "down"),
+			key.WithHelp("↑↓", "navigate"),
+		),
+		Page: key.NewBinding(
+			key.WithKeys("pgup", "pgdown", "home", "end"),
+			key.WithHelp("pgup/pgdn", "page"),
+		),
+		Search: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search"),
+		),
+		Remove: key.NewBinding(
+			key.WithKeys("d"),
+			key.WithHelp("d", "remove"),
+		),
+		Prune: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", prune),
+		),
+		Reload: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "reload"),
+		),
+	}
+}
+
 // ContainerKeyMap defines keybindings for single container state
 type ContainerKeyMap struct {
 	BaseKeyMap
@@ -150,27 +282,102 @@ func (k ContainerKeyMap) FullHelp() [][]key.Binding {
 	}
 }
 
+// ContainerFilesKeyMap defines keybindings for the Files tab of the
+// container view
+type ContainerFilesKeyMap struct {
+	ContainerKeyMap
+	Navigate key.Binding
+	Open     key.Binding
+	Parent   key.Binding
+	Copy     key.Binding
+	View     key.Binding
+	Reload   key.Binding
+}
+
+func (k ContainerFilesKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Open, k.Copy, k.View, k.Help, k.Back, k.Quit}
+}
+
+func (k ContainerFilesKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Navigate, k.Open, k.Parent},
+		{k.Copy, k.View, k.Reload},
+		{k.SwitchTab},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
 // ContainerLogsKeyMap defines keybindings for container logs state
 type ContainerLogsKeyMap struct {
 	BaseKeyMap
-	Scroll  key.Binding
-	Refresh key.Binding
-	HomeEnd key.Binding
-	Stream  key.Binding
+	Scroll     key.Binding
+	Refresh    key.Binding
+	HomeEnd    key.Binding
+	Stream     key.Binding
+	Search     key.Binding
+	Match      key.Binding
+	Level      key.Binding
+	Output     key.Binding
+	Timestamps key.Binding
+	Window     key.Binding
+	Export     key.Binding
 }
 
 func (k ContainerLogsKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Scroll, k.Refresh, k.Help, k.Back, k.Quit}
+	return []key.Binding{k.Scroll, k.Search, k.Level, k.Refresh, k.Help, k.Back, k.Quit}
 }
 
 func (k ContainerLogsKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Scroll, k.Refresh, k.HomeEnd},
-		{k.Stream},
+		{k.Stream, k.Window, k.Timestamps},
+		{k.Search, k.Match, k.Level, k.Output},
+		{k.Export},
 		{k.Help, k.Back, k.Quit},
 	}
 }
 
+// ContainerLimitsKeyMap defines keybindings for the container limits form
+type ContainerLimitsKeyMap struct {
+	BaseKeyMap
+	Navigate key.Binding
+	Apply    key.Binding
+	Reset    key.Binding
+}
+
+func (k ContainerLimitsKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Navigate, k.Apply, k.Help, k.Back, k.Quit}
+}
+
+func (k ContainerLimitsKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Navigate, k.Apply, k.Reset},
+		{k.Help, k.Back, k.Quit},
+	}
+}
+
+// ContainerExecKeyMap defines keybindings for the Run command view
+type ContainerExecKeyMap struct {
+	BaseKeyMap
+	Run     key.Binding
+	History key.Binding
+	Scroll  key.Binding
+	Clear   key.Binding
+	Stop    key.Binding
+}
+
+func (k ContainerExecKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Run, k.History, k.Scroll, k.Back, k.Quit}
+}
+
+func (k ContainerExecKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Run, k.History, k.Scroll},
+		{k.Clear, k.Stop},
+		{k.Back, k.Quit},
+	}
+}
+
 // NetworkKeyMap defines keybindings for network state
 type NetworkKeyMap struct {
 	BaseKeyMap
@@ -273,9 +480,11 @@ type ReportingKeyMap struct {
 	BaseKeyMap
 	Generate key.Binding
 	Save     key.Binding
+	Export   key.Binding
 	Load     key.Binding
 	Navigate key.Binding
 	Select   key.Binding
+	Compare  key.Binding
 	Delete   key.Binding
 }
 
@@ -285,8 +494,8 @@ func (k ReportingKeyMap) ShortHelp() []key.Binding {
 
 func (k ReportingKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Generate, k.Save, k.Load},
-		{k.Navigate, k.Select, k.Delete},
+		{k.Generate, k.Save, k.Export, k.Load},
+		{k.Navigate, k.Select, k.Compare, k.Delete},
 		{k.Help, k.Back, k.Quit},
 	}
 }
@@ -386,6 +595,22 @@ func (hs *HelpSystem) GetKeyMapForState(state model.AppState, diagnosticSelected
 				key.WithKeys("3"),
 				key.WithHelp("3", "containers"),
 			),
+			Quick4: key.NewBinding(
+				key.WithKeys("4"),
+				key.WithHelp("4", "images"),
+			),
+			Quick5: key.NewBinding(
+				key.WithKeys("5"),
+				key.WithHelp("5", "volumes"),
+			),
+			Quick6: key.NewBinding(
+				key.WithKeys("6"),
+				key.WithHelp("6", "networks"),
+			),
+			Quick7: key.NewBinding(
+				key.WithKeys("7"),
+				key.WithHelp("7", "events"),
+			),
 		}
 
 	case model.StateSystem:
@@ -420,6 +645,63 @@ func (hs *HelpSystem) GetKeyMapForState(state model.AppState, diagnosticSelected
 				key.WithKeys("s"),
 				key.WithHelp("s", "sort by cpu"),
 			),
+			Page: key.NewBinding(
+				key.WithKeys("pgup", "pgdown", "home", "end"),
+				key.WithHelp("pgup/pgdn", "page"),
+			),
+			SortBy: key.NewBinding(
+				key.WithKeys("<", ">"),
+				key.WithHelp("</>", "sort column"),
+			),
+			Reverse: key.NewBinding(
+				key.WithKeys("r"),
+				key.WithHelp("r", "reverse order"),
+			),
+			Tree: key.NewBinding(
+				key.WithKeys("t"),
+				key.WithHelp("t", "tree view"),
+			),
+			Collapse: key.NewBinding(
+				key.WithKeys(" "),
+				key.WithHelp("space", "expand/collapse"),
+			),
+			Details: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "details"),
+			),
+			Signal: key.NewBinding(
+				key.WithKeys("x"),
+				key.WithHelp("x", "send signal"),
+			),
+			Renice: key.NewBinding(
+				key.WithKeys("n"),
+				key.WithHelp("n", "renice"),
+			),
+			IONice: key.NewBinding(
+				key.WithKeys("i"),
+				key.WithHelp("i", "ionice"),
+			),
+			Group: key.NewBinding(
+				key.WithKeys("g"),
+				key.WithHelp("g", "group by user/command"),
+			),
+			KillTree: key.NewBinding(
+				key.WithKeys("K"),
+				key.WithHelp("K", "kill subtree"),
+			),
+		}
+
+	case model.StateProcessDetails:
+		return ProcessDetailsKeyMap{
+			BaseKeyMap: baseKeys,
+			Scroll: key.NewBinding(
+				key.WithKeys("up", "down", "pgup", "pgdown"),
+				key.WithHelp("↑↓/pgup/pgdn", "scroll"),
+			),
+			Reload: key.NewBinding(
+				key.WithKeys("r"),
+				key.WithHelp("r", "reload"),
+			),
 		}
 
 	case model.StateContainers:
@@ -437,6 +719,59 @@ func (hs *HelpSystem) GetKeyMapForState(state model.AppState, diagnosticSelected
 				key.WithKeys("/"),
 				key.WithHelp("/", "search"),
 			),
+			Group: key.NewBinding(
+				key.WithKeys("g"),
+				key.WithHelp("g", "group by project"),
+			),
+			Restart: key.NewBinding(
+				key.WithKeys("R"),
+				key.WithHelp("R", "restart project"),
+			),
+			Stop: key.NewBinding(
+				key.WithKeys("S"),
+				key.WithHelp("S", "stop project"),
+			),
+			Start: key.NewBinding(
+				key.WithKeys("U"),
+				key.WithHelp("U", "start project"),
+			),
+			Logs: key.NewBinding(
+				key.WithKeys("L"),
+				key.WithHelp("L", "project logs"),
+			),
+		}
+
+	case model.StateImages:
+		return ImagesKeyMap{
+			DockerResourceKeyMap: dockerResourceKeys(baseKeys, "prune dangling"),
+			Pull: key.NewBinding(
+				key.WithKeys("u"),
+				key.WithHelp("u", "pull"),
+			),
+		}
+
+	case model.StateVolumes, model.StateDockerNetworks:
+		return dockerResourceKeys(baseKeys, "prune unused")
+
+	case model.StateDockerEvents:
+		return EventsKeyMap{
+			BaseKeyMap: baseKeys,
+			Navigate: key.NewBinding(
+				key.WithKeys("up", "down"),
+				key.WithHelp("↑↓", "navigate"),
+			),
+			Page: key.NewBinding(
+				key.WithKeys("pgup", "pgdown", "home", "end"),
+				key.WithHelp("pgup/pgdn", "page"),
+			),
+			Search: key.NewBinding(
+				key.WithKeys("/"),
+				key.WithHelp("/", "search"),
+			),
+			Clear: key.NewBinding(
+				key.WithKeys("c"),
+				key.WithHelp("c", "clear history"),
+			),
 		}
 
 	case model.StateContainer:
@@ -448,6 +783,69 @@ func (hs *HelpSystem) GetKeyMapForState(state model.AppState, diagnosticSelected
 			),
 		}
 
+	case model.StateContainerLimits:
+		// Letters are typed into the form, so only esc and ctrl+c leave it.
+		return ContainerLimitsKeyMap{
+			BaseKeyMap: BaseKeyMap{
+				Help: baseKeys.Help,
+				Quit: key.NewBinding(
+					key.WithKeys("ctrl+c"),
+					key.WithHelp("ctrl+c", "quit"),
+				),
+				Back: key.NewBinding(
+					key.WithKeys("esc"),
+					key.WithHelp("esc", "back"),
+				),
+			},
+			Navigate: key.NewBinding(
+				key.WithKeys("tab", "shift+tab", "up", "down"),
+				key.WithHelp("tab/↑↓", "next field"),
+			),
+			Apply: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "apply"),
+			),
+			Reset: key.NewBinding(
+				key.WithKeys("ctrl+r"),
+				key.WithHelp("ctrl+r", "reset"),
+			),
+		}
+
+	case model.StateContainerExec:
+		// Letters, including ?, are typed into the command.
+		return ContainerExecKeyMap{
+			BaseKeyMap: BaseKeyMap{
+				Quit: key.NewBinding(
+					key.WithKeys("ctrl+c"),
+					key.WithHelp("ctrl+c", "quit"),
+				),
+				Back: key.NewBinding(
+					key.WithKeys("esc"),
+					key.WithHelp("esc", "back"),
+				),
+			},
+			Run: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "run"),
+			),
+			History: key.NewBinding(
+				key.WithKeys("up", "down"),
+				key.WithHelp("↑↓", "history"),
+			),
+			Scroll: key.NewBinding(
+				key.WithKeys("pgup", "pgdown"),
+				key.WithHelp("pgup/pgdn", "scroll"),
+			),
+			Clear: key.NewBinding(
+				key.WithKeys("ctrl+l"),
+				key.WithHelp("ctrl+l", "clear output"),
+			),
+			Stop: key.NewBinding(
+				key.WithKeys("ctrl+x"),
+				key.WithHelp("ctrl+x", "stop following"),
+			),
+		}
+
 	case model.StateContainerLogs:
 		return ContainerLogsKeyMap{
 			BaseKeyMap: baseKeys,
@@ -467,6 +865,34 @@ func (hs *HelpSystem) GetKeyMapForState(state model.AppState, diagnosticSelected
 				key.WithKeys("s"),
 				key.WithHelp("s", "toggle stream"),
 			),
+			Search: key.NewBinding(
+				key.WithKeys("/"),
+				key.WithHelp("/", "search"),
+			),
+			Match: key.NewBinding(
+				key.WithKeys("n", "N"),
+				key.WithHelp("n/N", "next/prev match"),
+			),
+			Level: key.NewBinding(
+				key.WithKeys("l"),
+				key.WithHelp("l", "min level"),
+			),
+			Output: key.NewBinding(
+				key.WithKeys("o"),
+				key.WithHelp("o", "stdout/stderr"),
+			),
+			Timestamps: key.NewBinding(
+				key.WithKeys("t"),
+				key.WithHelp("t", "timestamps"),
+			),
+			Window: key.NewBinding(
+				key.WithKeys("w"),
+				key.WithHelp("w", "time window"),
+			),
+			Export: key.NewBinding(
+				key.WithKeys("e", "E"),
+				key.WithHelp("e/E", "export/record (E: gzip)"),
+			),
 		}
 
 	case model.StateNetwork:
@@ -657,6 +1083,10 @@ func (hs *HelpSystem) GetKeyMapForState(state model.AppState, diagnosticSelected
 				key.WithKeys("s"),
 				key.WithHelp("s", "save report"),
 			),
+			Export: key.NewBinding(
+				key.WithKeys("e"),
+				key.WithHelp("e", "export JSON"),
+			),
 			Load: key.NewBinding(
 				key.WithKeys("l"),
 				key.WithHelp("l", "load reports"),
@@ -669,6 +1099,10 @@ func (hs *HelpSystem) GetKeyMapForState(state model.AppState, diagnosticSelected
 				key.WithKeys("enter"),
 				key.WithHelp("enter", "select"),
 			),
+			Compare: key.NewBinding(
+				key.WithKeys("c"),
+				key.WithHelp("c", "compare"),
+			),
 			Delete: key.NewBinding(
 				key.WithKeys("d"),
 				key.WithHelp("d", "delete"),
@@ -838,5 +1272,36 @@ func (hs *HelpSystem) GetKeyMapForStateWithModel(state model.AppState, diagnosti
 			),
 		}
 	}
+	if state == model.StateContainer && m.ContainerTab == model.ContainerTabFiles {
+		if containerKeys, ok := hs.GetKeyMapForState(state, diagnosticSelectedItem).(ContainerKeyMap); ok {
+			return ContainerFilesKeyMap{
+				ContainerKeyMap: containerKeys,
+				Navigate: key.NewBinding(
+					key.WithKeys("up", "down", "k", "j"),
+					key.WithHelp("↑↓", "navigate"),
+				),
+				Open: key.NewBinding(
+					key.WithKeys("enter"),
+					key.WithHelp("enter", "open directory"),
+				),
+				Parent: key.NewBinding(
+					key.WithKeys("backspace"),
+					key.WithHelp("backspace", "parent directory"),
+				),
+				Copy: key.NewBinding(
+					key.WithKeys("c"),
+					key.WithHelp("c", "copy to host"),
+				),
+				View: key.NewBinding(
+					key.WithKeys("v"),
+					key.WithHelp("v", "changes/browse"),
+				),
+				Reload: key.NewBinding(
+					key.WithKeys("r"),
+					key.WithHelp("r", "reload"),
+				),
+			}
+		}
+	}
 	return hs.GetKeyMapForState(state, diagnosticSelectedItem)
 }
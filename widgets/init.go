@@ -5,13 +5,12 @@ import (
 	"time"
 
 	"github.com/System-Pulse/server-pulse/system/app"
-	info "github.com/System-Pulse/server-pulse/system/informations"
 	"github.com/System-Pulse/server-pulse/system/logs"
 	"github.com/System-Pulse/server-pulse/system/network"
-	proc "github.com/System-Pulse/server-pulse/system/process"
-	resource "github.com/System-Pulse/server-pulse/system/resource"
+	"github.com/System-Pulse/server-pulse/system/schedule"
 	"github.com/System-Pulse/server-pulse/system/security"
 	"github.com/System-Pulse/server-pulse/utils"
+	"github.com/System-Pulse/server-pulse/widgets/commands"
 	model "github.com/System-Pulse/server-pulse/widgets/model"
 	v "github.com/System-Pulse/server-pulse/widgets/vars"
 
@@ -43,15 +42,8 @@ func InitialModelWithManager(apk *app.DockerManager) Model {
 		}
 	}
 
-	columns := []table.Column{
-		{Title: "PID", Width: 8},
-		{Title: "User", Width: 12},
-		{Title: "CPU%", Width: 8},
-		{Title: "Mem%", Width: 8},
-		{Title: "Command", Width: 30},
-	}
 	t := table.New(
-		table.WithColumns(columns),
+		table.WithColumns(processColumns(model.ProcessSortByCPU, false)),
 		table.WithFocused(true),
 	)
 	s := table.DefaultStyles()
@@ -59,17 +51,8 @@ func InitialModelWithManager(apk *app.DockerManager) Model {
 	s.Selected = s.Selected.Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57")).Bold(false)
 	t.SetStyles(s)
 	// containers table
-	ctColumns := []table.Column{
-		{Title: "ID", Width: 12},
-		{Title: "Image", Width: 12},
-		{Title: "Name", Width: 16},
-		{Title: "Status", Width: 12},
-		{Title: "Health", Width: 12},
-		{Title: "Project", Width: 20},
-		{Title: "Ports", Width: 20},
-	}
 	ct := table.New(
-		table.WithColumns(ctColumns),
+		table.WithColumns(containerColumns()),
 		table.WithFocused(true),
 	)
 	cs := table.DefaultStyles()
@@ -77,6 +60,62 @@ func InitialModelWithManager(apk *app.DockerManager) Model {
 	cs.Selected = cs.Selected.Foreground(lipgloss.Color("229")).Background(lipgloss.Color("57")).Bold(false)
 	ct.SetStyles(cs)
 
+	imageTable := table.New(
+		table.WithColumns([]table.Column{
+			{Title: "Repository", Width: 28},
+			{Title: "Tag", Width: 14},
+			{Title: "ID", Width: 12},
+			{Title: "Size", Width: 10},
+			{Title: "Created", Width: 10},
+			{Title: "Used by", Width: 24},
+		}),
+		table.WithFocused(true),
+	)
+	imageTable.SetStyles(cs)
+
+	volumeTable := table.New(
+		table.WithColumns([]table.Column{
+			{Title: "Name", Width: 28},
+			{Title: "Driver", Width: 8},
+			{Title: "Size", Width: 10},
+			{Title: "Used by", Width: 24},
+			{Title: "Mountpoint", Width: 40},
+		}),
+		table.WithFocused(true),
+	)
+	volumeTable.SetStyles(cs)
+
+	dockerNetworkTable := table.New(
+		table.WithColumns([]table.Column{
+			{Title: "Name", Width: 20},
+			{Title: "ID", Width: 12},
+			{Title: "Driver", Width: 8},
+			{Title: "Subnet", Width: 18},
+			{Title: "Gateway", Width: 15},
+			{Title: "Containers", Width: 10},
+		}),
+		table.WithFocused(true),
+	)
+	dockerNetworkTable.SetStyles(cs)
+
+	filesTable := table.New(
+		table.WithColumns(fileChangeColumns()),
+		table.WithFocused(true),
+	)
+	filesTable.SetStyles(cs)
+
+	eventTable := table.New(
+		table.WithColumns([]table.Column{
+			{Title: "Time", Width: 8},
+			{Title: "Container", Width: 20},
+			{Title: "Project", Width: 14},
+			{Title: "Event", Width: 14},
+			{Title: "Detail", Width: 24},
+		}),
+		table.WithFocused(true),
+	)
+	eventTable.SetStyles(cs)
+
 	// Network table
 	networkColumns := []table.Column{
 		{Title: "Interface", Width: 12},
@@ -359,8 +398,40 @@ func InitialModelWithManager(apk *app.DockerManager) Model {
 		CanRunSudo:    canRunSudo,
 		Reporting:     model.NewReportModel(),
 		Monitor: model.MonitorModel{
-			ProcessTable:       t,
+			ProcessTable: t,
+			ProcessInput: func() textinput.Model {
+				ti := textinput.New()
+				ti.CharLimit = 20
+				ti.Width = 40
+				return ti
+			}(),
 			Container:          ct,
+			ImageTable:         imageTable,
+			VolumeTable:        volumeTable,
+			DockerNetworkTable: dockerNetworkTable,
+			EventTable:         eventTable,
+			Files: model.ContainerFiles{
+				Table: filesTable,
+				CopyInput: func() textinput.Model {
+					ti := textinput.New()
+					ti.Prompt = "Copy to: "
+					ti.CharLimit = 4096
+					ti.Width = 60
+					return ti
+				}(),
+			},
+			Exec: model.ContainerExec{
+				Input: func() textinput.Model {
+					ti := textinput.New()
+					ti.Prompt = "$ "
+					ti.Placeholder = "command, e.g. ls -la /app or sh -c 'ps aux | grep node'"
+					ti.CharLimit = 4096
+					ti.Width = 60
+					return ti
+				}(),
+				Viewport:  viewport.New(100, 20),
+				Histories: make(map[string]model.CommandHistory),
+			},
 			CpuProgress:        progress.New(progOpts...),
 			MemProgress:        progress.New(progOpts...),
 			SwapProgress:       progress.New(progOpts...),
@@ -377,6 +448,16 @@ func InitialModelWithManager(apk *app.DockerManager) Model {
 				TotalPages:  1,
 				Lines:       []string{},
 			},
+			Logs: model.ContainerLogView{
+				Match:      -1,
+				Timestamps: true,
+				Prompt: func() textinput.Model {
+					ti := textinput.New()
+					ti.CharLimit = 256
+					ti.Width = 60
+					return ti
+				}(),
+			},
 			CpuHistory: model.DataHistory{
 				MaxPoints: 60,
 				Points:    make([]model.DataPoint, 0),
@@ -414,6 +495,7 @@ func InitialModelWithManager(apk *app.DockerManager) Model {
 			ContentHeight: 20,
 			Spinner:       spinnerModel,
 		},
+		Schedule:          schedule.New(schedule.DefaultInterval, nil),
 		LastChartUpdate:   time.Now(),
 		ScrollSensitivity: 3,
 		MouseEnabled:      true,
@@ -423,23 +505,33 @@ func InitialModelWithManager(apk *app.DockerManager) Model {
 	}
 
 	m.Monitor.ProcessTable.Focus()
-	m.updateContainerTable(containers)
+	m.Monitor.Containers = containers
+	m.Monitor.ContainersUpdatedAt = time.Now()
+	m.updateContainerTable()
 	m.Monitor.Container.Focus()
 	return m
 }
 
 func (m Model) Init() tea.Cmd {
-	cmds := []tea.Cmd{
-		tick(),
-		info.UpdateSystemInfo(),
-		resource.UpdateCPUInfo(),
-		resource.UpdateMemoryInfo(),
-		resource.UpdateDiskInfo(),
-		resource.UpdateNetworkInfo(),
-		proc.UpdateProcesses(),
-	}
+	cmds := append([]tea.Cmd{tick(m.Schedule.TickInterval())}, m.scheduledCmds(time.Now())...)
 	if m.Monitor.App != nil {
-		cmds = append(cmds, m.Monitor.App.UpdateApp())
+		// Init runs again each time the TUI comes back from a shell: the
+		// subscription of the previous run is replaced, resuming after the
+		// events already seen.
+		if m.Monitor.EventsCancelFunc != nil {
+			m.Monitor.EventsCancelFunc()
+		}
+		cmds = append(cmds, commands.WatchEventsCmd(m.Monitor.App, m.eventsSince()))
 	}
 	return tea.Batch(cmds...)
 }
+
+// eventsSince is where an events subscription starts: after the latest event
+// seen or, on the first one, EventAlertWindow ago so that repeated failures
+// show up at once.
+func (m Model) eventsSince() time.Time {
+	if last := m.Monitor.Events.Last(); !last.IsZero() {
+		return last.Add(time.Nanosecond)
+	}
+	return time.Now().Add(-model.EventAlertWindow)
+}
@@ -0,0 +1,179 @@
+package widgets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	system "github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/system/schedule"
+	model "github.com/System-Pulse/server-pulse/widgets/model"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/moby/moby/api/types/events"
+	"github.com/moby/moby/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEventsDaemon serves the container list and the events stream of a
+// Docker daemon, honoring the since filter, and counts the open streams.
+type fakeEventsDaemon struct {
+	mu      sync.Mutex
+	events  []events.Message
+	open    int
+	sinces  []string
+	handler http.Handler
+}
+
+func newFakeEventsDaemon(msgs []events.Message) *fakeEventsDaemon {
+	d := &fakeEventsDaemon{events: msgs}
+	d.handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/containers/json"):
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte("[]"))
+		case strings.HasSuffix(r.URL.Path, "/events"):
+			d.serveEvents(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	return d
+}
+
+func (d *fakeEventsDaemon) serveEvents(w http.ResponseWriter, r *http.Request) {
+	since := r.URL.Query().Get("since")
+	var sinceNano int64
+	if sec, nsec, ok := strings.Cut(since, "."); ok {
+		s, _ := strconv.ParseInt(sec, 10, 64)
+		n, _ := strconv.ParseInt(nsec, 10, 64)
+		sinceNano = s*int64(time.Second) + n
+	}
+
+	d.mu.Lock()
+	d.open++
+	d.sinces = append(d.sinces, since)
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		d.open--
+		d.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(w)
+	for _, msg := range d.events {
+		if msg.TimeNano >= sinceNano {
+			encoder.Encode(msg)
+		}
+	}
+	w.(http.Flusher).Flush()
+	<-r.Context().Done()
+}
+
+func (d *fakeEventsDaemon) openStreams() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.open
+}
+
+func dieEvent(id string, at time.Time) events.Message {
+	return events.Message{
+		Type:   events.ContainerEventType,
+		Action: events.ActionDie,
+		Actor: events.Actor{
+			ID:         id,
+			Attributes: map[string]string{"name": "web-1", "exitCode": "137"},
+		},
+		Time:     at.Unix(),
+		TimeNano: at.UnixNano(),
+	}
+}
+
+// runInit runs Init as the program does and delivers the events stream it
+// subscribes to, then the events received within a short wait.
+func runInit(t *testing.T, m Model) Model {
+	t.Helper()
+
+	cmd := m.Init()
+	var cmds []tea.Cmd
+	if batch, ok := cmd().(tea.BatchMsg); ok {
+		cmds = batch
+	}
+	msgs := make(chan tea.Msg, len(cmds))
+	for _, c := range cmds {
+		if c != nil {
+			go func() { msgs <- c() }()
+		}
+	}
+
+	var stream system.EventsStreamMsg
+	for stream.Events == nil {
+		select {
+		case msg := <-msgs:
+			if s, ok := msg.(system.EventsStreamMsg); ok {
+				stream = s
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Init did not subscribe to events")
+		}
+	}
+
+	updated, _ := m.Update(stream)
+	m = updated.(Model)
+	for {
+		select {
+		case event, ok := <-m.Monitor.EventsChan:
+			require.True(t, ok, "events stream ended")
+			updated, _ = m.Update(system.ContainerEventMsg(event))
+			m = updated.(Model)
+		case <-time.After(300 * time.Millisecond):
+			return m
+		}
+	}
+}
+
+func TestInitTwiceDoesNotDuplicateEvents(t *testing.T) {
+	now := time.Now()
+	daemon := newFakeEventsDaemon([]events.Message{
+		dieEvent("aaaaaaaaaaaaaaaa", now.Add(-10*time.Minute)),
+		dieEvent("aaaaaaaaaaaaaaaa", now.Add(-5*time.Minute)),
+	})
+	server := httptest.NewServer(daemon.handler)
+	defer server.Close()
+	// Close waits for the streams still open.
+	defer server.CloseClientConnections()
+
+	cli, err := client.NewClientWithOpts(client.WithHost("tcp://"+server.Listener.Addr().String()), client.WithVersion("1.47"))
+	require.NoError(t, err)
+	m := InitialModelWithManager(&system.DockerManager{Cli: cli})
+	// Only the events subscription is under test: no collector is due.
+	for _, c := range schedule.Collectors {
+		m.Schedule.Due(c, time.Now(), true)
+	}
+
+	m = runInit(t, m)
+	require.Len(t, m.Monitor.Events.Events, 2)
+
+	// Coming back from a shell runs Init again on the same model.
+	m = runInit(t, m)
+	assert.Len(t, m.Monitor.Events.Events, 2)
+	alerts := model.EventAlerts(m.Monitor.Events.Events, time.Now())
+	require.Len(t, alerts, 1)
+	assert.Equal(t, 2, alerts[0].Count)
+
+	last := now.Add(-5 * time.Minute).Add(time.Nanosecond)
+	daemon.mu.Lock()
+	assert.Equal(t, fmt.Sprintf("%d.%09d", last.Unix(), last.Nanosecond()), daemon.sinces[len(daemon.sinces)-1],
+		"the second subscription should resume after the last event")
+	daemon.mu.Unlock()
+	assert.Eventually(t, func() bool { return daemon.openStreams() == 1 }, 5*time.Second, 10*time.Millisecond,
+		"the first subscription should be cancelled")
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/viewport"
 
+	"github.com/System-Pulse/server-pulse/system/schedule"
 	model "github.com/System-Pulse/server-pulse/widgets/model"
 
 	"github.com/System-Pulse/server-pulse/system/app"
@@ -38,6 +39,7 @@ type Model struct {
 	SudoAvailable       bool
 	CanRunSudo          bool
 	Reporting           model.ReportModel
+	Schedule            schedule.Schedule
 }
 
 type connectionStats struct {
@@ -53,6 +55,12 @@ func (m *Model) setState(newState model.AppState) {
 		m.Ui.State = newState
 		m.HelpSystem.ResetHelp()
 
+		// The logs view stands in a pseudo container for merged project logs.
+		if newState != model.StateContainerLogs && m.Monitor.LogsProject != "" {
+			m.Monitor.LogsProject = ""
+			m.Monitor.SelectedContainer = nil
+		}
+
 		// Clear save notification when leaving reporting state
 		if m.Ui.State != model.StateReporting && m.Reporting.SaveNotification != "" {
 			m.Reporting.SaveNotification = ""
@@ -64,8 +72,9 @@ func (m *Model) setState(newState model.AppState) {
 	case model.StateHome:
 		m.Ui.SelectedTab = m.Ui.ActiveView
 		m.Ui.ActiveView = -1
-	case model.StateMonitor, model.StateSystem, model.StateProcess, model.StateContainers,
-		model.StateContainer, model.StateContainerLogs:
+	case model.StateMonitor, model.StateSystem, model.StateProcess, model.StateProcessDetails,
+		model.StateContainers, model.StateContainer, model.StateContainerLogs, model.StateContainerLimits, model.StateContainerExec,
+		model.StateImages, model.StateVolumes, model.StateDockerNetworks, model.StateDockerEvents:
 		m.Ui.SelectedTab = 0
 	case model.StateDiagnostics, model.StateCertificateDetails:
 		m.Ui.SelectedTab = 1
@@ -78,10 +87,19 @@ func (m *Model) setState(newState model.AppState) {
 	switch newState {
 	case model.StateSystem:
 		m.Ui.SelectedMonitor = 0
-	case model.StateProcess:
+	case model.StateProcess, model.StateProcessDetails:
 		m.Ui.SelectedMonitor = 1
-	case model.StateContainers, model.StateContainer, model.StateContainerLogs:
+	case model.StateContainers, model.StateContainer, model.StateContainerLogs, model.StateContainerLimits,
+		model.StateContainerExec:
 		m.Ui.SelectedMonitor = 2
+	case model.StateImages:
+		m.Ui.SelectedMonitor = 3
+	case model.StateVolumes:
+		m.Ui.SelectedMonitor = 4
+	case model.StateDockerNetworks:
+		m.Ui.SelectedMonitor = 5
+	case model.StateDockerEvents:
+		m.Ui.SelectedMonitor = 6
 	}
 }
 
@@ -90,10 +108,22 @@ func (m *Model) goBack() {
 	switch m.Ui.State {
 	case model.StateContainer, model.StateContainerLogs:
 		m.stopContainerStats()
+		// Reopening a container reloads its files.
+		m.Monitor.Files.ContainerID = ""
+		m.setState(model.StateContainers)
+	case model.StateContainerLimits:
+		m.Monitor.LimitsForm = model.ContainerLimitsForm{}
+		m.setState(model.StateContainers)
+	case model.StateContainerExec:
+		m.Monitor.Exec.Stop()
 		m.setState(model.StateContainers)
+	case model.StateProcessDetails:
+		m.Monitor.ProcessDetails = nil
+		m.setState(model.StateProcess)
 	case model.StateMonitor, model.StateDiagnostics, model.StateNetwork, model.StateReporting,
 		model.StateGeneratingReport, model.StateViewingReport, model.StateSavingReport,
-		model.StateSystem, model.StateProcess, model.StateContainers:
+		model.StateSystem, model.StateProcess, model.StateContainers,
+		model.StateImages, model.StateVolumes, model.StateDockerNetworks, model.StateDockerEvents:
 		m.stopContainerStats()
 		m.setState(model.StateHome)
 	default:
@@ -0,0 +1,216 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// LogStream selects the output streams shown in the logs view.
+type LogStream int
+
+const (
+	LogStreamAll LogStream = iota
+	LogStreamStdout
+	LogStreamStderr
+)
+
+func (s LogStream) String() string {
+	switch s {
+	case LogStreamStdout:
+		return "stdout"
+	case LogStreamStderr:
+		return "stderr"
+	}
+	return "stdout+stderr"
+}
+
+// LogPrompt is what the input of the logs view is asking for.
+type LogPrompt int
+
+const (
+	LogPromptNone LogPrompt = iota
+	LogPromptSearch
+	LogPromptWindow
+)
+
+// ContainerLogView holds the loaded logs of the logs view and the filters
+// applied to them. The prompt is kept when another container is opened.
+type ContainerLogView struct {
+	Lines []app.LogLine
+	// Visible holds the indexes in Lines of the lines passing the filters.
+	Visible  []int
+	MinLevel string // least severe level shown, "" to show every line
+	Stream   LogStream
+	Search   *regexp.Regexp
+	// Matches holds the positions in Visible of the lines matching Search
+	// and Match the current one, -1 when there is none.
+	Matches    []int
+	Match      int
+	Window     app.LogsWindow
+	Timestamps bool
+	Prompt     textinput.Model
+	PromptKind LogPrompt
+}
+
+// Reset clears the logs and filters of the previous container.
+func (v *ContainerLogView) Reset() {
+	v.Lines = nil
+	v.Visible = nil
+	v.MinLevel = ""
+	v.Stream = LogStreamAll
+	v.Search = nil
+	v.Matches = nil
+	v.Match = -1
+	v.Window = app.LogsWindow{}
+	v.Timestamps = true
+	v.ClosePrompt()
+}
+
+// SetLines replaces the logs and applies the filters.
+func (v *ContainerLogView) SetLines(lines []app.LogLine) {
+	v.Lines = lines
+	v.Match = -1
+	v.Apply()
+}
+
+// Append adds a streamed line, keeping at most limit lines.
+func (v *ContainerLogView) Append(line app.LogLine, limit int) {
+	v.Lines = append(v.Lines, line)
+	if len(v.Lines) > limit {
+		v.Lines = append([]app.LogLine(nil), v.Lines[len(v.Lines)-limit:]...)
+	}
+	v.Apply()
+}
+
+// Shows reports whether a line passes the level and stream filters. Lines
+// without a level are hidden while a level is selected.
+func (v ContainerLogView) Shows(line app.LogLine) bool {
+	switch {
+	case v.Stream == LogStreamStdout && line.Stderr, v.Stream == LogStreamStderr && !line.Stderr:
+		return false
+	case v.MinLevel != "":
+		return app.LogLevelRank(line.Level) >= app.LogLevelRank(v.MinLevel)
+	}
+	return true
+}
+
+// Apply recomputes the visible lines and the search matches.
+func (v *ContainerLogView) Apply() {
+	v.Visible = v.Visible[:0]
+	v.Matches = v.Matches[:0]
+	for i, line := range v.Lines {
+		if !v.Shows(line) {
+			continue
+		}
+		if v.Search != nil && v.Search.MatchString(line.Text) {
+			v.Matches = append(v.Matches, len(v.Visible))
+		}
+		v.Visible = append(v.Visible, i)
+	}
+	if v.Match >= len(v.Matches) {
+		v.Match = len(v.Matches) - 1
+	}
+}
+
+// SetSearch searches the logs for a regular expression, case-insensitive
+// unless it contains an upper-case letter, and selects the first match. An
+// empty pattern ends the search.
+func (v *ContainerLogView) SetSearch(pattern string) error {
+	v.Match = -1
+	if pattern == "" {
+		v.Search = nil
+		v.Apply()
+		return nil
+	}
+	if strings.ToLower(pattern) == pattern {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	v.Search = re
+	v.Apply()
+	if len(v.Matches) > 0 {
+		v.Match = 0
+	}
+	return nil
+}
+
+// SearchPattern returns the search as typed.
+func (v ContainerLogView) SearchPattern() string {
+	if v.Search == nil {
+		return ""
+	}
+	return strings.TrimPrefix(v.Search.String(), "(?i)")
+}
+
+// NextMatch selects the next (delta 1) or previous (delta -1) match,
+// wrapping around.
+func (v *ContainerLogView) NextMatch(delta int) {
+	if len(v.Matches) == 0 {
+		return
+	}
+	if v.Match < 0 {
+		v.Match = 0
+		return
+	}
+	v.Match = (v.Match + delta + len(v.Matches)) % len(v.Matches)
+}
+
+// CurrentMatch returns the position in Visible of the current match, -1
+// when there is none.
+func (v ContainerLogView) CurrentMatch() int {
+	if v.Match < 0 || v.Match >= len(v.Matches) {
+		return -1
+	}
+	return v.Matches[v.Match]
+}
+
+// CycleLevel shows every line, then only the lines at or above each level in
+// turn.
+func (v *ContainerLogView) CycleLevel() {
+	next := app.LogLevelRank(v.MinLevel) + 1
+	if next >= len(app.LogLevels) {
+		v.MinLevel = ""
+	} else {
+		v.MinLevel = app.LogLevels[next]
+	}
+	v.Apply()
+}
+
+func (v *ContainerLogView) CycleStream() {
+	v.Stream = (v.Stream + 1) % 3
+	v.Apply()
+}
+
+// OpenPrompt asks for a search or a time window, starting from the current
+// one.
+func (v *ContainerLogView) OpenPrompt(kind LogPrompt) {
+	v.PromptKind = kind
+	switch kind {
+	case LogPromptSearch:
+		v.Prompt.Prompt = "Search: "
+		v.Prompt.Placeholder = "regular expression, case-insensitive unless it has capitals"
+		v.Prompt.SetValue(v.SearchPattern())
+	case LogPromptWindow:
+		v.Prompt.Prompt = "Time window: "
+		v.Prompt.Placeholder = "SINCE [UNTIL], e.g. 30m or 2024-05-01T10:00:00Z 2024-05-01T11:00:00Z"
+		since := v.Window.Since
+		if since == "" && v.Window.Until != "" {
+			since = "-"
+		}
+		v.Prompt.SetValue(strings.TrimSpace(since + " " + v.Window.Until))
+	}
+	v.Prompt.CursorEnd()
+	v.Prompt.Focus()
+}
+
+func (v *ContainerLogView) ClosePrompt() {
+	v.PromptKind = LogPromptNone
+	v.Prompt.Blur()
+	v.Prompt.Reset()
+}
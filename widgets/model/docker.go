@@ -0,0 +1,158 @@
+package model
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+)
+
+// ImageUsage is the disk space taken by local images. Tags sharing an image
+// are counted once.
+type ImageUsage struct {
+	Images       int
+	Size         int64
+	Dangling     int
+	DanglingSize int64
+	Unused       int // images no container was created from
+	UnusedSize   int64
+}
+
+func SummarizeImages(images []app.Image) ImageUsage {
+	var usage ImageUsage
+	seen := make(map[string]bool)
+	for _, img := range images {
+		if seen[img.FullID] {
+			continue
+		}
+		seen[img.FullID] = true
+
+		usage.Images++
+		usage.Size += img.Size
+		if img.Dangling() {
+			usage.Dangling++
+			usage.DanglingSize += img.Size
+		}
+		if len(img.Containers) == 0 {
+			usage.Unused++
+			usage.UnusedSize += img.Size
+		}
+	}
+	return usage
+}
+
+// VolumeUsage is the disk space taken by volumes. Sizes that were not
+// measured count as zero.
+type VolumeUsage struct {
+	Volumes    int
+	Size       int64
+	Unused     int
+	UnusedSize int64
+}
+
+func SummarizeVolumes(volumes []app.Volume) VolumeUsage {
+	var usage VolumeUsage
+	for _, v := range volumes {
+		size := max(v.Size, 0)
+		usage.Volumes++
+		usage.Size += size
+		if !v.InUse() {
+			usage.Unused++
+			usage.UnusedSize += size
+		}
+	}
+	return usage
+}
+
+// MergeVolumeSizes copies the sizes of previous into volumes whose size was
+// not measured, so that periodic refreshes do not have to walk every volume,
+// and restores the size order.
+func MergeVolumeSizes(previous, volumes []app.Volume) {
+	sizes := make(map[string]int64, len(previous))
+	for _, v := range previous {
+		sizes[v.Name] = v.Size
+	}
+	for i := range volumes {
+		if size, ok := sizes[volumes[i].Name]; ok && volumes[i].Size < 0 {
+			volumes[i].Size = size
+		}
+	}
+	app.SortVolumes(volumes)
+}
+
+// ContainerProject sums the containers of one Compose project. CPU and
+// memory only cover the running containers that have a stats sample.
+type ContainerProject struct {
+	Name       string
+	Running    int
+	CPU        float64
+	Memory     uint64
+	Containers []app.Container
+}
+
+// Standalone reports whether the group holds the containers not started by
+// Compose, which project-wide actions do not apply to.
+func (p ContainerProject) Standalone() bool {
+	return p.Name == app.NoProject
+}
+
+func (p ContainerProject) ContainerIDs() []string {
+	ids := make([]string, len(p.Containers))
+	for i, c := range p.Containers {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+// GroupContainers groups containers by Compose project, sorted by name with
+// standalone containers last. stats is keyed by container ID.
+func GroupContainers(containers []app.Container, stats map[string]app.ContainerStats) []ContainerProject {
+	index := make(map[string]int)
+	var projects []ContainerProject
+	for _, c := range containers {
+		i, ok := index[c.Project]
+		if !ok {
+			i = len(projects)
+			index[c.Project] = i
+			projects = append(projects, ContainerProject{Name: c.Project})
+		}
+		p := &projects[i]
+		p.Containers = append(p.Containers, c)
+		if c.State == "running" {
+			p.Running++
+			if s, ok := stats[c.ID]; ok {
+				p.CPU += s.CPUPercent
+				p.Memory += s.MemoryUsage
+			}
+		}
+	}
+
+	sort.SliceStable(projects, func(i, j int) bool {
+		if projects[i].Standalone() != projects[j].Standalone() {
+			return projects[j].Standalone()
+		}
+		return projects[i].Name < projects[j].Name
+	})
+	return projects
+}
+
+// ReplaceContainer updates the container with the given short ID after it
+// was inspected again: it is replaced, added when new, or removed when found
+// is false.
+func ReplaceContainer(containers []app.Container, id string, c app.Container, found bool) []app.Container {
+	for i := range containers {
+		if containers[i].ID != id {
+			continue
+		}
+		if !found {
+			return append(containers[:i:i], containers[i+1:]...)
+		}
+		updated := slices.Clone(containers)
+		updated[i] = c
+		return updated
+	}
+	if !found {
+		return containers
+	}
+	return append(slices.Clone(containers), c)
+}
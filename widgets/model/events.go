@@ -0,0 +1,113 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+)
+
+// MaxEvents bounds the container events kept in memory.
+const MaxEvents = 1000
+
+// EventAlertWindow is how far back repeated failures are counted.
+const EventAlertWindow = time.Hour
+
+// EventHistory keeps the latest container events, oldest first.
+type EventHistory struct {
+	Events []app.ContainerEvent
+}
+
+// Add records an event, dropping the oldest ones past MaxEvents.
+func (h *EventHistory) Add(e app.ContainerEvent) {
+	h.Events = append(h.Events, e)
+	if len(h.Events) > MaxEvents {
+		h.Events = append([]app.ContainerEvent(nil), h.Events[len(h.Events)-MaxEvents:]...)
+	}
+}
+
+// Last returns the time of the latest event, zero without events.
+func (h EventHistory) Last() time.Time {
+	if len(h.Events) == 0 {
+		return time.Time{}
+	}
+	return h.Events[len(h.Events)-1].Time
+}
+
+// ContainerEvents returns the event history while the events subscription is
+// live, and nil otherwise since events missed while disconnected would make
+// it incomplete.
+func (m MonitorModel) ContainerEvents() []app.ContainerEvent {
+	if m.EventsChan == nil {
+		return nil
+	}
+	if m.Events.Events == nil {
+		return []app.ContainerEvent{}
+	}
+	return m.Events.Events
+}
+
+// EventAlert is a kind of failure that happened to a container within
+// EventAlertWindow.
+type EventAlert struct {
+	Container string
+	Kind      string // "oom", "crash" or "unhealthy"
+	Count     int
+}
+
+// Message phrases the alert, e.g. "web-1 was OOM-killed 3 times in the last
+// hour".
+func (a EventAlert) Message() string {
+	var what string
+	switch a.Kind {
+	case "oom":
+		what = "was OOM-killed"
+	case "crash":
+		what = "exited with an error"
+	default:
+		what = "became unhealthy"
+	}
+	times := "once"
+	if a.Count > 1 {
+		times = fmt.Sprintf("%d times", a.Count)
+	}
+	return fmt.Sprintf("%s %s %s in the last hour", a.Container, what, times)
+}
+
+// EventAlerts counts OOM kills, crashes and health check failures per
+// container since now minus EventAlertWindow, most frequent first.
+func EventAlerts(events []app.ContainerEvent, now time.Time) []EventAlert {
+	since := now.Add(-EventAlertWindow)
+	type key struct{ container, kind string }
+	counts := make(map[key]int)
+	var order []key
+	for _, e := range events {
+		if e.Time.Before(since) {
+			continue
+		}
+		var kind string
+		switch {
+		case e.Action == "oom":
+			kind = "oom"
+		case e.Crashed():
+			kind = "crash"
+		case e.Health == "unhealthy":
+			kind = "unhealthy"
+		default:
+			continue
+		}
+		k := key{e.Name, kind}
+		if counts[k] == 0 {
+			order = append(order, k)
+		}
+		counts[k]++
+	}
+
+	alerts := make([]EventAlert, len(order))
+	for i, k := range order {
+		alerts[i] = EventAlert{Container: k.container, Kind: k.kind, Count: counts[k]}
+	}
+	sort.SliceStable(alerts, func(i, j int) bool { return alerts[i].Count > alerts[j].Count })
+	return alerts
+}
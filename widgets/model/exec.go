@@ -0,0 +1,135 @@
+package model
+
+import (
+	"context"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+)
+
+const (
+	// MaxExecOutputLines bounds the output kept for a command; older lines
+	// are dropped.
+	MaxExecOutputLines = 5000
+	// MaxCommandHistory bounds the commands remembered per container.
+	MaxCommandHistory = 50
+)
+
+// CommandHistory is the list of commands run in one container, oldest first.
+type CommandHistory []string
+
+// Add records a command, unless it repeats the previous one.
+func (h CommandHistory) Add(command string) CommandHistory {
+	if command == "" || (len(h) > 0 && h[len(h)-1] == command) {
+		return h
+	}
+	h = append(h, command)
+	if len(h) > MaxCommandHistory {
+		h = h[len(h)-MaxCommandHistory:]
+	}
+	return h
+}
+
+// ContainerExec is the state of the Run command view. Histories are kept by
+// container name, so they survive the container being recreated.
+type ContainerExec struct {
+	ContainerID string
+	Name        string
+	Input       textinput.Model
+	Viewport    viewport.Model
+	Output      []app.ExecLine
+	Dropped     int // lines dropped from the start of Output
+	Command     string
+	ExecID      string
+	Running     bool
+	Result      *app.ExecResult
+	Error       string
+	Lines       <-chan app.ExecLine
+	Results     <-chan app.ExecResult
+	CancelFunc  context.CancelFunc
+	Histories   map[string]CommandHistory
+	// HistoryPos is the history entry shown in the input, len(history) for
+	// the line being typed.
+	HistoryPos int
+}
+
+// Open shows the view for a container, clearing the output of the previous
+// one.
+func (e *ContainerExec) Open(containerID, name string) {
+	e.Stop()
+	e.ContainerID = containerID
+	e.Name = name
+	e.ClearOutput()
+	e.Command = ""
+	e.Result = nil
+	e.Error = ""
+	e.Input.Reset()
+	e.HistoryPos = len(e.History())
+}
+
+// Start records a command about to run and clears the previous output.
+func (e *ContainerExec) Start(command string) {
+	e.Stop()
+	if e.Histories == nil {
+		e.Histories = make(map[string]CommandHistory)
+	}
+	e.Histories[e.Name] = e.History().Add(command)
+	e.HistoryPos = len(e.History())
+	e.ClearOutput()
+	e.Command = command
+	e.Running = true
+	e.Result = nil
+	e.Error = ""
+	e.Input.Reset()
+}
+
+// Stop stops reading the output of the running command.
+func (e *ContainerExec) Stop() {
+	if e.CancelFunc != nil {
+		e.CancelFunc()
+	}
+	e.CancelFunc = nil
+	e.ExecID = ""
+	e.Lines = nil
+	e.Results = nil
+	e.Running = false
+}
+
+func (e *ContainerExec) ClearOutput() {
+	e.Output = nil
+	e.Dropped = 0
+}
+
+// AppendOutput adds command output, dropping the oldest lines beyond
+// MaxExecOutputLines.
+func (e *ContainerExec) AppendOutput(lines []app.ExecLine) {
+	e.Output = append(e.Output, lines...)
+	if extra := len(e.Output) - MaxExecOutputLines; extra > 0 {
+		e.Output = append([]app.ExecLine(nil), e.Output[extra:]...)
+		e.Dropped += extra
+	}
+}
+
+// History returns the commands run in the current container.
+func (e ContainerExec) History() CommandHistory {
+	return e.Histories[e.Name]
+}
+
+// RecallHistory moves through the history by delta (-1 for older, 1 for
+// newer) and shows the entry in the input. Moving past the newest entry
+// empties the input.
+func (e *ContainerExec) RecallHistory(delta int) {
+	history := e.History()
+	pos := min(max(e.HistoryPos+delta, 0), len(history))
+	if pos == e.HistoryPos {
+		return
+	}
+	e.HistoryPos = pos
+	if pos == len(history) {
+		e.Input.Reset()
+		return
+	}
+	e.Input.SetValue(history[pos])
+	e.Input.CursorEnd()
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"path"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+)
+
+// FilesView is what the Files tab of the container view lists.
+type FilesView int
+
+const (
+	FilesChanges FilesView = iota // paths changed compared to the image
+	FilesBrowse                   // one directory of the container
+)
+
+// ContainerFiles is the state of the Files tab. The table and the
+// destination input are kept when another container is opened.
+type ContainerFiles struct {
+	ContainerID string
+	View        FilesView
+	Changes     []app.FileChange
+	Dir         app.DirListing
+	Loading     bool
+	Error       string
+	Table       table.Model
+	CopyInput   textinput.Model
+	// CopySource is the container path to copy out while the destination
+	// is being asked for, empty otherwise.
+	CopySource string
+}
+
+// Reset clears the files of the previous container.
+func (f *ContainerFiles) Reset(containerID string) {
+	f.ContainerID = containerID
+	f.View = FilesChanges
+	f.Changes = nil
+	f.Dir = app.DirListing{Path: "/"}
+	f.Loading = false
+	f.Error = ""
+	f.CopySource = ""
+	f.CopyInput.Blur()
+	f.Table.SetRows(nil)
+	f.Table.SetCursor(0)
+}
+
+// Selected returns the path under the cursor and whether it is a directory.
+// Changed paths are never reported as directories.
+func (f ContainerFiles) Selected() (string, bool, bool) {
+	i := f.Table.Cursor()
+	switch f.View {
+	case FilesChanges:
+		if i >= 0 && i < len(f.Changes) {
+			return f.Changes[i].Path, false, true
+		}
+	case FilesBrowse:
+		if i >= 0 && i < len(f.Dir.Entries) {
+			return f.Dir.Entries[i].Path, f.Dir.Entries[i].IsDir(), true
+		}
+	}
+	return "", false, false
+}
+
+// SelectedChange returns the change under the cursor in the changes view.
+func (f ContainerFiles) SelectedChange() (app.FileChange, bool) {
+	i := f.Table.Cursor()
+	if f.View != FilesChanges || i < 0 || i >= len(f.Changes) {
+		return app.FileChange{}, false
+	}
+	return f.Changes[i], true
+}
+
+// ParentDir returns the directory containing p, "/" for "/" itself.
+func ParentDir(p string) string {
+	return path.Dir(path.Clean("/" + p))
+}
+
+// ChangeSummary counts filesystem changes by kind.
+type ChangeSummary struct {
+	Added   int
+	Changed int
+	Deleted int
+}
+
+func SummarizeChanges(changes []app.FileChange) ChangeSummary {
+	var summary ChangeSummary
+	for _, c := range changes {
+		switch c.Kind {
+		case "added":
+			summary.Added++
+		case "deleted":
+			summary.Deleted++
+		default:
+			summary.Changed++
+		}
+	}
+	return summary
+}
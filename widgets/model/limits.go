@@ -0,0 +1,175 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// LimitField is one editable setting of the limits form, in display order.
+type LimitField int
+
+const (
+	LimitCPUShares LimitField = iota
+	LimitCPUQuota
+	LimitCPUPeriod
+	LimitMemory
+	LimitMemorySwap
+	LimitPids
+	LimitRestartPolicy
+	limitFieldCount
+)
+
+var limitFieldLabels = [limitFieldCount]string{
+	LimitCPUShares:     "CPU shares",
+	LimitCPUQuota:      "CPU quota (µs)",
+	LimitCPUPeriod:     "CPU period (µs)",
+	LimitMemory:        "Memory",
+	LimitMemorySwap:    "Memory + swap",
+	LimitPids:          "PIDs limit",
+	LimitRestartPolicy: "Restart policy",
+}
+
+func (f LimitField) String() string {
+	return limitFieldLabels[f]
+}
+
+// LimitValues formats limits the way the form edits them, indexed by
+// LimitField.
+func LimitValues(l app.ContainerLimits) []string {
+	values := make([]string, limitFieldCount)
+	values[LimitCPUShares] = strconv.FormatInt(l.CPUShares, 10)
+	values[LimitCPUQuota] = strconv.FormatInt(l.CPUQuota, 10)
+	values[LimitCPUPeriod] = strconv.FormatInt(l.CPUPeriod, 10)
+	values[LimitMemory] = app.FormatMemoryLimit(l.Memory)
+	values[LimitMemorySwap] = app.FormatMemoryLimit(l.MemorySwap)
+	values[LimitPids] = strconv.FormatInt(l.PidsLimit, 10)
+	values[LimitRestartPolicy] = app.FormatRestartPolicy(l.RestartPolicy)
+	return values
+}
+
+// LimitChange is a setting whose value differs between two sets of limits.
+type LimitChange struct {
+	Field  LimitField
+	Before string
+	After  string
+}
+
+// DiffLimits lists the settings that differ between before and after.
+func DiffLimits(before, after app.ContainerLimits) []LimitChange {
+	old, updated := LimitValues(before), LimitValues(after)
+	var changes []LimitChange
+	for i := range old {
+		if old[i] != updated[i] {
+			changes = append(changes, LimitChange{Field: LimitField(i), Before: old[i], After: updated[i]})
+		}
+	}
+	return changes
+}
+
+// ContainerLimitsForm edits the resource limits and restart policy of a
+// container. Current is nil until the limits are loaded.
+type ContainerLimitsForm struct {
+	ContainerID string
+	Name        string
+	Current     *app.ContainerLimits
+	// Before holds the limits replaced by the last update, nil until one
+	// was applied.
+	Before   *app.ContainerLimits
+	Warnings []string
+	Error    string
+	Applying bool
+	Inputs   []textinput.Model
+	Focus    LimitField
+}
+
+func NewContainerLimitsForm(containerID, name string) ContainerLimitsForm {
+	inputs := make([]textinput.Model, limitFieldCount)
+	for i := range inputs {
+		ti := textinput.New()
+		ti.Prompt = ""
+		ti.CharLimit = 32
+		ti.Width = 20
+		inputs[i] = ti
+	}
+	inputs[LimitMemory].Placeholder = "e.g. 512m"
+	inputs[LimitMemorySwap].Placeholder = "-1 for unlimited swap"
+	inputs[LimitRestartPolicy].Placeholder = "no, always, unless-stopped, on-failure[:n]"
+
+	return ContainerLimitsForm{ContainerID: containerID, Name: name, Inputs: inputs}
+}
+
+// SetLimits shows limits as the current values and resets the inputs to them.
+func (f *ContainerLimitsForm) SetLimits(limits app.ContainerLimits) tea.Cmd {
+	f.Current = &limits
+	for i, value := range LimitValues(limits) {
+		f.Inputs[i].SetValue(value)
+	}
+	return f.focus(f.Focus)
+}
+
+// MoveFocus moves the cursor to the next (delta 1) or previous (delta -1)
+// field, wrapping around.
+func (f *ContainerLimitsForm) MoveFocus(delta int) tea.Cmd {
+	next := (int(f.Focus) + delta + int(limitFieldCount)) % int(limitFieldCount)
+	return f.focus(LimitField(next))
+}
+
+func (f *ContainerLimitsForm) focus(field LimitField) tea.Cmd {
+	for i := range f.Inputs {
+		f.Inputs[i].Blur()
+	}
+	f.Focus = field
+	return f.Inputs[field].Focus()
+}
+
+// Update passes a key to the focused input.
+func (f *ContainerLimitsForm) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	f.Inputs[f.Focus], cmd = f.Inputs[f.Focus].Update(msg)
+	return cmd
+}
+
+// Limits parses the inputs into the limits to apply.
+func (f ContainerLimitsForm) Limits() (app.ContainerLimits, error) {
+	if f.Current == nil {
+		return app.ContainerLimits{}, fmt.Errorf("limits are not loaded yet")
+	}
+	limits := *f.Current
+
+	integers := []struct {
+		field LimitField
+		value *int64
+	}{
+		{LimitCPUShares, &limits.CPUShares},
+		{LimitCPUQuota, &limits.CPUQuota},
+		{LimitCPUPeriod, &limits.CPUPeriod},
+		{LimitPids, &limits.PidsLimit},
+	}
+	for _, i := range integers {
+		n, err := strconv.ParseInt(strings.TrimSpace(f.Inputs[i.field].Value()), 10, 64)
+		if err != nil {
+			return app.ContainerLimits{}, fmt.Errorf("%s: not a number", i.field)
+		}
+		*i.value = n
+	}
+
+	var err error
+	if limits.Memory, err = app.ParseMemoryLimit(f.Inputs[LimitMemory].Value()); err != nil {
+		return app.ContainerLimits{}, fmt.Errorf("%s: %w", LimitMemory, err)
+	}
+	if limits.MemorySwap, err = app.ParseMemoryLimit(f.Inputs[LimitMemorySwap].Value()); err != nil {
+		return app.ContainerLimits{}, fmt.Errorf("%s: %w", LimitMemorySwap, err)
+	}
+	if limits.RestartPolicy, err = app.ParseRestartPolicy(f.Inputs[LimitRestartPolicy].Value()); err != nil {
+		return app.ContainerLimits{}, fmt.Errorf("%s: %w", LimitRestartPolicy, err)
+	}
+	if err := limits.ValidateChange(*f.Current); err != nil {
+		return app.ContainerLimits{}, err
+	}
+	return limits, nil
+}
@@ -1,7 +1,12 @@
 package model
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	info "github.com/System-Pulse/server-pulse/system/informations"
 	proc "github.com/System-Pulse/server-pulse/system/process"
@@ -10,6 +15,7 @@ import (
 	"github.com/System-Pulse/server-pulse/system/app"
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 )
 
 type ContainerLogsPagination struct {
@@ -24,8 +30,133 @@ type ProcessSortField int
 const (
 	ProcessSortByCPU ProcessSortField = iota
 	ProcessSortByMem
+	ProcessSortByPID
+	ProcessSortByUser
+	ProcessSortByRSS
+	ProcessSortByStart
+	ProcessSortByState
+	ProcessSortByCommand
 )
 
+// ProcessSortColumns lists the sort fields in process table column order.
+var ProcessSortColumns = []ProcessSortField{
+	ProcessSortByPID,
+	ProcessSortByUser,
+	ProcessSortByCPU,
+	ProcessSortByMem,
+	ProcessSortByRSS,
+	ProcessSortByStart,
+	ProcessSortByState,
+	ProcessSortByCommand,
+}
+
+// String returns the title of the column the field sorts by.
+func (f ProcessSortField) String() string {
+	switch f {
+	case ProcessSortByMem:
+		return "Mem%"
+	case ProcessSortByPID:
+		return "PID"
+	case ProcessSortByUser:
+		return "User"
+	case ProcessSortByRSS:
+		return "RSS"
+	case ProcessSortByStart:
+		return "Start"
+	case ProcessSortByState:
+		return "State"
+	case ProcessSortByCommand:
+		return "Command"
+	default:
+		return "CPU%"
+	}
+}
+
+// Descending reports the natural order of a field: resource usage and start
+// time show the largest or newest first, identifiers and names sort A to Z.
+func (f ProcessSortField) Descending() bool {
+	switch f {
+	case ProcessSortByCPU, ProcessSortByMem, ProcessSortByRSS, ProcessSortByStart:
+		return true
+	default:
+		return false
+	}
+}
+
+// Next returns the field of the column to the right of f, wrapping around.
+func (f ProcessSortField) Next() ProcessSortField {
+	return ProcessSortColumns[(f.column()+1)%len(ProcessSortColumns)]
+}
+
+// Prev returns the field of the column to the left of f, wrapping around.
+func (f ProcessSortField) Prev() ProcessSortField {
+	n := len(ProcessSortColumns)
+	return ProcessSortColumns[(f.column()+n-1)%n]
+}
+
+func (f ProcessSortField) column() int {
+	return max(slices.Index(ProcessSortColumns, f), 0)
+}
+
+// SortProcesses sorts processes in place by field, in the field's natural
+// order or the opposite one when reverse is set. Ties are broken by PID so
+// that rows do not jump around between refreshes.
+func SortProcesses(processes []proc.ProcessInfo, field ProcessSortField, reverse bool) {
+	descending := field.Descending() != reverse
+	sort.SliceStable(processes, func(i, j int) bool {
+		a, b := processes[i], processes[j]
+		c := compareProcesses(a, b, field)
+		if c == 0 {
+			return a.PID < b.PID
+		}
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+func compareProcesses(a, b proc.ProcessInfo, field ProcessSortField) int {
+	switch field {
+	case ProcessSortByMem:
+		return cmp.Compare(a.Mem, b.Mem)
+	case ProcessSortByPID:
+		return cmp.Compare(a.PID, b.PID)
+	case ProcessSortByUser:
+		return strings.Compare(strings.ToLower(a.User), strings.ToLower(b.User))
+	case ProcessSortByRSS:
+		return cmp.Compare(a.RSS, b.RSS)
+	case ProcessSortByStart:
+		return cmp.Compare(a.CreateTime, b.CreateTime)
+	case ProcessSortByState:
+		return strings.Compare(a.State, b.State)
+	case ProcessSortByCommand:
+		return strings.Compare(strings.ToLower(a.Command), strings.ToLower(b.Command))
+	default:
+		return cmp.Compare(a.CPU, b.CPU)
+	}
+}
+
+// ProcessInputMode selects what the Process view prompt is asking for.
+type ProcessInputMode int
+
+const (
+	ProcessInputNone ProcessInputMode = iota
+	ProcessInputNice
+	ProcessInputIOPriority
+)
+
+// ProcessAction is a signal, renice or ionice request waiting for
+// confirmation.
+type ProcessAction struct {
+	PID        int32
+	Command    string
+	Operation  string // "signal", "renice" or "ionice"
+	Signal     proc.SignalOption
+	Nice       int
+	IOPriority proc.IOPriority
+}
+
 type MonitorModel struct {
 	System                  info.SystemInfo
 	Cpu                     resource.CPUInfo
@@ -33,38 +164,74 @@ type MonitorModel struct {
 	Disks                   []resource.DiskInfo
 	Processes               []proc.ProcessInfo
 	ProcessSort             ProcessSortField
+	ProcessSortReverse      bool
+	ProcessTreeMode         bool
+	ProcessGroupBy          ProcessGroupBy
+	ProcessGroupFilter      string // group being drilled into, empty for the group list
+	ProcessesUpdatedAt      time.Time
+	ProcessCollapsed        map[int32]bool // tree nodes whose children are hidden
+	ProcessDetails          *proc.ProcessDetails
+	ProcessSignalMenu       bool
+	ProcessSignalSelected   int
+	ProcessInputMode        ProcessInputMode
+	ProcessInput            textinput.Model
+	ProcessContainerName    string
+	ContainerNames          map[string]string // full container ID to name, for the Process view
+	Images                  []app.Image
+	ImageTable              table.Model
+	Volumes                 []app.Volume
+	VolumeTable             table.Model
+	DockerNetworks          []app.Network
+	DockerNetworkTable      table.Model
+	Events                  EventHistory
+	EventTable              table.Model
+	EventsChan              <-chan app.ContainerEvent
+	EventsErrs              <-chan error
+	EventsCancelFunc        context.CancelFunc
+	EventsError             error     // why the subscription ended, until it is back
+	ContainersUpdatedAt     time.Time // last full refresh of the container list
 	App                     *app.DockerManager
 	PendingShellExec        *ShellExecRequest
 	ShouldQuit              bool
 	ContainerLogsPagination ContainerLogsPagination
 
 	ContainerLogsStreaming bool
-	ContainerLogsChan      chan string
+	ContainerLogsChan      chan app.LogLine
 	LogsCancelFunc         context.CancelFunc
+	LogsTee                *app.LogFile // file the live logs are copied to, nil when not recording
 	StatsCancelFunc        context.CancelFunc
 
-	ContainerLogs        string
-	ContainerLogsLoading bool
-	CpuHistory           DataHistory
-	MemoryHistory        DataHistory
-	NetworkRxHistory     DataHistory
-	NetworkTxHistory     DataHistory
-	DiskReadHistory      DataHistory
-	DiskWriteHistory     DataHistory
-	ContainerHistories   map[string]ContainerHistory // History per container ID
-	ContainerViewState   ContainerViewState
-	ContainerTabs        []string
-	ContainerDetails     *app.ContainerDetails
-	ContainerMenuState   ContainerMenuState
-	SelectedContainer    *app.Container
-	ContainerMenuItems   []ContainerMenuItem
-	SelectedMenuItem     int
-	CpuProgress          progress.Model
-	MemProgress          progress.Model
-	SwapProgress         progress.Model
-	ProcessTable         table.Model
-	Container            table.Model
-	DiskProgress         map[string]progress.Model
+	ContainerLogs           string
+	Logs                    ContainerLogView
+	ContainerLogsLoading    bool
+	CpuHistory              DataHistory
+	MemoryHistory           DataHistory
+	NetworkRxHistory        DataHistory
+	NetworkTxHistory        DataHistory
+	DiskReadHistory         DataHistory
+	DiskWriteHistory        DataHistory
+	ContainerHistories      map[string]ContainerHistory // History per container ID
+	ContainerViewState      ContainerViewState
+	ContainerTabs           []string
+	ContainerDetails        *app.ContainerDetails
+	ContainerMenuState      ContainerMenuState
+	SelectedContainer       *app.Container
+	Containers              []app.Container
+	ContainerStats          map[string]app.ContainerStats // latest sample per container ID, for project totals
+	ContainerGroupByProject bool
+	ContainerProjectFilter  string // project being drilled into, empty for the project list
+	LogsProject             string // project whose merged logs are shown, empty for a single container
+	LimitsForm              ContainerLimitsForm
+	Files                   ContainerFiles
+	Exec                    ContainerExec
+	ContainerMenuItems      []ContainerMenuItem
+	SelectedMenuItem        int
+	CpuProgress             progress.Model
+	MemProgress             progress.Model
+	SwapProgress            progress.Model
+	ProcessTable            table.Model
+	Container               table.Model
+	DiskProgress            map[string]progress.Model
 }
 
 func (p *ContainerLogsPagination) Clear() {
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"cmp"
+	"sort"
+	"strings"
+
+	proc "github.com/System-Pulse/server-pulse/system/process"
+)
+
+// ProcessGroupBy selects how the Process view aggregates processes.
+type ProcessGroupBy int
+
+const (
+	ProcessGroupNone ProcessGroupBy = iota
+	ProcessGroupUser
+	ProcessGroupCommand
+)
+
+func (g ProcessGroupBy) String() string {
+	switch g {
+	case ProcessGroupUser:
+		return "user"
+	case ProcessGroupCommand:
+		return "command"
+	default:
+		return "none"
+	}
+}
+
+// Next cycles through no grouping, by user and by command.
+func (g ProcessGroupBy) Next() ProcessGroupBy {
+	return (g + 1) % 3
+}
+
+// Key returns the group a process belongs to.
+func (g ProcessGroupBy) Key(p proc.ProcessInfo) string {
+	switch g {
+	case ProcessGroupUser:
+		if p.User == "" {
+			return "?"
+		}
+		return p.User
+	case ProcessGroupCommand:
+		return p.Command
+	default:
+		return ""
+	}
+}
+
+// ProcessGroup sums the processes of one user or command.
+type ProcessGroup struct {
+	Name      string
+	Processes int
+	Threads   int
+	CPU       float64
+	Mem       float64
+	RSS       uint64
+	ReadRate  float64
+	WriteRate float64
+}
+
+// GroupProcesses aggregates processes by user or command name.
+func GroupProcesses(processes []proc.ProcessInfo, by ProcessGroupBy) []ProcessGroup {
+	index := make(map[string]int)
+	var groups []ProcessGroup
+	for _, p := range processes {
+		key := by.Key(p)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, ProcessGroup{Name: key})
+		}
+		g := &groups[i]
+		g.Processes++
+		g.Threads += int(p.Threads)
+		g.CPU += p.CPU
+		g.Mem += p.Mem
+		g.RSS += p.RSS
+		g.ReadRate += p.ReadRate
+		g.WriteRate += p.WriteRate
+	}
+	return groups
+}
+
+// SortProcessGroups orders groups like SortProcesses orders processes. PID
+// sorts by process count, user and command by name, and the fields that have
+// no group equivalent (start time, state) fall back to CPU.
+func SortProcessGroups(groups []ProcessGroup, field ProcessSortField, reverse bool) {
+	if field == ProcessSortByStart || field == ProcessSortByState {
+		field = ProcessSortByCPU
+	}
+	descending := field.Descending() != reverse
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i], groups[j]
+		var c int
+		switch field {
+		case ProcessSortByMem:
+			c = cmp.Compare(a.Mem, b.Mem)
+		case ProcessSortByRSS:
+			c = cmp.Compare(a.RSS, b.RSS)
+		case ProcessSortByPID:
+			// More processes first in the natural (ascending PID) order
+			c = cmp.Compare(b.Processes, a.Processes)
+		case ProcessSortByUser, ProcessSortByCommand:
+			c = strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+		default:
+			c = cmp.Compare(a.CPU, b.CPU)
+		}
+		if c == 0 {
+			return a.Name < b.Name
+		}
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+// ContainerProcesses returns the processes running in the container whose
+// ID, full or short, is containerID, busiest first.
+func ContainerProcesses(processes []proc.ProcessInfo, containerID string) []proc.ProcessInfo {
+	if containerID == "" {
+		return nil
+	}
+	var result []proc.ProcessInfo
+	for _, p := range processes {
+		if strings.HasPrefix(p.ContainerID, containerID) {
+			result = append(result, p)
+		}
+	}
+	SortProcesses(result, ProcessSortByCPU, false)
+	return result
+}
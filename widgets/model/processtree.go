@@ -0,0 +1,141 @@
+package model
+
+import (
+	proc "github.com/System-Pulse/server-pulse/system/process"
+)
+
+// ProcessTreeRow is one line of the process tree. Subtree* fields include the
+// process itself and all of its descendants, collapsed or not.
+type ProcessTreeRow struct {
+	Process     proc.ProcessInfo
+	Prefix      string // box-drawing characters placed before the command
+	HasChildren bool
+	Collapsed   bool
+	Descendants int
+	SubtreeCPU  float64
+	SubtreeMem  float64
+	SubtreeRSS  uint64
+}
+
+type processNode struct {
+	process  proc.ProcessInfo
+	children []*processNode
+}
+
+// BuildProcessTree arranges processes by parent PID. Processes whose parent
+// is not in the list are roots. Siblings are ordered like the flat list, and
+// the descendants of PIDs in collapsed are left out.
+func BuildProcessTree(processes []proc.ProcessInfo, field ProcessSortField, reverse bool, collapsed map[int32]bool) []ProcessTreeRow {
+	sorted := make([]proc.ProcessInfo, len(processes))
+	copy(sorted, processes)
+	SortProcesses(sorted, field, reverse)
+
+	nodes := make(map[int32]*processNode, len(sorted))
+	for _, p := range sorted {
+		nodes[p.PID] = &processNode{process: p}
+	}
+
+	var roots []*processNode
+	for _, p := range sorted {
+		node := nodes[p.PID]
+		parent, ok := nodes[p.PPID]
+		if !ok || p.PPID == p.PID || isAncestor(nodes, p.PID, p.PPID) {
+			roots = append(roots, node)
+			continue
+		}
+		parent.children = append(parent.children, node)
+	}
+
+	var rows []ProcessTreeRow
+	var walk func(node *processNode, indent string, root, last bool)
+	walk = func(node *processNode, indent string, root, last bool) {
+		row := ProcessTreeRow{
+			Process:     node.process,
+			HasChildren: len(node.children) > 0,
+			Collapsed:   collapsed[node.process.PID],
+		}
+		row.SubtreeCPU, row.SubtreeMem, row.SubtreeRSS, row.Descendants = aggregate(node)
+
+		childIndent := indent
+		if !root {
+			if last {
+				row.Prefix = indent + "└─ "
+				childIndent = indent + "   "
+			} else {
+				row.Prefix = indent + "├─ "
+				childIndent = indent + "│  "
+			}
+		}
+		rows = append(rows, row)
+
+		if row.Collapsed {
+			return
+		}
+		for i, child := range node.children {
+			walk(child, childIndent, false, i == len(node.children)-1)
+		}
+	}
+	for _, root := range roots {
+		walk(root, "", true, true)
+	}
+	return rows
+}
+
+// isAncestor reports whether pid is an ancestor of candidate, which would
+// make candidate's parent link a cycle. PIDs are reused, so a stale PPID can
+// point back into the process's own subtree.
+func isAncestor(nodes map[int32]*processNode, pid, candidate int32) bool {
+	for steps := 0; steps < len(nodes); steps++ {
+		node, ok := nodes[candidate]
+		if !ok || node.process.PPID == candidate {
+			return false
+		}
+		if node.process.PPID == pid {
+			return true
+		}
+		candidate = node.process.PPID
+	}
+	return true
+}
+
+func aggregate(node *processNode) (cpu, mem float64, rss uint64, descendants int) {
+	cpu, mem, rss = node.process.CPU, node.process.Mem, node.process.RSS
+	for _, child := range node.children {
+		c, m, r, d := aggregate(child)
+		cpu += c
+		mem += m
+		rss += r
+		descendants += d + 1
+	}
+	return cpu, mem, rss, descendants
+}
+
+// SubtreePIDs returns pid followed by all of its descendants, parents before
+// children, so that a supervisor is stopped before it can respawn workers.
+func SubtreePIDs(processes []proc.ProcessInfo, pid int32) []int32 {
+	children := make(map[int32][]int32)
+	found := false
+	for _, p := range processes {
+		if p.PID == pid {
+			found = true
+		}
+		if p.PPID != p.PID {
+			children[p.PPID] = append(children[p.PPID], p.PID)
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	seen := map[int32]bool{pid: true}
+	pids := []int32{pid}
+	for i := 0; i < len(pids); i++ {
+		for _, child := range children[pids[i]] {
+			if !seen[child] {
+				seen[child] = true
+				pids = append(pids, child)
+			}
+		}
+	}
+	return pids
+}
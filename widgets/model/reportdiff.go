@@ -0,0 +1,256 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ReportDiff describes what changed between two report documents.
+type ReportDiff struct {
+	BaselineTime     time.Time
+	CurrentTime      time.Time
+	StatusBefore     ReportStatus
+	StatusAfter      ReportStatus
+	ScoreBefore      *int
+	ScoreAfter       *int
+	NewFindings      []SecurityFinding
+	ResolvedFindings []SecurityFinding
+	StatusChanges    []CheckStatusChange
+	ResourceDeltas   []ResourceDelta
+	Appeared         []ContainerEntry
+	Disappeared      []ContainerEntry
+	StateChanges     []ContainerStateChange
+}
+
+type CheckStatusChange struct {
+	Name   string
+	Before string
+	After  string
+}
+
+// ResourceDelta is a usage percentage observed in both reports.
+type ResourceDelta struct {
+	Name   string
+	Before float64
+	After  float64
+}
+
+func (d ResourceDelta) Change() float64 {
+	return d.After - d.Before
+}
+
+type ContainerStateChange struct {
+	Name   string
+	Before string
+	After  string
+}
+
+// DiffReports compares a baseline report with a later one. Containers are
+// matched by name since IDs change whenever a container is recreated.
+func DiffReports(baseline, current *ReportDocument) ReportDiff {
+	diff := ReportDiff{
+		BaselineTime: baseline.GeneratedAt,
+		CurrentTime:  current.GeneratedAt,
+		StatusBefore: baseline.Summary.Status,
+		StatusAfter:  current.Summary.Status,
+		ScoreBefore:  baseline.Summary.HealthScore,
+		ScoreAfter:   current.Summary.HealthScore,
+	}
+
+	diff.diffSecurity(baseline.Security, current.Security)
+	diff.diffResources(baseline.Resources, current.Resources)
+	diff.diffContainers(baseline.Containers.Containers, current.Containers.Containers)
+
+	return diff
+}
+
+func (diff *ReportDiff) diffSecurity(before, after []SecurityFinding) {
+	previous := make(map[string]SecurityFinding, len(before))
+	for _, finding := range before {
+		previous[finding.Name] = finding
+	}
+	seen := make(map[string]bool, len(after))
+
+	for _, finding := range after {
+		seen[finding.Name] = true
+		old, ok := previous[finding.Name]
+		if isFinding(finding) && (!ok || !isFinding(old)) {
+			diff.NewFindings = append(diff.NewFindings, finding)
+		}
+		if ok && isFinding(old) && finding.Result == CheckPassed {
+			diff.ResolvedFindings = append(diff.ResolvedFindings, old)
+		}
+		if ok && old.Status != finding.Status {
+			diff.StatusChanges = append(diff.StatusChanges, CheckStatusChange{
+				Name:   finding.Name,
+				Before: old.Status,
+				After:  finding.Status,
+			})
+		}
+	}
+
+	// Checks that failed before and no longer run count as resolved
+	for _, finding := range before {
+		if !seen[finding.Name] && isFinding(finding) {
+			diff.ResolvedFindings = append(diff.ResolvedFindings, finding)
+		}
+	}
+}
+
+// isFinding reports whether a check points at a problem. Checks that could not
+// be evaluated are not findings.
+func isFinding(finding SecurityFinding) bool {
+	return finding.Result == CheckWarning || finding.Result == CheckFailed
+}
+
+func (diff *ReportDiff) diffResources(before, after ResourceUsage) {
+	if before.CPU != nil && after.CPU != nil {
+		diff.ResourceDeltas = append(diff.ResourceDeltas, ResourceDelta{"CPU", before.CPU.Usage, after.CPU.Usage})
+	}
+	if before.Memory != nil && after.Memory != nil {
+		diff.ResourceDeltas = append(diff.ResourceDeltas, ResourceDelta{"Memory", before.Memory.Usage, after.Memory.Usage})
+	}
+	if before.Swap != nil && after.Swap != nil {
+		diff.ResourceDeltas = append(diff.ResourceDeltas, ResourceDelta{"Swap", before.Swap.Usage, after.Swap.Usage})
+	}
+
+	disks := make(map[string]DiskUsage, len(before.Disks))
+	for _, disk := range before.Disks {
+		disks[disk.Mountpoint] = disk
+	}
+	for _, disk := range after.Disks {
+		if old, ok := disks[disk.Mountpoint]; ok {
+			diff.ResourceDeltas = append(diff.ResourceDeltas, ResourceDelta{"Disk " + disk.Mountpoint, old.Usage, disk.Usage})
+		}
+	}
+}
+
+func (diff *ReportDiff) diffContainers(before, after []ContainerEntry) {
+	previous := make(map[string]ContainerEntry, len(before))
+	for _, container := range before {
+		previous[container.Name] = container
+	}
+	current := make(map[string]ContainerEntry, len(after))
+	for _, container := range after {
+		current[container.Name] = container
+	}
+
+	for _, container := range after {
+		old, ok := previous[container.Name]
+		if !ok {
+			diff.Appeared = append(diff.Appeared, container)
+			continue
+		}
+		if old.State != container.State {
+			diff.StateChanges = append(diff.StateChanges, ContainerStateChange{
+				Name:   container.Name,
+				Before: old.State,
+				After:  container.State,
+			})
+		}
+	}
+	for _, container := range before {
+		if _, ok := current[container.Name]; !ok {
+			diff.Disappeared = append(diff.Disappeared, container)
+		}
+	}
+
+	sort.Slice(diff.Appeared, func(i, j int) bool { return diff.Appeared[i].Name < diff.Appeared[j].Name })
+	sort.Slice(diff.Disappeared, func(i, j int) bool { return diff.Disappeared[i].Name < diff.Disappeared[j].Name })
+}
+
+// Markdown renders the comparison for the report viewer.
+func (diff ReportDiff) Markdown() string {
+	var out strings.Builder
+
+	out.WriteString("# Report Comparison\n\n")
+	out.WriteString(fmt.Sprintf("**Baseline**: %s\n", diff.BaselineTime.Format("2006-01-02 15:04:05")))
+	out.WriteString(fmt.Sprintf("**Current**: %s\n\n", diff.CurrentTime.Format("2006-01-02 15:04:05")))
+
+	out.WriteString("## Summary\n\n")
+	out.WriteString(fmt.Sprintf("- **Overall Status**: %s → %s\n", diff.StatusBefore, diff.StatusAfter))
+	out.WriteString(fmt.Sprintf("- **System Health Score**: %s\n", formatScoreChange(diff.ScoreBefore, diff.ScoreAfter)))
+	out.WriteString("\n")
+
+	out.WriteString("## Security\n\n")
+	if len(diff.NewFindings) == 0 && len(diff.ResolvedFindings) == 0 && len(diff.StatusChanges) == 0 {
+		out.WriteString("**No security changes**\n")
+	}
+	if len(diff.NewFindings) > 0 {
+		out.WriteString("### New Findings\n")
+		for _, finding := range diff.NewFindings {
+			out.WriteString(fmt.Sprintf("- %s **%s**: %s\n", findingLabel(finding.Result), finding.Name, finding.Details))
+		}
+		out.WriteString("\n")
+	}
+	if len(diff.ResolvedFindings) > 0 {
+		out.WriteString("### Resolved Findings\n")
+		for _, finding := range diff.ResolvedFindings {
+			out.WriteString(fmt.Sprintf("- ✅ **%s**: %s\n", finding.Name, finding.Details))
+		}
+		out.WriteString("\n")
+	}
+	if len(diff.StatusChanges) > 0 {
+		out.WriteString("### Status Changes\n")
+		out.WriteString("| Check | Before | After |\n")
+		out.WriteString("| :---- | :----- | :---- |\n")
+		for _, change := range diff.StatusChanges {
+			out.WriteString(fmt.Sprintf("| %s | %s | %s |\n", change.Name, change.Before, change.After))
+		}
+		out.WriteString("\n")
+	}
+
+	out.WriteString("## Resource Usage\n\n")
+	if len(diff.ResourceDeltas) > 0 {
+		out.WriteString("| Resource | Before | After | Change |\n")
+		out.WriteString("| :------- | :----- | :---- | :----- |\n")
+		for _, delta := range diff.ResourceDeltas {
+			out.WriteString(fmt.Sprintf("| %s | %.1f%% | %.1f%% | %+.1f%% |\n",
+				delta.Name, delta.Before, delta.After, delta.Change()))
+		}
+	} else {
+		out.WriteString("**No comparable resource data**\n")
+	}
+	out.WriteString("\n")
+
+	out.WriteString("## Docker Containers\n\n")
+	if len(diff.Appeared) == 0 && len(diff.Disappeared) == 0 && len(diff.StateChanges) == 0 {
+		out.WriteString("**No container changes**\n")
+	}
+	if len(diff.Appeared) > 0 {
+		out.WriteString("### Appeared\n")
+		for _, container := range diff.Appeared {
+			out.WriteString(fmt.Sprintf("- %s (%s, %s)\n", container.Name, container.Image, container.State))
+		}
+		out.WriteString("\n")
+	}
+	if len(diff.Disappeared) > 0 {
+		out.WriteString("### Disappeared\n")
+		for _, container := range diff.Disappeared {
+			out.WriteString(fmt.Sprintf("- %s (%s)\n", container.Name, container.Image))
+		}
+		out.WriteString("\n")
+	}
+	if len(diff.StateChanges) > 0 {
+		out.WriteString("### State Changes\n")
+		for _, change := range diff.StateChanges {
+			out.WriteString(fmt.Sprintf("- %s: %s → %s\n", change.Name, change.Before, change.After))
+		}
+	}
+
+	return out.String()
+}
+
+func formatScoreChange(before, after *int) string {
+	switch {
+	case before != nil && after != nil:
+		return fmt.Sprintf("%d → %d (%+d)", *before, *after, *after-*before)
+	case after != nil:
+		return fmt.Sprintf("n/a → %d", *after)
+	case before != nil:
+		return fmt.Sprintf("%d → n/a", *before)
+	}
+	return "n/a"
+}
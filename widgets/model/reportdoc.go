@@ -0,0 +1,444 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/performance"
+	"github.com/System-Pulse/server-pulse/system/security"
+)
+
+// ReportFormat selects how a report is serialized on disk.
+type ReportFormat string
+
+const (
+	ReportFormatMarkdown ReportFormat = "md"
+	ReportFormatJSON     ReportFormat = "json"
+)
+
+// ParseReportFormat validates a user supplied format name.
+func ParseReportFormat(s string) (ReportFormat, error) {
+	switch ReportFormat(strings.ToLower(s)) {
+	case ReportFormatMarkdown, "markdown":
+		return ReportFormatMarkdown, nil
+	case ReportFormatJSON:
+		return ReportFormatJSON, nil
+	}
+	return "", fmt.Errorf("unknown report format %q (expected md or json)", s)
+}
+
+// Security check outcomes as classified in the report.
+const (
+	CheckPassed  = security.CheckPassed
+	CheckWarning = security.CheckWarning
+	CheckFailed  = security.CheckFailed
+	CheckUnknown = security.CheckUnknown
+)
+
+// ReportDocument is the typed form of a system health report. It is rendered
+// as Markdown for the Reporting view and serialized as-is to JSON.
+type ReportDocument struct {
+	GeneratedAt     time.Time            `json:"generated_at"`
+	Summary         ExecutiveSummary     `json:"summary"`
+	System          SystemIdentification `json:"system"`
+	Resources       ResourceUsage        `json:"resources"`
+	Security        []SecurityFinding    `json:"security"`
+	Containers      ContainerSummary     `json:"containers"`
+	Performance     PerformanceInsights  `json:"performance"`
+	Recommendations Recommendations      `json:"recommendations"`
+}
+
+type ExecutiveSummary struct {
+	Status         ReportStatus `json:"status"`
+	HealthScore    *int         `json:"health_score,omitempty"`
+	SecurityPassed int          `json:"security_passed"`
+	SecurityTotal  int          `json:"security_total"`
+	CriticalIssues []string     `json:"critical_issues"`
+}
+
+type SystemIdentification struct {
+	Hostname string `json:"hostname"`
+	OS       string `json:"os"`
+	Kernel   string `json:"kernel"`
+	Uptime   uint64 `json:"uptime_seconds"`
+}
+
+type ResourceUsage struct {
+	CPU    *CPUUsage    `json:"cpu,omitempty"`
+	Memory *MemoryUsage `json:"memory,omitempty"`
+	Swap   *MemoryUsage `json:"swap,omitempty"`
+	Disks  []DiskUsage  `json:"disks"`
+}
+
+type CPUUsage struct {
+	Usage     float64 `json:"usage_percent"`
+	LoadAvg1  float64 `json:"load_avg_1"`
+	LoadAvg5  float64 `json:"load_avg_5"`
+	LoadAvg15 float64 `json:"load_avg_15"`
+}
+
+type MemoryUsage struct {
+	Usage float64 `json:"usage_percent"`
+	Total uint64  `json:"total_bytes"`
+	Used  uint64  `json:"used_bytes"`
+	Free  uint64  `json:"free_bytes"`
+}
+
+type DiskUsage struct {
+	Mountpoint string  `json:"mountpoint"`
+	Usage      float64 `json:"usage_percent"`
+	Total      uint64  `json:"total_bytes"`
+	Used       uint64  `json:"used_bytes"`
+	// Inodes* are zero for filesystems without a fixed inode table.
+	InodesTotal uint64  `json:"inodes_total"`
+	InodesUsed  uint64  `json:"inodes_used"`
+	InodesFree  uint64  `json:"inodes_free"`
+	InodesUsage float64 `json:"inodes_usage_percent"`
+}
+
+// SecurityFinding keeps the raw check status alongside its classification
+// (CheckPassed, CheckWarning, CheckFailed or CheckUnknown).
+type SecurityFinding struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Result  string `json:"result"`
+	Details string `json:"details"`
+}
+
+type ContainerSummary struct {
+	Available bool     `json:"available"`
+	Total     int      `json:"total"`
+	Running   int      `json:"running"`
+	Stopped   int      `json:"stopped"`
+	Paused    int      `json:"paused"`
+	Unhealthy []string `json:"unhealthy"`
+	// RestartLooping lists containers the restart policy keeps restarting.
+	RestartLooping []string         `json:"restart_looping"`
+	Containers     []ContainerEntry `json:"containers"`
+}
+
+type ContainerEntry struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Image        string `json:"image"`
+	State        string `json:"state"`
+	Health       string `json:"health"`
+	RestartCount int    `json:"restart_count"`
+	ExitCode     int    `json:"exit_code"`
+	OOMKilled    bool   `json:"oom_killed"`
+}
+
+type PerformanceInsights struct {
+	TopCPU        []ProcessEntry             `json:"top_cpu"`
+	TopMemory     []ProcessEntry             `json:"top_memory"`
+	HealthMetrics *performance.HealthMetrics `json:"health_metrics,omitempty"`
+}
+
+type ProcessEntry struct {
+	PID     int32   `json:"pid"`
+	Command string  `json:"command"`
+	CPU     float64 `json:"cpu_percent"`
+	Mem     float64 `json:"mem_percent"`
+}
+
+type Recommendations struct {
+	Security    []string `json:"security"`
+	Performance []string `json:"performance"`
+	Docker      []string `json:"docker"`
+}
+
+// MarshalText stores the status as a plain keyword so JSON consumers do not
+// have to match on the emoji labels used in the UI.
+func (s ReportStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case StatusHealthy:
+		return []byte("healthy"), nil
+	case StatusWarning:
+		return []byte("warning"), nil
+	case StatusCritical:
+		return []byte("critical"), nil
+	}
+	return []byte(s), nil
+}
+
+func (s *ReportStatus) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "healthy":
+		*s = StatusHealthy
+	case "warning":
+		*s = StatusWarning
+	case "critical":
+		*s = StatusCritical
+	default:
+		*s = ReportStatus(text)
+	}
+	return nil
+}
+
+// JSON serializes the document with indentation.
+func (d *ReportDocument) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// ParseReportDocument decodes a report previously written by JSON.
+func ParseReportDocument(data []byte) (*ReportDocument, error) {
+	var doc ReportDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse report: %w", err)
+	}
+	return &doc, nil
+}
+
+// Markdown renders the document in the layout of the Reporting view.
+func (d *ReportDocument) Markdown() string {
+	sections := []string{
+		d.markdownExecutiveSummary(),
+		d.markdownSystemIdentification(),
+		d.markdownResourceUsage(),
+		d.markdownSecurityStatus(),
+		d.markdownContainerStatus(),
+		d.markdownPerformanceInsights(),
+		d.markdownRecommendations(),
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+func (d *ReportDocument) markdownExecutiveSummary() string {
+	var summary strings.Builder
+
+	summary.WriteString("# System Health Report - Executive Summary\n\n")
+	summary.WriteString(fmt.Sprintf("**Report Date/Time**: %s\n", d.GeneratedAt.Format("2006-01-02 15:04:05")))
+	summary.WriteString(fmt.Sprintf("**Overall Status**: %s\n", d.Summary.Status))
+
+	if d.Summary.HealthScore != nil {
+		summary.WriteString(fmt.Sprintf("**System Health Score**: %d/100\n", *d.Summary.HealthScore))
+	}
+
+	summary.WriteString(fmt.Sprintf("**Security Score**: %d/%d checks passed\n", d.Summary.SecurityPassed, d.Summary.SecurityTotal))
+
+	if len(d.Summary.CriticalIssues) > 0 {
+		summary.WriteString("\n**Critical Issues**:\n")
+		for _, point := range d.Summary.CriticalIssues {
+			summary.WriteString(fmt.Sprintf("- %s\n", point))
+		}
+	} else {
+		summary.WriteString("\n**No critical issues detected**\n")
+	}
+
+	return summary.String()
+}
+
+func (d *ReportDocument) markdownSystemIdentification() string {
+	var system strings.Builder
+
+	system.WriteString("## System Identification\n\n")
+
+	if d.System.Hostname != "" {
+		system.WriteString(fmt.Sprintf("**Hostname**: %s\n", d.System.Hostname))
+	}
+	if d.System.OS != "" {
+		system.WriteString(fmt.Sprintf("**Operating System**: %s\n", d.System.OS))
+	}
+	if d.System.Kernel != "" {
+		system.WriteString(fmt.Sprintf("**Kernel**: %s\n", d.System.Kernel))
+	}
+	if d.System.Uptime > 0 {
+		system.WriteString(fmt.Sprintf("**Uptime**: %s\n", formatUptime(d.System.Uptime)))
+	}
+
+	return system.String()
+}
+
+func (d *ReportDocument) markdownResourceUsage() string {
+	var resources strings.Builder
+
+	resources.WriteString("## Resource Usage\n\n")
+
+	if cpu := d.Resources.CPU; cpu != nil {
+		resources.WriteString("### CPU\n")
+		resources.WriteString(fmt.Sprintf("- **Average Usage**: %.1f%%\n", cpu.Usage))
+		resources.WriteString(fmt.Sprintf("- **Load Average**: %.2f (1m), %.2f (5m), %.2f (15m)\n",
+			cpu.LoadAvg1, cpu.LoadAvg5, cpu.LoadAvg15))
+		resources.WriteString("\n")
+	}
+
+	if memory := d.Resources.Memory; memory != nil {
+		resources.WriteString("### Memory (RAM)\n")
+		resources.WriteString(fmt.Sprintf("- **Usage**: %.1f%%\n", memory.Usage))
+		resources.WriteString(fmt.Sprintf("- **Details**: %s / %s / %s (Used/Total/Free)\n",
+			formatBytes(memory.Used), formatBytes(memory.Total), formatBytes(memory.Free)))
+		resources.WriteString("\n")
+	}
+
+	if swap := d.Resources.Swap; swap != nil {
+		resources.WriteString("### Swap\n")
+		resources.WriteString(fmt.Sprintf("- **Usage**: %.1f%%\n", swap.Usage))
+		resources.WriteString(fmt.Sprintf("- **Details**: %s / %s / %s (Used/Total/Free)\n",
+			formatBytes(swap.Used), formatBytes(swap.Total), formatBytes(swap.Free)))
+		resources.WriteString("\n")
+	}
+
+	if len(d.Resources.Disks) > 0 {
+		resources.WriteString("### Disk Usage\n")
+		resources.WriteString("| Mount Point | Usage (%) | Total | Used | Inodes (%) | Inodes Used |\n")
+		resources.WriteString("| :---------- | :-------- | :---- | :--- | :--------- | :---------- |\n")
+		for _, disk := range d.Resources.Disks {
+			inodeUsage, inodesUsed := "n/a", "n/a"
+			if disk.InodesTotal > 0 {
+				inodeUsage = fmt.Sprintf("%.1f%%", disk.InodesUsage)
+				inodesUsed = fmt.Sprintf("%s / %s", formatNumber(disk.InodesUsed), formatNumber(disk.InodesTotal))
+			}
+			resources.WriteString(fmt.Sprintf("| %s | %.1f%% | %s | %s | %s | %s |\n",
+				disk.Mountpoint, disk.Usage, formatBytes(disk.Total), formatBytes(disk.Used), inodeUsage, inodesUsed))
+		}
+	}
+
+	return resources.String()
+}
+
+func (d *ReportDocument) markdownSecurityStatus() string {
+	var security strings.Builder
+
+	security.WriteString("## Security Status\n\n")
+	security.WriteString("| Check | Status | Details |\n")
+	security.WriteString("| :---- | :----- | :------ |\n")
+
+	for _, finding := range d.Security {
+		security.WriteString(fmt.Sprintf("| %s | %s | %s |\n",
+			finding.Name, findingLabel(finding.Result), finding.Details))
+	}
+
+	return security.String()
+}
+
+func (d *ReportDocument) markdownContainerStatus() string {
+	var containers strings.Builder
+
+	containers.WriteString("## Docker Container Status\n\n")
+
+	if !d.Containers.Available {
+		containers.WriteString("**Docker not available**\n")
+		return containers.String()
+	}
+
+	containers.WriteString(fmt.Sprintf("**Total Containers**: %d\n", d.Containers.Total))
+	containers.WriteString(fmt.Sprintf("- **Running**: %d\n", d.Containers.Running))
+	containers.WriteString(fmt.Sprintf("- **Stopped**: %d\n", d.Containers.Stopped))
+	containers.WriteString(fmt.Sprintf("- **Paused**: %d\n", d.Containers.Paused))
+
+	if len(d.Containers.Unhealthy) > 0 {
+		containers.WriteString("\n**Unhealthy Containers**:\n")
+		for _, name := range d.Containers.Unhealthy {
+			containers.WriteString(fmt.Sprintf("- %s\n", name))
+		}
+	} else {
+		containers.WriteString("\n**No unhealthy containers detected**\n")
+	}
+
+	if len(d.Containers.RestartLooping) > 0 {
+		containers.WriteString("\n**Restart-Looping Containers**:\n")
+		for _, name := range d.Containers.RestartLooping {
+			containers.WriteString(fmt.Sprintf("- %s\n", name))
+		}
+	}
+
+	var oomKilled []string
+	for _, c := range d.Containers.Containers {
+		if c.OOMKilled {
+			oomKilled = append(oomKilled, fmt.Sprintf("- %s (exit code %d)\n", c.Name, c.ExitCode))
+		}
+	}
+	if len(oomKilled) > 0 {
+		containers.WriteString("\n**OOM-Killed Containers**:\n")
+		containers.WriteString(strings.Join(oomKilled, ""))
+	}
+
+	return containers.String()
+}
+
+func (d *ReportDocument) markdownPerformanceInsights() string {
+	var section strings.Builder
+
+	section.WriteString("## Performance Insights\n\n")
+
+	if len(d.Performance.TopCPU) > 0 {
+		section.WriteString("### Top Processes by CPU Usage\n")
+		for _, p := range d.Performance.TopCPU {
+			section.WriteString(fmt.Sprintf("- **%s** (PID: %d): %.1f%% CPU\n",
+				p.Command, p.PID, p.CPU))
+		}
+		section.WriteString("\n")
+	}
+
+	if len(d.Performance.TopMemory) > 0 {
+		section.WriteString("### Top Processes by Memory Usage\n")
+		for _, p := range d.Performance.TopMemory {
+			section.WriteString(fmt.Sprintf("- **%s** (PID: %d): %.1f%% Memory\n",
+				p.Command, p.PID, p.Mem))
+		}
+		section.WriteString("\n")
+	}
+
+	if metrics := d.Performance.HealthMetrics; metrics != nil {
+		section.WriteString("### System Health Metrics\n")
+		section.WriteString(fmt.Sprintf("- **I/O Wait**: %.2f%%\n", metrics.IOWait))
+		section.WriteString(fmt.Sprintf("- **Steal Time**: %.2f%%\n", metrics.StealTime))
+		section.WriteString(fmt.Sprintf("- **Major Page Faults**: %s\n", formatNumber(metrics.MajorFaults)))
+		section.WriteString(fmt.Sprintf("- **Context Switches**: %s\n", formatNumber(metrics.ContextSwitches)))
+	}
+
+	return section.String()
+}
+
+func (d *ReportDocument) markdownRecommendations() string {
+	var recommendations strings.Builder
+
+	recommendations.WriteString("## Recommendations\n\n")
+
+	groups := []struct {
+		title string
+		items []string
+	}{
+		{"Security", d.Recommendations.Security},
+		{"Performance", d.Recommendations.Performance},
+		{"Docker", d.Recommendations.Docker},
+	}
+
+	empty := true
+	for _, group := range groups {
+		if len(group.items) == 0 {
+			continue
+		}
+		empty = false
+		recommendations.WriteString(fmt.Sprintf("### %s\n", group.title))
+		for _, rec := range group.items {
+			recommendations.WriteString(fmt.Sprintf("- %s\n", rec))
+		}
+		recommendations.WriteString("\n")
+	}
+
+	if empty {
+		recommendations.WriteString("**No specific recommendations at this time.**\n")
+	}
+
+	return recommendations.String()
+}
+
+func findingLabel(result string) string {
+	switch result {
+	case CheckPassed:
+		return "✅ Passed"
+	case CheckWarning:
+		return "⚠️ Warning"
+	case CheckUnknown:
+		return "❔ Unknown"
+	default:
+		return "❌ Failed"
+	}
+}
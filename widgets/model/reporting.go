@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/System-Pulse/server-pulse/system/app"
+	proc "github.com/System-Pulse/server-pulse/system/process"
 	"github.com/System-Pulse/server-pulse/system/security"
 )
 
@@ -24,11 +26,13 @@ type ReturnToMenuMsg struct{}
 type ClearSaveNotificationMsg struct{}
 
 type ReportGenerationMsg struct {
-	Report string
+	Report   string
+	Document *ReportDocument
 }
 
 type ReportModel struct {
 	CurrentReport        string
+	CurrentDocument      *ReportDocument
 	SavedReports         []string
 	SelectedReport       int
 	IsGenerating         bool
@@ -38,15 +42,15 @@ type ReportModel struct {
 	ReportDirectory      string
 	SaveNotification     string
 	SaveNotificationTime time.Time
+	CompareSelection     []string
 }
 
 func NewReportModel() ReportModel {
 	homeDir, _ := os.UserHomeDir()
+	// The directory may be replaced by the configured one; it is created
+	// when the first report is saved.
 	reportDir := filepath.Join(homeDir, ".server-pulse", "reports")
 
-	// Ensure report directory exists
-	os.MkdirAll(reportDir, 0755)
-
 	return ReportModel{
 		ReportDirectory:      reportDir,
 		SavedReports:         []string{},
@@ -55,321 +59,228 @@ func NewReportModel() ReportModel {
 	}
 }
 
+// GenerateReport builds a report document from the current monitor and
+// diagnostic state and returns its Markdown rendering.
 func (rm *ReportModel) GenerateReport(m MonitorModel, diagnostic DiagnosticModel, securityChecks []security.SecurityCheck) string {
-	var report strings.Builder
-
-	// 1. Executive Summary
-	report.WriteString(rm.generateExecutiveSummary(m, diagnostic, securityChecks))
-	report.WriteString("\n\n")
-
-	// 2. System Identification
-	report.WriteString(rm.generateSystemIdentification(m))
-	report.WriteString("\n\n")
-
-	// 3. Resource Usage
-	report.WriteString(rm.generateResourceUsage(m))
-	report.WriteString("\n\n")
-
-	// 4. Security Status
-	report.WriteString(rm.generateSecurityStatus(securityChecks))
-	report.WriteString("\n\n")
-
-	// 5. Docker Container Status
-	report.WriteString(rm.generateContainerStatus(m))
-	report.WriteString("\n\n")
-
-	// 6. Performance Insights
-	report.WriteString(rm.generatePerformanceInsights(m, diagnostic))
-	report.WriteString("\n\n")
-
-	// 7. Recommendations
-	report.WriteString(rm.generateRecommendations(m, securityChecks))
-
-	rm.CurrentReport = report.String()
-	rm.LastGenerated = time.Now()
+	rm.CurrentDocument = rm.BuildReport(m, diagnostic, securityChecks)
+	rm.CurrentReport = rm.CurrentDocument.Markdown()
+	rm.LastGenerated = rm.CurrentDocument.GeneratedAt
 
 	return rm.CurrentReport
 }
 
-func (rm *ReportModel) generateExecutiveSummary(m MonitorModel, diagnostic DiagnosticModel, securityChecks []security.SecurityCheck) string {
-	var summary strings.Builder
-
-	summary.WriteString("# System Health Report - Executive Summary\n\n")
-	summary.WriteString(fmt.Sprintf("**Report Date/Time**: %s\n", time.Now().Format("2006-01-02 15:04:05")))
-
-	// Overall Status
-	status := rm.calculateOverallStatus(m, diagnostic, securityChecks)
-	summary.WriteString(fmt.Sprintf("**Overall Status**: %s\n", status))
-
-	// System Health Score
-	if diagnostic.Performance.HealthScore != nil {
-		summary.WriteString(fmt.Sprintf("**System Health Score**: %d/100\n", diagnostic.Performance.HealthScore.Score))
-	}
-
-	// Security Score
-	securityScore := rm.calculateSecurityScore(securityChecks)
-	summary.WriteString(fmt.Sprintf("**Security Score**: %d/%d checks passed\n", securityScore, len(securityChecks)))
-
-	// Critical Points
-	criticalPoints := rm.getCriticalPoints(m, securityChecks)
-	if len(criticalPoints) > 0 {
-		summary.WriteString("\n**Critical Issues**:\n")
-		for _, point := range criticalPoints {
-			summary.WriteString(fmt.Sprintf("- %s\n", point))
-		}
-	} else {
-		summary.WriteString("\n**No critical issues detected**\n")
+// BuildReport assembles the typed report document without touching the
+// model's current report.
+func (rm *ReportModel) BuildReport(m MonitorModel, diagnostic DiagnosticModel, securityChecks []security.SecurityCheck) *ReportDocument {
+	var containers []app.Container
+	if m.App != nil {
+		containers, _ = m.App.RefreshContainers()
 	}
-
-	return summary.String()
+	return rm.BuildReportWithContainers(m, diagnostic, securityChecks, containers)
 }
 
-func (rm *ReportModel) generateSystemIdentification(m MonitorModel) string {
-	var system strings.Builder
-
-	system.WriteString("## System Identification\n\n")
-
-	if m.System.Hostname != "" {
-		system.WriteString(fmt.Sprintf("**Hostname**: %s\n", m.System.Hostname))
-	}
-	if m.System.OS != "" {
-		system.WriteString(fmt.Sprintf("**Operating System**: %s\n", m.System.OS))
-	}
-	if m.System.Kernel != "" {
-		system.WriteString(fmt.Sprintf("**Kernel**: %s\n", m.System.Kernel))
+// BuildReportWithContainers is BuildReport with an already fetched container
+// list.
+func (rm *ReportModel) BuildReportWithContainers(m MonitorModel, diagnostic DiagnosticModel, securityChecks []security.SecurityCheck, containers []app.Container) *ReportDocument {
+	now := time.Now()
+	doc := &ReportDocument{
+		GeneratedAt: now,
+		Summary: ExecutiveSummary{
+			Status:         rm.calculateOverallStatus(m, diagnostic, securityChecks, containers, now),
+			SecurityPassed: rm.calculateSecurityScore(securityChecks),
+			SecurityTotal:  len(securityChecks),
+			CriticalIssues: rm.getCriticalPoints(m, securityChecks, containers, now),
+		},
+		System: SystemIdentification{
+			Hostname: m.System.Hostname,
+			OS:       m.System.OS,
+			Kernel:   m.System.Kernel,
+			Uptime:   m.System.Uptime,
+		},
+		Resources:   rm.buildResourceUsage(m),
+		Security:    rm.buildSecurityFindings(securityChecks),
+		Containers:  rm.buildContainerSummary(m.App != nil, containers, m.ContainerEvents(), now),
+		Performance: rm.buildPerformanceInsights(m, diagnostic),
+		Recommendations: Recommendations{
+			Security:    rm.getSecurityRecommendations(securityChecks),
+			Performance: rm.getPerformanceRecommendations(m),
+			Docker:      rm.getDockerRecommendations(containers),
+		},
 	}
-	if m.System.Uptime > 0 {
-		uptime := formatUptime(m.System.Uptime)
-		system.WriteString(fmt.Sprintf("**Uptime**: %s\n", uptime))
+
+	if diagnostic.Performance.HealthScore != nil {
+		score := diagnostic.Performance.HealthScore.Score
+		doc.Summary.HealthScore = &score
 	}
 
-	return system.String()
+	return doc
 }
 
-func (rm *ReportModel) generateResourceUsage(m MonitorModel) string {
-	var resources strings.Builder
+func (rm *ReportModel) buildResourceUsage(m MonitorModel) ResourceUsage {
+	var resources ResourceUsage
 
-	resources.WriteString("## Resource Usage\n\n")
-
-	// CPU
 	if m.Cpu.Usage > 0 {
-		resources.WriteString("### CPU\n")
-		resources.WriteString(fmt.Sprintf("- **Average Usage**: %.1f%%\n", m.Cpu.Usage))
-		resources.WriteString(fmt.Sprintf("- **Load Average**: %.2f (1m), %.2f (5m), %.2f (15m)\n",
-			m.Cpu.LoadAvg1, m.Cpu.LoadAvg5, m.Cpu.LoadAvg15))
-		resources.WriteString("\n")
+		resources.CPU = &CPUUsage{
+			Usage:     m.Cpu.Usage,
+			LoadAvg1:  m.Cpu.LoadAvg1,
+			LoadAvg5:  m.Cpu.LoadAvg5,
+			LoadAvg15: m.Cpu.LoadAvg15,
+		}
 	}
 
-	// Memory
 	if m.Memory.Total > 0 {
-		resources.WriteString("### Memory (RAM)\n")
-		resources.WriteString(fmt.Sprintf("- **Usage**: %.1f%%\n", m.Memory.Usage))
-		resources.WriteString(fmt.Sprintf("- **Details**: %s / %s / %s (Used/Total/Free)\n",
-			formatBytes(m.Memory.Used), formatBytes(m.Memory.Total), formatBytes(m.Memory.Free)))
-		resources.WriteString("\n")
+		resources.Memory = &MemoryUsage{
+			Usage: m.Memory.Usage,
+			Total: m.Memory.Total,
+			Used:  m.Memory.Used,
+			Free:  m.Memory.Free,
+		}
 	}
 
-	// Swap
 	if m.Memory.SwapTotal > 0 {
-		resources.WriteString("### Swap\n")
-		resources.WriteString(fmt.Sprintf("- **Usage**: %.1f%%\n", m.Memory.SwapUsage))
-		resources.WriteString(fmt.Sprintf("- **Details**: %s / %s / %s (Used/Total/Free)\n",
-			formatBytes(m.Memory.SwapUsed), formatBytes(m.Memory.SwapTotal), formatBytes(m.Memory.SwapFree)))
-		resources.WriteString("\n")
-	}
-
-	// Disks
-	if len(m.Disks) > 0 {
-		resources.WriteString("### Disk Usage\n")
-		resources.WriteString("| Mount Point | Usage (%) | Total | Used |\n")
-		resources.WriteString("| :---------- | :-------- | :---- | :--- |\n")
-		for _, disk := range m.Disks {
-			resources.WriteString(fmt.Sprintf("| %s | %.1f%% | %s | %s |\n",
-				disk.Mountpoint, disk.Usage, formatBytes(disk.Total), formatBytes(disk.Used)))
+		resources.Swap = &MemoryUsage{
+			Usage: m.Memory.SwapUsage,
+			Total: m.Memory.SwapTotal,
+			Used:  m.Memory.SwapUsed,
+			Free:  m.Memory.SwapFree,
 		}
 	}
 
-	return resources.String()
-}
+	resources.Disks = []DiskUsage{}
+	for _, disk := range m.Disks {
+		resources.Disks = append(resources.Disks, DiskUsage{
+			Mountpoint:  disk.Mountpoint,
+			Usage:       disk.Usage,
+			Total:       disk.Total,
+			Used:        disk.Used,
+			InodesTotal: disk.InodesTotal,
+			InodesUsed:  disk.InodesUsed,
+			InodesFree:  disk.InodesFree,
+			InodesUsage: disk.InodesUsage,
+		})
+	}
 
-func (rm *ReportModel) generateSecurityStatus(securityChecks []security.SecurityCheck) string {
-	var security strings.Builder
+	return resources
+}
 
-	security.WriteString("## Security Status\n\n")
-	security.WriteString("| Check | Status | Details |\n")
-	security.WriteString("| :---- | :----- | :------ |\n")
+func (rm *ReportModel) buildSecurityFindings(securityChecks []security.SecurityCheck) []SecurityFinding {
+	findings := []SecurityFinding{}
 
 	for _, check := range securityChecks {
-		status := "❌ Failed"
-		if strings.Contains(check.Status, "OK") || strings.Contains(check.Status, "Valide") {
-			status = "✅ Passed"
-		} else if strings.Contains(check.Status, "Warning") {
-			status = "⚠️ Warning"
-		}
-
-		security.WriteString(fmt.Sprintf("| %s | %s | %s |\n",
-			check.Name, status, check.Details))
+		findings = append(findings, SecurityFinding{
+			Name:    check.Name,
+			Status:  check.Status,
+			Result:  check.Result(),
+			Details: check.Details,
+		})
 	}
 
-	return security.String()
+	return findings
 }
 
-func (rm *ReportModel) generateContainerStatus(m MonitorModel) string {
-	var containers strings.Builder
-
-	containers.WriteString("## Docker Container Status\n\n")
-
-	if m.App != nil {
-		containerList, _ := m.App.RefreshContainers()
-		totalContainers := len(containerList)
-
-		containers.WriteString(fmt.Sprintf("**Total Containers**: %d\n", totalContainers))
-
-		// Count by status
-		running := 0
-		stopped := 0
-		paused := 0
-		unhealthy := []string{}
-
-		for _, container := range containerList {
-			switch container.State {
-			case "running":
-				running++
-			case "exited", "stopped":
-				stopped++
-			case "paused":
-				paused++
-			}
-
-			if container.Health == "unhealthy" {
-				unhealthy = append(unhealthy, container.Name)
-			}
+func (rm *ReportModel) buildContainerSummary(available bool, containerList []app.Container, events []app.ContainerEvent, now time.Time) ContainerSummary {
+	summary := ContainerSummary{
+		Available:      available,
+		Total:          len(containerList),
+		Unhealthy:      []string{},
+		RestartLooping: []string{},
+		Containers:     []ContainerEntry{},
+	}
+
+	for _, container := range containerList {
+		switch container.State {
+		case "running":
+			summary.Running++
+		case "exited", "stopped":
+			summary.Stopped++
+		case "paused":
+			summary.Paused++
 		}
 
-		containers.WriteString(fmt.Sprintf("- **Running**: %d\n", running))
-		containers.WriteString(fmt.Sprintf("- **Stopped**: %d\n", stopped))
-		containers.WriteString(fmt.Sprintf("- **Paused**: %d\n", paused))
-
-		if len(unhealthy) > 0 {
-			containers.WriteString("\n**Unhealthy Containers**:\n")
-			for _, name := range unhealthy {
-				containers.WriteString(fmt.Sprintf("- %s\n", name))
-			}
-		} else {
-			containers.WriteString("\n**No unhealthy containers detected**\n")
+		if container.Health == "unhealthy" {
+			summary.Unhealthy = append(summary.Unhealthy, container.Name)
 		}
-	} else {
-		containers.WriteString("**Docker not available**\n")
-	}
-
-	return containers.String()
-}
-
-func (rm *ReportModel) generatePerformanceInsights(m MonitorModel, diagnostic DiagnosticModel) string {
-	var performance strings.Builder
-
-	performance.WriteString("## Performance Insights\n\n")
-
-	// Top processes by CPU
-	if len(m.Processes) > 0 {
-		performance.WriteString("### Top Processes by CPU Usage\n")
-		sort.Slice(m.Processes, func(i, j int) bool {
-			return m.Processes[i].CPU > m.Processes[j].CPU
-		})
-
-		for i := 0; i < len(m.Processes) && i < 5; i++ {
-			p := m.Processes[i]
-			performance.WriteString(fmt.Sprintf("- **%s** (PID: %d): %.1f%% CPU\n",
-				p.Command, p.PID, p.CPU))
+		if container.RestartLooping(now, events) {
+			summary.RestartLooping = append(summary.RestartLooping, container.Name)
 		}
-		performance.WriteString("\n")
-	}
 
-	// Top processes by Memory
-	if len(m.Processes) > 0 {
-		performance.WriteString("### Top Processes by Memory Usage\n")
-		sort.Slice(m.Processes, func(i, j int) bool {
-			return m.Processes[i].Mem > m.Processes[j].Mem
+		summary.Containers = append(summary.Containers, ContainerEntry{
+			ID:           container.ID,
+			Name:         container.Name,
+			Image:        container.Image,
+			State:        container.State,
+			Health:       container.Health,
+			RestartCount: container.RestartCount,
+			ExitCode:     container.ExitCode,
+			OOMKilled:    container.OOMKilled,
 		})
-
-		for i := 0; i < len(m.Processes) && i < 5; i++ {
-			p := m.Processes[i]
-			performance.WriteString(fmt.Sprintf("- **%s** (PID: %d): %.1f%% Memory\n",
-				p.Command, p.PID, p.Mem))
-		}
-		performance.WriteString("\n")
 	}
 
-	// System health metrics
-	if diagnostic.Performance.HealthMetrics != nil {
-		performance.WriteString("### System Health Metrics\n")
-		metrics := diagnostic.Performance.HealthMetrics
-
-		performance.WriteString(fmt.Sprintf("- **I/O Wait**: %.2f%%\n", metrics.IOWait))
-		performance.WriteString(fmt.Sprintf("- **Steal Time**: %.2f%%\n", metrics.StealTime))
-		performance.WriteString(fmt.Sprintf("- **Major Page Faults**: %s\n", formatNumber(metrics.MajorFaults)))
-		performance.WriteString(fmt.Sprintf("- **Context Switches**: %s\n", formatNumber(metrics.ContextSwitches)))
-	}
-
-	return performance.String()
+	return summary
 }
 
-func (rm *ReportModel) generateRecommendations(m MonitorModel, securityChecks []security.SecurityCheck) string {
-	var recommendations strings.Builder
-
-	recommendations.WriteString("## Recommendations\n\n")
-
-	// Security recommendations
-	securityRecs := rm.getSecurityRecommendations(securityChecks)
-	if len(securityRecs) > 0 {
-		recommendations.WriteString("### Security\n")
-		for _, rec := range securityRecs {
-			recommendations.WriteString(fmt.Sprintf("- %s\n", rec))
-		}
-		recommendations.WriteString("\n")
-	}
-
-	// Performance recommendations
-	performanceRecs := rm.getPerformanceRecommendations(m)
-	if len(performanceRecs) > 0 {
-		recommendations.WriteString("### Performance\n")
-		for _, rec := range performanceRecs {
-			recommendations.WriteString(fmt.Sprintf("- %s\n", rec))
-		}
-		recommendations.WriteString("\n")
+func (rm *ReportModel) buildPerformanceInsights(m MonitorModel, diagnostic DiagnosticModel) PerformanceInsights {
+	insights := PerformanceInsights{
+		TopCPU:    topProcesses(m.Processes, func(a, b proc.ProcessInfo) bool { return a.CPU > b.CPU }),
+		TopMemory: topProcesses(m.Processes, func(a, b proc.ProcessInfo) bool { return a.Mem > b.Mem }),
 	}
 
-	// Docker recommendations
-	dockerRecs := rm.getDockerRecommendations(m)
-	if len(dockerRecs) > 0 {
-		recommendations.WriteString("### Docker\n")
-		for _, rec := range dockerRecs {
-			recommendations.WriteString(fmt.Sprintf("- %s\n", rec))
-		}
+	if diagnostic.Performance.HealthMetrics != nil {
+		metrics := *diagnostic.Performance.HealthMetrics
+		insights.HealthMetrics = &metrics
 	}
 
-	if !strings.Contains(recommendations.String(), "###") {
-		recommendations.WriteString("**No specific recommendations at this time.**\n")
-	}
+	return insights
+}
 
-	return recommendations.String()
+// topProcesses returns the first five processes by the given ordering
+// without reordering the caller's slice.
+func topProcesses(processes []proc.ProcessInfo, less func(a, b proc.ProcessInfo) bool) []ProcessEntry {
+	sorted := make([]proc.ProcessInfo, len(processes))
+	copy(sorted, processes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	entries := []ProcessEntry{}
+	for i := 0; i < len(sorted) && i < 5; i++ {
+		p := sorted[i]
+		entries = append(entries, ProcessEntry{PID: p.PID, Command: p.Command, CPU: p.CPU, Mem: p.Mem})
+	}
+	return entries
 }
 
-func (rm *ReportModel) calculateOverallStatus(m MonitorModel, diagnostic DiagnosticModel, securityChecks []security.SecurityCheck) ReportStatus {
+func (rm *ReportModel) calculateOverallStatus(m MonitorModel, diagnostic DiagnosticModel, securityChecks []security.SecurityCheck, containers []app.Container, now time.Time) ReportStatus {
 	// Check for critical conditions
 	if m.Memory.Usage > 95 || (diagnostic.Performance.HealthScore != nil && diagnostic.Performance.HealthScore.Score < 50) {
 		return StatusCritical
 	}
+	for _, disk := range m.Disks {
+		if disk.InodesCritical() {
+			return StatusCritical
+		}
+	}
+	events := m.ContainerEvents()
+	for _, container := range containers {
+		if container.RestartLooping(now, events) {
+			return StatusCritical
+		}
+	}
 
 	// Check for warning conditions
 	if m.Memory.Usage > 85 || m.Cpu.Usage > 85 ||
 		(diagnostic.Performance.HealthScore != nil && diagnostic.Performance.HealthScore.Score < 70) {
 		return StatusWarning
 	}
+	for _, container := range containers {
+		if container.Health == "unhealthy" {
+			return StatusWarning
+		}
+	}
 
 	// Check security status
 	for _, check := range securityChecks {
-		if strings.Contains(check.Status, "Failed") || strings.Contains(check.Status, "Critical") {
+		if check.Result() == security.CheckFailed {
 			return StatusWarning
 		}
 	}
@@ -380,15 +291,15 @@ func (rm *ReportModel) calculateOverallStatus(m MonitorModel, diagnostic Diagnos
 func (rm *ReportModel) calculateSecurityScore(securityChecks []security.SecurityCheck) int {
 	passed := 0
 	for _, check := range securityChecks {
-		if strings.Contains(check.Status, "OK") || strings.Contains(check.Status, "Valide") {
+		if check.Result() == security.CheckPassed {
 			passed++
 		}
 	}
 	return passed
 }
 
-func (rm *ReportModel) getCriticalPoints(m MonitorModel, securityChecks []security.SecurityCheck) []string {
-	var points []string
+func (rm *ReportModel) getCriticalPoints(m MonitorModel, securityChecks []security.SecurityCheck, containers []app.Container, now time.Time) []string {
+	points := []string{}
 
 	// Memory usage
 	if m.Memory.Usage > 95 {
@@ -400,20 +311,34 @@ func (rm *ReportModel) getCriticalPoints(m MonitorModel, securityChecks []securi
 		points = append(points, fmt.Sprintf("CPU usage at %.1f%% - system may be overloaded", m.Cpu.Usage))
 	}
 
+	// Inode exhaustion stops file creation even when there is free space
+	for _, disk := range m.Disks {
+		if disk.InodesCritical() {
+			points = append(points, fmt.Sprintf("Inode usage on '%s' at %.1f%% (%s of %s) - new files cannot be created once exhausted",
+				disk.Mountpoint, disk.InodesUsage, formatNumber(disk.InodesUsed), formatNumber(disk.InodesTotal)))
+		}
+	}
+
 	// Security issues
 	for _, check := range securityChecks {
-		if strings.Contains(check.Status, "Failed") || strings.Contains(check.Status, "Critical") {
+		if check.Result() == security.CheckFailed {
 			points = append(points, fmt.Sprintf("Security: %s - %s", check.Name, check.Details))
 		}
 	}
 
-	// Docker unhealthy containers
-	if m.App != nil {
-		containers, _ := m.App.RefreshContainers()
-		for _, container := range containers {
-			if container.Health == "unhealthy" {
-				points = append(points, fmt.Sprintf("Docker container '%s' is unhealthy", container.Name))
+	// Docker unhealthy and restart-looping containers
+	events := m.ContainerEvents()
+	for _, container := range containers {
+		if container.Health == "unhealthy" {
+			points = append(points, fmt.Sprintf("Docker container '%s' is unhealthy", container.Name))
+		}
+		if container.RestartLooping(now, events) {
+			reason := fmt.Sprintf("exit code %d", container.ExitCode)
+			if container.OOMKilled {
+				reason = "OOM-killed"
 			}
+			points = append(points, fmt.Sprintf("Docker container '%s' is restart-looping (%d restarts, last run %s)",
+				container.Name, container.RestartCount, reason))
 		}
 	}
 
@@ -421,19 +346,20 @@ func (rm *ReportModel) getCriticalPoints(m MonitorModel, securityChecks []securi
 }
 
 func (rm *ReportModel) getSecurityRecommendations(securityChecks []security.SecurityCheck) []string {
-	var recommendations []string
+	recommendations := []string{}
 
 	for _, check := range securityChecks {
-		if strings.Contains(check.Status, "Warning") {
+		switch check.Result() {
+		case security.CheckWarning:
 			switch check.Name {
 			case "SSH Password Authentication":
 				recommendations = append(recommendations, "SSH password authentication is enabled. Consider using SSH keys only for better security.")
 			case "System Updates":
 				recommendations = append(recommendations, "System updates are available. Run 'sudo apt update && sudo apt upgrade' to apply security patches.")
-			case "System Restart Required":
+			case "System Restart":
 				recommendations = append(recommendations, "System restart required for kernel updates. Schedule a maintenance window to reboot the system.")
 			}
-		} else if strings.Contains(check.Status, "Failed") {
+		case security.CheckFailed:
 			switch check.Name {
 			case "Firewall Status":
 				recommendations = append(recommendations, "Firewall is not active. Enable it with 'sudo ufw enable'.")
@@ -447,12 +373,12 @@ func (rm *ReportModel) getSecurityRecommendations(securityChecks []security.Secu
 }
 
 func (rm *ReportModel) getPerformanceRecommendations(m MonitorModel) []string {
-	var recommendations []string
+	recommendations := []string{}
 
 	// Memory recommendations
 	if m.Memory.Usage > 90 {
-		if len(m.Processes) > 0 {
-			topProcess := m.Processes[0]
+		if top := topProcesses(m.Processes, func(a, b proc.ProcessInfo) bool { return a.Mem > b.Mem }); len(top) > 0 {
+			topProcess := top[0]
 			recommendations = append(recommendations,
 				fmt.Sprintf("Memory usage very high (%.1f%%). Process '%s' is consuming %.1f%% memory. Consider optimizing or allocating more resources.",
 					m.Memory.Usage, topProcess.Command, topProcess.Mem))
@@ -464,8 +390,8 @@ func (rm *ReportModel) getPerformanceRecommendations(m MonitorModel) []string {
 
 	// CPU recommendations
 	if m.Cpu.Usage > 90 {
-		if len(m.Processes) > 0 {
-			topProcess := m.Processes[0]
+		if top := topProcesses(m.Processes, func(a, b proc.ProcessInfo) bool { return a.CPU > b.CPU }); len(top) > 0 {
+			topProcess := top[0]
 			recommendations = append(recommendations,
 				fmt.Sprintf("CPU usage very high (%.1f%%). Process '%s' is consuming %.1f%% CPU. Consider optimizing or distributing load.",
 					m.Cpu.Usage, topProcess.Command, topProcess.CPU))
@@ -482,39 +408,64 @@ func (rm *ReportModel) getPerformanceRecommendations(m MonitorModel) []string {
 				fmt.Sprintf("Disk usage on '%s' is very high (%.1f%%). Consider cleaning up or expanding storage.",
 					disk.Mountpoint, disk.Usage))
 		}
+		if disk.InodesCritical() {
+			recommendations = append(recommendations,
+				fmt.Sprintf("Inode usage on '%s' is very high (%.1f%%). Remove small files such as caches, mail spools or session files.",
+					disk.Mountpoint, disk.InodesUsage))
+		}
 	}
 
 	return recommendations
 }
 
-func (rm *ReportModel) getDockerRecommendations(m MonitorModel) []string {
-	var recommendations []string
+func (rm *ReportModel) getDockerRecommendations(containers []app.Container) []string {
+	recommendations := []string{}
 
-	if m.App != nil {
-		containers, _ := m.App.RefreshContainers()
-		for _, container := range containers {
-			if container.Health == "unhealthy" {
-				recommendations = append(recommendations,
-					fmt.Sprintf("Container '%s' is unhealthy. Check its logs with 'docker logs %s'.",
-						container.Name, container.ID[:12]))
-			}
+	for _, container := range containers {
+		if container.Health == "unhealthy" {
+			recommendations = append(recommendations,
+				fmt.Sprintf("Container '%s' is unhealthy. Check its logs with 'docker logs %s'.",
+					container.Name, container.ID))
 		}
 	}
 
 	return recommendations
 }
 
-func (rm *ReportModel) SaveReport() (string, error) {
+// SaveReport writes the current report to the report directory in the given
+// format and returns the path of the new file.
+func (rm *ReportModel) SaveReport(format ReportFormat) (string, error) {
 	if rm.CurrentReport == "" {
 		return "", fmt.Errorf("no report to save")
 	}
 
-	filename := fmt.Sprintf("report_%s_%s.md",
+	var content []byte
+	switch format {
+	case ReportFormatMarkdown:
+		content = []byte(rm.CurrentReport)
+	case ReportFormatJSON:
+		if rm.CurrentDocument == nil {
+			return "", fmt.Errorf("report has no structured data to export")
+		}
+		data, err := rm.CurrentDocument.JSON()
+		if err != nil {
+			return "", err
+		}
+		content = data
+	default:
+		return "", fmt.Errorf("unknown report format %q", format)
+	}
+
+	filename := fmt.Sprintf("report_%s_%s.%s",
 		time.Now().Format("2006-01-02_150405"),
-		"system") // In practice, you might want to get the actual hostname
+		"system", // In practice, you might want to get the actual hostname
+		format)
 
+	if err := os.MkdirAll(rm.ReportDirectory, 0755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", rm.ReportDirectory, err)
+	}
 	filepath := filepath.Join(rm.ReportDirectory, filename)
-	err := os.WriteFile(filepath, []byte(rm.CurrentReport), 0644)
+	err := os.WriteFile(filepath, content, 0644)
 	if err != nil {
 		return "", err
 	}
@@ -549,6 +500,8 @@ func (rm *ReportModel) RefreshSavedReports() {
 	sort.Sort(sort.Reverse(sort.StringSlice(rm.SavedReports)))
 }
 
+// LoadReport reads a saved report and returns its Markdown rendering. JSON
+// reports are decoded into CurrentDocument; Markdown reports are shown as-is.
 func (rm *ReportModel) LoadReport(filename string) (string, error) {
 	filepath := filepath.Join(rm.ReportDirectory, filename)
 	content, err := os.ReadFile(filepath)
@@ -556,10 +509,79 @@ func (rm *ReportModel) LoadReport(filename string) (string, error) {
 		return "", err
 	}
 
+	if strings.HasSuffix(filename, "."+string(ReportFormatJSON)) {
+		doc, err := ParseReportDocument(content)
+		if err != nil {
+			return "", err
+		}
+		rm.CurrentDocument = doc
+		rm.CurrentReport = doc.Markdown()
+		return rm.CurrentReport, nil
+	}
+
+	rm.CurrentDocument = nil
 	rm.CurrentReport = string(content)
 	return rm.CurrentReport, nil
 }
 
+// LoadReportDocument decodes a saved JSON report without changing the
+// current report. Markdown reports carry no structured data.
+func (rm *ReportModel) LoadReportDocument(filename string) (*ReportDocument, error) {
+	if !strings.HasSuffix(filename, "."+string(ReportFormatJSON)) {
+		return nil, fmt.Errorf("%s is not a JSON report; export reports with 'e' to compare them", filename)
+	}
+
+	content, err := os.ReadFile(filepath.Join(rm.ReportDirectory, filename))
+	if err != nil {
+		return nil, err
+	}
+	return ParseReportDocument(content)
+}
+
+// ToggleCompareSelection marks or unmarks a saved report for comparison.
+// At most two reports are kept; selecting a third drops the oldest mark.
+func (rm *ReportModel) ToggleCompareSelection(filename string) {
+	for i, selected := range rm.CompareSelection {
+		if selected == filename {
+			rm.CompareSelection = append(rm.CompareSelection[:i], rm.CompareSelection[i+1:]...)
+			return
+		}
+	}
+	rm.CompareSelection = append(rm.CompareSelection, filename)
+	if len(rm.CompareSelection) > 2 {
+		rm.CompareSelection = rm.CompareSelection[1:]
+	}
+}
+
+func (rm *ReportModel) IsSelectedForCompare(filename string) bool {
+	for _, selected := range rm.CompareSelection {
+		if selected == filename {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareReports diffs two saved JSON reports, oldest first, and makes the
+// rendered comparison the current report.
+func (rm *ReportModel) CompareReports(first, second string) (string, error) {
+	a, err := rm.LoadReportDocument(first)
+	if err != nil {
+		return "", err
+	}
+	b, err := rm.LoadReportDocument(second)
+	if err != nil {
+		return "", err
+	}
+	if b.GeneratedAt.Before(a.GeneratedAt) {
+		a, b = b, a
+	}
+
+	rm.CurrentDocument = nil
+	rm.CurrentReport = DiffReports(a, b).Markdown()
+	return rm.CurrentReport, nil
+}
+
 func formatUptime(uptime uint64) string {
 	seconds := int(uptime)
 	days := seconds / 86400
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/widgets/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testLogLines() []app.LogLine {
+	return []app.LogLine{
+		{Text: "server started"},
+		{Text: `{"level":"debug","msg":"cache warm"}`, Level: "debug"},
+		{Text: `{"level":"error","msg":"Connection refused"}`, Level: "error", Stderr: true},
+		{Text: "plain stderr output", Stderr: true},
+		{Text: `{"level":"warn","msg":"slow connection"}`, Level: "warn"},
+	}
+}
+
+func TestContainerLogViewFilters(t *testing.T) {
+	t.Parallel()
+
+	var view model.ContainerLogView
+	view.Reset()
+	view.SetLines(testLogLines())
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, view.Visible)
+
+	view.CycleStream()
+	assert.Equal(t, model.LogStreamStdout, view.Stream)
+	assert.Equal(t, []int{0, 1, 4}, view.Visible)
+	view.CycleStream()
+	assert.Equal(t, []int{2, 3}, view.Visible)
+	view.CycleStream()
+	assert.Equal(t, model.LogStreamAll, view.Stream)
+
+	// trace, debug, info, then warn: lines without a level are hidden.
+	for range 4 {
+		view.CycleLevel()
+	}
+	assert.Equal(t, "warn", view.MinLevel)
+	assert.Equal(t, []int{2, 4}, view.Visible)
+
+	view.CycleLevel()
+	view.CycleLevel()
+	view.CycleLevel()
+	assert.Empty(t, view.MinLevel)
+	assert.Len(t, view.Visible, 5)
+}
+
+func TestContainerLogViewSearch(t *testing.T) {
+	t.Parallel()
+
+	var view model.ContainerLogView
+	view.Reset()
+	view.SetLines(testLogLines())
+
+	require.NoError(t, view.SetSearch("connection"))
+	assert.Equal(t, []int{2, 4}, view.Matches)
+	assert.Equal(t, 2, view.CurrentMatch())
+	assert.Equal(t, "connection", view.SearchPattern())
+
+	view.NextMatch(1)
+	assert.Equal(t, 4, view.CurrentMatch())
+	view.NextMatch(1)
+	assert.Equal(t, 2, view.CurrentMatch())
+	view.NextMatch(-1)
+	assert.Equal(t, 4, view.CurrentMatch())
+
+	// Capitals make the search case-sensitive.
+	require.NoError(t, view.SetSearch("Connection"))
+	assert.Equal(t, []int{2}, view.Matches)
+
+	// Matches are positions among the visible lines.
+	view.CycleStream()
+	require.NoError(t, view.SetSearch("connection"))
+	assert.Equal(t, []int{2}, view.Matches)
+
+	assert.Error(t, view.SetSearch("("))
+	require.NoError(t, view.SetSearch(""))
+	assert.Nil(t, view.Search)
+	assert.Equal(t, -1, view.CurrentMatch())
+}
+
+func TestContainerLogViewAppend(t *testing.T) {
+	t.Parallel()
+
+	var view model.ContainerLogView
+	view.Reset()
+	for i := range 15 {
+		view.Append(app.LogLine{Text: fmt.Sprintf("line %d", i)}, 10)
+	}
+	require.Len(t, view.Lines, 10)
+	assert.Equal(t, "line 5", view.Lines[0].Text)
+	assert.Len(t, view.Visible, 10)
+}
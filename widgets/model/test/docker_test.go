@@ -0,0 +1,132 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/widgets/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeImages(t *testing.T) {
+	t.Parallel()
+
+	images := []app.Image{
+		{FullID: "sha256:a", Repository: "nginx", Tag: "1.27", Size: 200, Containers: []string{"web"}},
+		{FullID: "sha256:a", Repository: "nginx", Tag: "latest", Size: 200, Containers: []string{"web"}},
+		{FullID: "sha256:b", Repository: "<none>", Tag: "<none>", Size: 500},
+		{FullID: "sha256:c", Repository: "redis", Tag: "7", Size: 100},
+	}
+
+	assert.Equal(t, model.ImageUsage{
+		Images:       3,
+		Size:         800,
+		Dangling:     1,
+		DanglingSize: 500,
+		Unused:       2,
+		UnusedSize:   600,
+	}, model.SummarizeImages(images))
+}
+
+func TestSummarizeVolumes(t *testing.T) {
+	t.Parallel()
+
+	volumes := []app.Volume{
+		{Name: "db", Size: 300, Containers: []string{"postgres"}},
+		{Name: "cache", Size: 50},
+		{Name: "new", Size: -1},
+	}
+
+	assert.Equal(t, model.VolumeUsage{
+		Volumes:    3,
+		Size:       350,
+		Unused:     2,
+		UnusedSize: 50,
+	}, model.SummarizeVolumes(volumes))
+}
+
+func TestMergeVolumeSizes(t *testing.T) {
+	t.Parallel()
+
+	previous := []app.Volume{
+		{Name: "db", Size: 300},
+		{Name: "cache", Size: 50},
+	}
+	volumes := []app.Volume{
+		{Name: "cache", Size: -1},
+		{Name: "db", Size: -1},
+		{Name: "new", Size: -1},
+	}
+
+	model.MergeVolumeSizes(previous, volumes)
+
+	assert.Equal(t, []app.Volume{
+		{Name: "db", Size: 300},
+		{Name: "cache", Size: 50},
+		{Name: "new", Size: -1},
+	}, volumes)
+}
+
+func TestGroupContainers(t *testing.T) {
+	t.Parallel()
+
+	containers := []app.Container{
+		{ID: "a1", Name: "web-1", Project: "shop", State: "running"},
+		{ID: "b1", Name: "redis", Project: app.NoProject, State: "running"},
+		{ID: "a2", Name: "db-1", Project: "shop", State: "exited"},
+		{ID: "c1", Name: "api-1", Project: "blog", State: "running"},
+	}
+	stats := map[string]app.ContainerStats{
+		"a1": {CPUPercent: 12.5, MemoryUsage: 100},
+		"a2": {CPUPercent: 99, MemoryUsage: 1000},
+		"b1": {CPUPercent: 1, MemoryUsage: 10},
+	}
+
+	projects := model.GroupContainers(containers, stats)
+	require.Len(t, projects, 3)
+
+	assert.Equal(t, "blog", projects[0].Name)
+	assert.Equal(t, 1, projects[0].Running)
+	assert.Zero(t, projects[0].CPU, "containers without a sample add nothing")
+
+	shop := projects[1]
+	assert.Equal(t, "shop", shop.Name)
+	assert.Equal(t, 1, shop.Running)
+	assert.Len(t, shop.Containers, 2)
+	assert.Equal(t, 12.5, shop.CPU, "stopped containers are not counted")
+	assert.Equal(t, uint64(100), shop.Memory)
+	assert.Equal(t, []string{"a1", "a2"}, shop.ContainerIDs())
+	assert.False(t, shop.Standalone())
+
+	assert.True(t, projects[2].Standalone(), "standalone containers come last")
+}
+
+func TestReplaceContainer(t *testing.T) {
+	t.Parallel()
+
+	containers := []app.Container{
+		{ID: "a", State: "running"},
+		{ID: "b", State: "running"},
+	}
+
+	tests := []struct {
+		name      string
+		id        string
+		container app.Container
+		found     bool
+		expected  []app.Container
+	}{
+		{"Update", "b", app.Container{ID: "b", State: "exited"}, true, []app.Container{{ID: "a", State: "running"}, {ID: "b", State: "exited"}}},
+		{"Add", "c", app.Container{ID: "c", State: "created"}, true, []app.Container{{ID: "a", State: "running"}, {ID: "b", State: "running"}, {ID: "c", State: "created"}}},
+		{"Remove", "a", app.Container{}, false, []app.Container{{ID: "b", State: "running"}}},
+		{"Remove unknown", "z", app.Container{}, false, containers},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, model.ReplaceContainer(containers, tt.id, tt.container, tt.found))
+		})
+	}
+	assert.Equal(t, "running", containers[1].State, "the original list is left untouched")
+}
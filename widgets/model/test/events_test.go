@@ -0,0 +1,52 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/widgets/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventHistoryAdd(t *testing.T) {
+	t.Parallel()
+
+	var history model.EventHistory
+	assert.True(t, history.Last().IsZero())
+
+	start := time.Unix(1700000000, 0)
+	for i := range model.MaxEvents + 5 {
+		history.Add(app.ContainerEvent{Time: start.Add(time.Duration(i) * time.Second)})
+	}
+
+	require.Len(t, history.Events, model.MaxEvents)
+	assert.Equal(t, start.Add(5*time.Second), history.Events[0].Time, "the oldest events are dropped")
+	assert.Equal(t, start.Add(time.Duration(model.MaxEvents+4)*time.Second), history.Last())
+}
+
+func TestEventAlerts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	ago := func(d time.Duration) time.Time { return now.Add(-d) }
+	events := []app.ContainerEvent{
+		{Time: ago(2 * time.Hour), Name: "web-1", Action: "oom"},
+		{Time: ago(50 * time.Minute), Name: "web-1", Action: "oom"},
+		{Time: ago(40 * time.Minute), Name: "worker", Action: "die", ExitCode: "1"},
+		{Time: ago(30 * time.Minute), Name: "web-1", Action: "oom"},
+		{Time: ago(20 * time.Minute), Name: "web-1", Action: "die", ExitCode: "0"},
+		{Time: ago(10 * time.Minute), Name: "db", Action: "health_status", Health: "unhealthy"},
+		{Time: ago(5 * time.Minute), Name: "db", Action: "health_status", Health: "healthy"},
+		{Time: ago(time.Minute), Name: "web-1", Action: "oom"},
+	}
+
+	alerts := model.EventAlerts(events, now)
+	require.Len(t, alerts, 3)
+
+	assert.Equal(t, model.EventAlert{Container: "web-1", Kind: "oom", Count: 3}, alerts[0])
+	assert.Equal(t, "web-1 was OOM-killed 3 times in the last hour", alerts[0].Message())
+	assert.Equal(t, "worker exited with an error once in the last hour", alerts[1].Message())
+	assert.Equal(t, "db became unhealthy once in the last hour", alerts[2].Message())
+}
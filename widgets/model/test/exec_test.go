@@ -0,0 +1,74 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/widgets/model"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandHistoryAdd(t *testing.T) {
+	t.Parallel()
+
+	var history model.CommandHistory
+	history = history.Add("ls")
+	history = history.Add("ls")
+	history = history.Add("")
+	history = history.Add("pwd")
+	history = history.Add("ls")
+	assert.Equal(t, model.CommandHistory{"ls", "pwd", "ls"}, history)
+
+	for i := range model.MaxCommandHistory + 5 {
+		history = history.Add(fmt.Sprintf("echo %d", i))
+	}
+	assert.Len(t, history, model.MaxCommandHistory)
+	assert.Equal(t, fmt.Sprintf("echo %d", model.MaxCommandHistory+4), history[len(history)-1])
+}
+
+func TestContainerExecHistory(t *testing.T) {
+	t.Parallel()
+
+	exec := model.ContainerExec{Input: textinput.New()}
+	exec.Open("abc", "web")
+	exec.Start("ls")
+	exec.Start("pwd")
+	assert.Equal(t, model.CommandHistory{"ls", "pwd"}, exec.History())
+	assert.True(t, exec.Running)
+
+	exec.RecallHistory(-1)
+	assert.Equal(t, "pwd", exec.Input.Value())
+	exec.RecallHistory(-1)
+	exec.RecallHistory(-1)
+	assert.Equal(t, "ls", exec.Input.Value())
+	exec.RecallHistory(1)
+	exec.RecallHistory(1)
+	assert.Equal(t, "", exec.Input.Value())
+
+	// Histories are kept per container name.
+	exec.Open("def", "db")
+	assert.Empty(t, exec.History())
+	assert.False(t, exec.Running)
+	exec.Open("123", "web")
+	assert.Equal(t, model.CommandHistory{"ls", "pwd"}, exec.History())
+}
+
+func TestContainerExecAppendOutput(t *testing.T) {
+	t.Parallel()
+
+	var exec model.ContainerExec
+	batch := make([]app.ExecLine, model.MaxExecOutputLines-1)
+	exec.AppendOutput(batch)
+	assert.Zero(t, exec.Dropped)
+
+	exec.AppendOutput([]app.ExecLine{{Text: "a"}, {Text: "b"}, {Stderr: true, Text: "c"}})
+	assert.Len(t, exec.Output, model.MaxExecOutputLines)
+	assert.Equal(t, 2, exec.Dropped)
+	assert.Equal(t, app.ExecLine{Stderr: true, Text: "c"}, exec.Output[len(exec.Output)-1])
+
+	exec.ClearOutput()
+	assert.Empty(t, exec.Output)
+	assert.Zero(t, exec.Dropped)
+}
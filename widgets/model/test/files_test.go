@@ -0,0 +1,82 @@
+package test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/widgets/model"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParentDir(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"/etc/nginx/nginx.conf", "/etc/nginx"},
+		{"/etc", "/"},
+		{"/", "/"},
+		{"var/log/", "/var"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, model.ParentDir(tt.input))
+		})
+	}
+}
+
+func TestSummarizeChanges(t *testing.T) {
+	t.Parallel()
+
+	summary := model.SummarizeChanges([]app.FileChange{
+		{Kind: "added", Path: "/tmp/a"},
+		{Kind: "added", Path: "/tmp/b"},
+		{Kind: "changed", Path: "/tmp"},
+		{Kind: "deleted", Path: "/etc/motd"},
+	})
+	assert.Equal(t, model.ChangeSummary{Added: 2, Changed: 1, Deleted: 1}, summary)
+}
+
+func TestContainerFilesSelected(t *testing.T) {
+	t.Parallel()
+
+	files := model.ContainerFiles{
+		Changes: []app.FileChange{{Kind: "deleted", Path: "/etc/motd"}},
+		Dir: app.DirListing{Path: "/etc", Entries: []app.FileEntry{
+			{Name: "nginx", Path: "/etc/nginx", Mode: os.ModeDir | 0o755},
+			{Name: "hosts", Path: "/etc/hosts", Mode: 0o644},
+		}},
+		Table: table.New(table.WithColumns([]table.Column{{Title: "Path"}}), table.WithRows([]table.Row{{"a"}, {"b"}})),
+	}
+
+	path, isDir, ok := files.Selected()
+	assert.True(t, ok)
+	assert.Equal(t, "/etc/motd", path)
+	assert.False(t, isDir)
+	change, ok := files.SelectedChange()
+	assert.True(t, ok)
+	assert.Equal(t, "deleted", change.Kind)
+
+	files.View = model.FilesBrowse
+	path, isDir, ok = files.Selected()
+	assert.True(t, ok)
+	assert.Equal(t, "/etc/nginx", path)
+	assert.True(t, isDir)
+	_, ok = files.SelectedChange()
+	assert.False(t, ok)
+
+	files.Table.SetCursor(1)
+	path, isDir, _ = files.Selected()
+	assert.Equal(t, "/etc/hosts", path)
+	assert.False(t, isDir)
+
+	files.Reset("other")
+	_, _, ok = files.Selected()
+	assert.False(t, ok)
+	assert.Equal(t, "/", files.Dir.Path)
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	"github.com/System-Pulse/server-pulse/widgets/model"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleLimits() app.ContainerLimits {
+	return app.ContainerLimits{
+		CPUShares:     1024,
+		CPUPeriod:     100000,
+		Memory:        512 << 20,
+		MemorySwap:    1 << 30,
+		RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyUnlessStopped},
+	}
+}
+
+func TestContainerLimitsForm(t *testing.T) {
+	t.Parallel()
+
+	form := model.NewContainerLimitsForm("abc123", "web")
+	_, err := form.Limits()
+	assert.Error(t, err, "limits are not loaded yet")
+
+	form.SetLimits(sampleLimits())
+	assert.Equal(t, "512m", form.Inputs[model.LimitMemory].Value())
+	assert.Equal(t, "unless-stopped", form.Inputs[model.LimitRestartPolicy].Value())
+
+	unchanged, err := form.Limits()
+	require.NoError(t, err)
+	assert.Equal(t, sampleLimits(), unchanged)
+
+	form.Inputs[model.LimitMemory].SetValue("1g")
+	form.Inputs[model.LimitMemorySwap].SetValue("-1")
+	form.Inputs[model.LimitPids].SetValue("100")
+	form.Inputs[model.LimitRestartPolicy].SetValue("on-failure:3")
+	limits, err := form.Limits()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1<<30), limits.Memory)
+	assert.Equal(t, int64(-1), limits.MemorySwap)
+	assert.Equal(t, int64(100), limits.PidsLimit)
+	assert.Equal(t, 3, limits.RestartPolicy.MaximumRetryCount)
+
+	form.Inputs[model.LimitCPUShares].SetValue("many")
+	_, err = form.Limits()
+	assert.ErrorContains(t, err, "CPU shares")
+
+	// The daemon would ignore 0 for a memory limit already set.
+	form.SetLimits(sampleLimits())
+	form.Inputs[model.LimitMemory].SetValue("0")
+	_, err = form.Limits()
+	assert.ErrorContains(t, err, "memory limit")
+
+	form.MoveFocus(-1)
+	assert.Equal(t, model.LimitRestartPolicy, form.Focus)
+	form.MoveFocus(1)
+	assert.Equal(t, model.LimitCPUShares, form.Focus)
+}
+
+func TestDiffLimits(t *testing.T) {
+	t.Parallel()
+
+	before := sampleLimits()
+	after := before
+	after.Memory = 1 << 30
+	after.RestartPolicy = container.RestartPolicy{Name: container.RestartPolicyAlways}
+
+	assert.Empty(t, model.DiffLimits(before, before))
+	assert.Equal(t, []model.LimitChange{
+		{Field: model.LimitMemory, Before: "512m", After: "1g"},
+		{Field: model.LimitRestartPolicy, Before: "unless-stopped", After: "always"},
+	}, model.DiffLimits(before, after))
+}
@@ -0,0 +1,193 @@
+package test
+
+import (
+	"testing"
+
+	proc "github.com/System-Pulse/server-pulse/system/process"
+	"github.com/System-Pulse/server-pulse/widgets/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleProcesses() []proc.ProcessInfo {
+	return []proc.ProcessInfo{
+		{PID: 30, User: "www-data", CPU: 5, Mem: 1, RSS: 10 << 20, CreateTime: 3000, State: "sleep", Command: "nginx"},
+		{PID: 10, User: "root", CPU: 0, Mem: 40, RSS: 400 << 20, CreateTime: 1000, State: "sleep", Command: "java"},
+		{PID: 20, User: "Postgres", CPU: 5, Mem: 2, RSS: 20 << 20, CreateTime: 2000, State: "running", Command: "postgres"},
+	}
+}
+
+func pids(processes []proc.ProcessInfo) []int32 {
+	var result []int32
+	for _, p := range processes {
+		result = append(result, p.PID)
+	}
+	return result
+}
+
+func TestSortProcesses(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		field    model.ProcessSortField
+		reverse  bool
+		expected []int32
+	}{
+		{"CPU descending with PID tie-break", model.ProcessSortByCPU, false, []int32{20, 30, 10}},
+		{"Memory descending", model.ProcessSortByMem, false, []int32{10, 20, 30}},
+		{"PID ascending", model.ProcessSortByPID, false, []int32{10, 20, 30}},
+		{"PID reversed", model.ProcessSortByPID, true, []int32{30, 20, 10}},
+		{"User ignores case", model.ProcessSortByUser, false, []int32{20, 10, 30}},
+		{"RSS descending", model.ProcessSortByRSS, false, []int32{10, 20, 30}},
+		{"Newest first", model.ProcessSortByStart, false, []int32{30, 20, 10}},
+		{"State ascending", model.ProcessSortByState, false, []int32{20, 10, 30}},
+		{"Command reversed", model.ProcessSortByCommand, true, []int32{20, 30, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processes := sampleProcesses()
+			model.SortProcesses(processes, tt.field, tt.reverse)
+			assert.Equal(t, tt.expected, pids(processes))
+		})
+	}
+}
+
+func TestProcessSortFieldCycle(t *testing.T) {
+	t.Parallel()
+
+	field := model.ProcessSortByCPU
+	for range model.ProcessSortColumns {
+		field = field.Next()
+	}
+	assert.Equal(t, model.ProcessSortByCPU, field)
+
+	assert.Equal(t, model.ProcessSortByCommand, model.ProcessSortByPID.Prev())
+	assert.Equal(t, model.ProcessSortByMem, model.ProcessSortByCPU.Next())
+	assert.Equal(t, "RSS", model.ProcessSortByRSS.String())
+}
+
+func sampleTree() []proc.ProcessInfo {
+	return []proc.ProcessInfo{
+		{PID: 1, PPID: 0, CPU: 0.5, Mem: 1, Command: "systemd"},
+		{PID: 100, PPID: 1, CPU: 1, Mem: 2, Command: "php-fpm"},
+		{PID: 101, PPID: 100, CPU: 40, Mem: 5, Command: "php-fpm"},
+		{PID: 102, PPID: 100, CPU: 10, Mem: 5, Command: "php-fpm"},
+		{PID: 200, PPID: 1, CPU: 2, Mem: 3, Command: "containerd-shim"},
+		{PID: 300, PPID: 999, CPU: 0, Mem: 0, Command: "orphan"},
+	}
+}
+
+func TestBuildProcessTree(t *testing.T) {
+	t.Parallel()
+
+	rows := model.BuildProcessTree(sampleTree(), model.ProcessSortByPID, false, nil)
+
+	var order []int32
+	var prefixes []string
+	for _, row := range rows {
+		order = append(order, row.Process.PID)
+		prefixes = append(prefixes, row.Prefix)
+	}
+	assert.Equal(t, []int32{1, 100, 101, 102, 200, 300}, order)
+	assert.Equal(t, []string{"", "├─ ", "│  ├─ ", "│  └─ ", "└─ ", ""}, prefixes)
+
+	fpm := rows[1]
+	assert.True(t, fpm.HasChildren)
+	assert.Equal(t, 2, fpm.Descendants)
+	assert.InDelta(t, 51.0, fpm.SubtreeCPU, 0.001)
+	assert.InDelta(t, 12.0, fpm.SubtreeMem, 0.001)
+	assert.Equal(t, 4, rows[0].Descendants)
+}
+
+func TestBuildProcessTreeCollapsed(t *testing.T) {
+	t.Parallel()
+
+	rows := model.BuildProcessTree(sampleTree(), model.ProcessSortByCPU, false, map[int32]bool{100: true})
+
+	var order []int32
+	for _, row := range rows {
+		order = append(order, row.Process.PID)
+	}
+	assert.Equal(t, []int32{1, 200, 100, 300}, order)
+	assert.True(t, rows[2].Collapsed)
+	assert.InDelta(t, 51.0, rows[2].SubtreeCPU, 0.001)
+}
+
+func TestBuildProcessTreeCycle(t *testing.T) {
+	t.Parallel()
+
+	rows := model.BuildProcessTree([]proc.ProcessInfo{
+		{PID: 10, PPID: 20},
+		{PID: 20, PPID: 10},
+	}, model.ProcessSortByPID, false, nil)
+
+	assert.Len(t, rows, 2)
+}
+
+func TestSubtreePIDs(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int32{100, 101, 102}, model.SubtreePIDs(sampleTree(), 100))
+	assert.Equal(t, []int32{200}, model.SubtreePIDs(sampleTree(), 200))
+	assert.Nil(t, model.SubtreePIDs(sampleTree(), 42))
+}
+
+func TestGroupProcesses(t *testing.T) {
+	t.Parallel()
+
+	processes := []proc.ProcessInfo{
+		{PID: 1, User: "www-data", Command: "php-fpm", CPU: 30, Mem: 2, RSS: 100, Threads: 1, ReadRate: 10},
+		{PID: 2, User: "www-data", Command: "php-fpm", CPU: 20, Mem: 3, RSS: 200, Threads: 1, WriteRate: 5},
+		{PID: 3, User: "root", Command: "php-fpm", CPU: 1, Mem: 1, RSS: 50, Threads: 1},
+		{PID: 4, User: "postgres", Command: "postgres", CPU: 40, Mem: 10, RSS: 1000, Threads: 4},
+	}
+
+	byUser := model.GroupProcesses(processes, model.ProcessGroupUser)
+	model.SortProcessGroups(byUser, model.ProcessSortByCPU, false)
+	require.Len(t, byUser, 3)
+	assert.Equal(t, model.ProcessGroup{Name: "www-data", Processes: 2, Threads: 2, CPU: 50, Mem: 5, RSS: 300, ReadRate: 10, WriteRate: 5}, byUser[0])
+	assert.Equal(t, "postgres", byUser[1].Name)
+
+	byCommand := model.GroupProcesses(processes, model.ProcessGroupCommand)
+	model.SortProcessGroups(byCommand, model.ProcessSortByPID, false)
+	require.Len(t, byCommand, 2)
+	assert.Equal(t, "php-fpm", byCommand[0].Name)
+	assert.Equal(t, 3, byCommand[0].Processes)
+
+	model.SortProcessGroups(byCommand, model.ProcessSortByRSS, false)
+	assert.Equal(t, "postgres", byCommand[0].Name)
+
+	assert.Equal(t, model.ProcessGroupNone, model.ProcessGroupCommand.Next())
+}
+
+func TestContainerProcesses(t *testing.T) {
+	t.Parallel()
+
+	web := "3f4e5d6c7b8a" + "0123456789abcdef0123456789abcdef0123456789abcdef0123"
+	db := "aa11bb22cc33" + "0123456789abcdef0123456789abcdef0123456789abcdef0123"
+	processes := []proc.ProcessInfo{
+		{PID: 1, Command: "systemd"},
+		{PID: 200, CPU: 1, Command: "nginx", ContainerID: web},
+		{PID: 201, CPU: 9, Command: "nginx", ContainerID: web},
+		{PID: 300, CPU: 50, Command: "postgres", ContainerID: db},
+	}
+
+	tests := []struct {
+		name        string
+		containerID string
+		expected    []int32
+	}{
+		{"Short ID", web[:12], []int32{201, 200}},
+		{"Full ID", db, []int32{300}},
+		{"Unknown container", "ffffffffffff", nil},
+		{"Empty ID does not match host processes", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, pids(model.ContainerProcesses(processes, tt.containerID)))
+		})
+	}
+}
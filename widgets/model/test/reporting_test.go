@@ -0,0 +1,279 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/System-Pulse/server-pulse/system/app"
+	info "github.com/System-Pulse/server-pulse/system/informations"
+	resource "github.com/System-Pulse/server-pulse/system/resource"
+	"github.com/System-Pulse/server-pulse/system/security"
+	"github.com/System-Pulse/server-pulse/widgets/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleMonitor() model.MonitorModel {
+	return model.MonitorModel{
+		System: info.SystemInfo{Hostname: "web-01", OS: "debian 12", Kernel: "6.1.0", Uptime: 90000},
+		Cpu:    resource.CPUInfo{Usage: 12.5, LoadAvg1: 0.5, LoadAvg5: 0.4, LoadAvg15: 0.3},
+		Memory: resource.MemoryInfo{Total: 8 << 30, Used: 2 << 30, Free: 6 << 30, Usage: 25},
+		Disks:  []resource.DiskInfo{{Mountpoint: "/", Total: 100 << 30, Used: 40 << 30, Usage: 40}},
+	}
+}
+
+func sampleChecks() []security.SecurityCheck {
+	return []security.SecurityCheck{
+		{Name: "SSH Root Login", Status: "Disabled", Details: "Root login is disabled"},
+		{Name: "System Updates", Status: "Updates Available", Details: "3 updates available"},
+		{Name: "Firewall Status", Status: "Inactive", Details: "Inactive"},
+	}
+}
+
+func TestParseReportFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected model.ReportFormat
+		wantErr  bool
+	}{
+		{"Markdown short", "md", model.ReportFormatMarkdown, false},
+		{"Markdown long", "markdown", model.ReportFormatMarkdown, false},
+		{"JSON upper case", "JSON", model.ReportFormatJSON, false},
+		{"Unknown", "html", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := model.ParseReportFormat(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, format)
+		})
+	}
+}
+
+func TestBuildReport(t *testing.T) {
+	t.Parallel()
+
+	rm := model.ReportModel{}
+	doc := rm.BuildReport(sampleMonitor(), model.DiagnosticModel{}, sampleChecks())
+
+	assert.Equal(t, model.StatusWarning, doc.Summary.Status)
+	assert.Equal(t, 1, doc.Summary.SecurityPassed)
+	assert.Equal(t, 3, doc.Summary.SecurityTotal)
+	assert.False(t, doc.Containers.Available)
+
+	require.Len(t, doc.Security, 3)
+	assert.Equal(t, model.CheckPassed, doc.Security[0].Result)
+	assert.Equal(t, model.CheckWarning, doc.Security[1].Result)
+	assert.Equal(t, model.CheckFailed, doc.Security[2].Result)
+
+	markdown := doc.Markdown()
+	assert.Contains(t, markdown, "**Hostname**: web-01")
+	assert.Contains(t, markdown, "| Firewall Status | ❌ Failed | Inactive |")
+	assert.Contains(t, markdown, "**Docker not available**")
+}
+
+func TestInodeCriticalPoints(t *testing.T) {
+	t.Parallel()
+
+	monitor := sampleMonitor()
+	monitor.Disks = []resource.DiskInfo{
+		{Mountpoint: "/", Total: 100 << 30, Usage: 40, InodesTotal: 1000, InodesUsed: 500, InodesUsage: 50},
+		{Mountpoint: "/var/spool", Total: 100 << 30, Usage: 20, InodesTotal: 1000, InodesUsed: 970, InodesUsage: 97},
+		{Mountpoint: "/srv/btrfs", Total: 100 << 30, Usage: 10},
+	}
+
+	rm := model.ReportModel{}
+	doc := rm.BuildReport(monitor, model.DiagnosticModel{}, nil)
+
+	assert.Equal(t, model.StatusCritical, doc.Summary.Status)
+	require.Len(t, doc.Summary.CriticalIssues, 1)
+	assert.Contains(t, doc.Summary.CriticalIssues[0], "Inode usage on '/var/spool' at 97.0%")
+	require.Len(t, doc.Resources.Disks, 3)
+	assert.Equal(t, uint64(970), doc.Resources.Disks[1].InodesUsed)
+
+	markdown := doc.Markdown()
+	assert.Contains(t, markdown, "| /var/spool | 20.0% | 100.0 GB | 0 B | 97.0% | 970 / 1,000 |")
+	assert.Contains(t, markdown, "| /srv/btrfs | 10.0% | 100.0 GB | 0 B | n/a | n/a |")
+}
+
+func TestContainerOverallStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		containers []app.Container
+		expected   model.ReportStatus
+	}{
+		{"No containers", nil, model.StatusHealthy},
+		{"Healthy container", []app.Container{{Name: "api", State: "running", Health: "healthy"}}, model.StatusHealthy},
+		{"Unhealthy container", []app.Container{{Name: "api", State: "running", Health: "unhealthy"}}, model.StatusWarning},
+		{"Restart-looping container", []app.Container{
+			{Name: "api", State: "running", Health: "unhealthy"},
+			{Name: "worker", State: "restarting"},
+		}, model.StatusCritical},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := model.ReportModel{}
+			doc := rm.BuildReportWithContainers(sampleMonitor(), model.DiagnosticModel{}, nil, tt.containers)
+			assert.Equal(t, tt.expected, doc.Summary.Status)
+		})
+	}
+}
+
+func TestContainerStatusMarkdown(t *testing.T) {
+	t.Parallel()
+
+	doc := model.ReportDocument{
+		Containers: model.ContainerSummary{
+			Available:      true,
+			Total:          2,
+			Running:        1,
+			Unhealthy:      []string{"api"},
+			RestartLooping: []string{"worker"},
+			Containers: []model.ContainerEntry{
+				{Name: "api", State: "running", Health: "unhealthy"},
+				{Name: "worker", State: "restarting", RestartCount: 7, ExitCode: 137, OOMKilled: true},
+			},
+		},
+	}
+
+	markdown := doc.Markdown()
+	assert.Contains(t, markdown, "**Unhealthy Containers**:\n- api\n")
+	assert.Contains(t, markdown, "**Restart-Looping Containers**:\n- worker\n")
+	assert.Contains(t, markdown, "**OOM-Killed Containers**:\n- worker (exit code 137)\n")
+}
+
+func TestReportDocumentJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	rm := model.ReportModel{}
+	doc := rm.BuildReport(sampleMonitor(), model.DiagnosticModel{}, sampleChecks())
+
+	data, err := doc.JSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"status": "warning"`)
+
+	parsed, err := model.ParseReportDocument(data)
+	require.NoError(t, err)
+	assert.Equal(t, doc.Summary.Status, parsed.Summary.Status)
+	assert.Equal(t, doc.Markdown(), parsed.Markdown())
+}
+
+func TestSaveAndLoadReport(t *testing.T) {
+	t.Parallel()
+
+	// The configured directory is created on the first save.
+	rm := model.ReportModel{ReportDirectory: filepath.Join(t.TempDir(), "reports")}
+	rm.GenerateReport(sampleMonitor(), model.DiagnosticModel{}, sampleChecks())
+	markdown := rm.CurrentReport
+
+	jsonPath, err := rm.SaveReport(model.ReportFormatJSON)
+	require.NoError(t, err)
+	assert.Equal(t, ".json", filepath.Ext(jsonPath))
+
+	mdPath, err := rm.SaveReport(model.ReportFormatMarkdown)
+	require.NoError(t, err)
+	assert.Equal(t, ".md", filepath.Ext(mdPath))
+
+	loaded, err := rm.LoadReport(filepath.Base(jsonPath))
+	require.NoError(t, err)
+	assert.Equal(t, markdown, loaded)
+	require.NotNil(t, rm.CurrentDocument)
+	assert.Equal(t, "web-01", rm.CurrentDocument.System.Hostname)
+
+	raw, err := os.ReadFile(mdPath)
+	require.NoError(t, err)
+	loaded, err = rm.LoadReport(filepath.Base(mdPath))
+	require.NoError(t, err)
+	assert.Equal(t, string(raw), loaded)
+	assert.Nil(t, rm.CurrentDocument)
+}
+
+func TestDiffReports(t *testing.T) {
+	t.Parallel()
+
+	score := func(v int) *int { return &v }
+	baseline := &model.ReportDocument{
+		GeneratedAt: time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		Summary:     model.ExecutiveSummary{Status: model.StatusWarning, HealthScore: score(60)},
+		Resources: model.ResourceUsage{
+			Memory: &model.MemoryUsage{Usage: 80},
+			Disks:  []model.DiskUsage{{Mountpoint: "/", Usage: 90}},
+		},
+		Security: []model.SecurityFinding{
+			{Name: "Firewall Status", Status: "Failed", Result: model.CheckFailed},
+			{Name: "SSH Root Login", Status: "OK", Result: model.CheckPassed},
+		},
+		Containers: model.ContainerSummary{Containers: []model.ContainerEntry{
+			{Name: "web", State: "running"},
+			{Name: "legacy", State: "exited"},
+		}},
+	}
+	current := &model.ReportDocument{
+		GeneratedAt: time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC),
+		Summary:     model.ExecutiveSummary{Status: model.StatusHealthy, HealthScore: score(85)},
+		Resources: model.ResourceUsage{
+			Memory: &model.MemoryUsage{Usage: 50},
+			Disks:  []model.DiskUsage{{Mountpoint: "/", Usage: 40}},
+		},
+		Security: []model.SecurityFinding{
+			{Name: "Firewall Status", Status: "OK", Result: model.CheckPassed},
+			{Name: "SSH Root Login", Status: "Warning", Result: model.CheckWarning},
+		},
+		Containers: model.ContainerSummary{Containers: []model.ContainerEntry{
+			{Name: "web", State: "paused"},
+			{Name: "api", State: "running"},
+		}},
+	}
+
+	diff := model.DiffReports(baseline, current)
+
+	require.Len(t, diff.NewFindings, 1)
+	assert.Equal(t, "SSH Root Login", diff.NewFindings[0].Name)
+	require.Len(t, diff.ResolvedFindings, 1)
+	assert.Equal(t, "Firewall Status", diff.ResolvedFindings[0].Name)
+	assert.Len(t, diff.StatusChanges, 2)
+
+	require.Len(t, diff.ResourceDeltas, 2)
+	assert.InDelta(t, -30.0, diff.ResourceDeltas[0].Change(), 0.001)
+	assert.InDelta(t, -50.0, diff.ResourceDeltas[1].Change(), 0.001)
+
+	require.Len(t, diff.Appeared, 1)
+	assert.Equal(t, "api", diff.Appeared[0].Name)
+	require.Len(t, diff.Disappeared, 1)
+	assert.Equal(t, "legacy", diff.Disappeared[0].Name)
+	require.Len(t, diff.StateChanges, 1)
+	assert.Equal(t, "paused", diff.StateChanges[0].After)
+
+	assert.Contains(t, diff.Markdown(), "60 → 85 (+25)")
+}
+
+func TestCompareReportsRequiresJSON(t *testing.T) {
+	t.Parallel()
+
+	rm := model.ReportModel{ReportDirectory: t.TempDir()}
+	rm.GenerateReport(sampleMonitor(), model.DiagnosticModel{}, sampleChecks())
+	mdPath, err := rm.SaveReport(model.ReportFormatMarkdown)
+	require.NoError(t, err)
+	jsonPath, err := rm.SaveReport(model.ReportFormatJSON)
+	require.NoError(t, err)
+
+	_, err = rm.CompareReports(filepath.Base(mdPath), filepath.Base(jsonPath))
+	assert.Error(t, err)
+
+	content, err := rm.CompareReports(filepath.Base(jsonPath), filepath.Base(jsonPath))
+	require.NoError(t, err)
+	assert.Contains(t, content, "**No security changes**")
+}
@@ -11,6 +11,8 @@ const (
 	ContainerTabNetwork
 	// ContainerTabDisk
 	ContainerTabEnv
+	ContainerTabProcesses
+	ContainerTabFiles
 	// "Interface", "Connectivity", "Configuration", "Protocol Analysis"
 	// ================================ //
 	NetworkTabInterface     ContainerTab = ContainerTabGeneral
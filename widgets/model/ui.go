@@ -14,8 +14,15 @@ const (
 	StateSystem             AppState = "monitor.system"
 	StateProcess            AppState = "monitor.process"
 	StateContainers         AppState = "monitor.containers"
+	StateProcessDetails     AppState = "monitor.process.details"
 	StateContainer          AppState = "monitor.containers.single"
 	StateContainerLogs      AppState = "monitor.containers.logs"
+	StateContainerLimits    AppState = "monitor.containers.limits"
+	StateContainerExec      AppState = "monitor.containers.exec"
+	StateImages             AppState = "monitor.images"
+	StateVolumes            AppState = "monitor.volumes"
+	StateDockerNetworks     AppState = "monitor.networks"
+	StateDockerEvents       AppState = "monitor.events"
 	StateDiagnostics        AppState = "diagnostics"
 	StateCertificateDetails AppState = "diagnostics.certificate"
 	StateSSHRootDetails     AppState = "diagnostics.sshroot"
@@ -18,12 +18,26 @@ func (m Model) renderMainContent() string {
 		currentView = m.renderSystem()
 	case model.StateProcess:
 		currentView = m.renderProcesses()
+	case model.StateProcessDetails:
+		currentView = m.renderProcessDetails()
 	case model.StateContainers:
 		currentView = m.renderContainers()
 	case model.StateContainer:
 		currentView = m.renderContainerSingleView()
 	case model.StateContainerLogs:
 		currentView = m.renderContainerLogs()
+	case model.StateContainerLimits:
+		currentView = m.renderContainerLimits()
+	case model.StateContainerExec:
+		currentView = m.renderContainerExec()
+	case model.StateImages:
+		currentView = m.renderImages()
+	case model.StateVolumes:
+		currentView = m.renderVolumes()
+	case model.StateDockerNetworks:
+		currentView = m.renderDockerNetworks()
+	case model.StateDockerEvents:
+		currentView = m.renderDockerEvents()
 	case model.StateNetwork:
 		currentView = m.renderNetwork()
 	case model.StateDiagnostics:
@@ -66,4 +66,5 @@ func (m *Model) cleanupLogsStream() {
 	m.Monitor.ContainerLogsStreaming = false
 	m.Monitor.ContainerLogsChan = nil
 	m.Monitor.LogsCancelFunc = nil
+	m.stopLogsTee()
 }
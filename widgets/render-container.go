@@ -2,8 +2,11 @@ package widgets
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/System-Pulse/server-pulse/system/app"
 	"github.com/System-Pulse/server-pulse/utils"
 	v "github.com/System-Pulse/server-pulse/widgets/vars"
 
@@ -66,6 +69,10 @@ func (m Model) renderContainerSingleView() string {
 	// 	content = m.renderContainerDisk() // temporary
 	case model.ContainerTabEnv:
 		content = m.renderContainerEnv()
+	case model.ContainerTabProcesses:
+		content = m.renderContainerProcesses()
+	case model.ContainerTabFiles:
+		content = m.renderContainerFiles()
 	default:
 		content = "Not implemented"
 	}
@@ -100,6 +107,13 @@ func (m Model) renderContainerGeneral() string {
 			m.Monitor.ContainerDetails.Gateway,
 		)
 		doc.WriteString(v.MetricLabelStyle.Render(info))
+		doc.WriteString("\n")
+		doc.WriteString(renderRunState(m.Monitor.ContainerDetails.Container, m.Monitor.ContainerEvents()))
+
+		if health := m.Monitor.ContainerDetails.Health; health != nil {
+			doc.WriteString("\n\n")
+			doc.WriteString(renderHealthHistory(health))
+		}
 
 		if len(m.Monitor.ContainerDetails.Ports) > 0 {
 			doc.WriteString("\n\n")
@@ -112,6 +126,21 @@ func (m Model) renderContainerGeneral() string {
 			}
 		}
 
+		if len(m.Monitor.ContainerDetails.Mounts) > 0 {
+			doc.WriteString("\n")
+			doc.WriteString(lipgloss.NewStyle().Bold(true).Render("Mounts:"))
+			doc.WriteString("\n")
+			for _, mount := range m.Monitor.ContainerDetails.Mounts {
+				mode := "rw"
+				if mount.ReadOnly {
+					mode = "ro"
+				}
+				mountInfo := fmt.Sprintf("  %s %s → %s (%s)", mount.Type, mount.Source, mount.Destination, mode)
+				doc.WriteString(v.MetricValueStyle.Render(mountInfo))
+				doc.WriteString("\n")
+			}
+		}
+
 		if m.Monitor.ContainerDetails.Command != "" {
 			doc.WriteString("\n")
 			doc.WriteString(lipgloss.NewStyle().Bold(true).Render("Command:"))
@@ -287,6 +316,98 @@ func (m Model) renderContainerEnv() string {
 	return v.CardStyle.Render(doc.String())
 }
 
+// renderContainerProcesses lists the processes of the selected container as
+// seen from the host, so PIDs can be used with the Process view.
+func (m Model) renderContainerProcesses() string {
+	doc := strings.Builder{}
+
+	doc.WriteString(lipgloss.NewStyle().Bold(true).Underline(true).MarginBottom(1).Render("Processes (host PIDs)"))
+	doc.WriteString("\n\n")
+
+	if m.Monitor.Processes == nil {
+		doc.WriteString(v.MetricLabelStyle.Render("Loading processes..."))
+		return v.CardStyle.Render(doc.String())
+	}
+
+	processes := model.ContainerProcesses(m.Monitor.Processes, m.Monitor.SelectedContainer.ID)
+	if len(processes) == 0 {
+		doc.WriteString(v.MetricLabelStyle.Render("No processes found (the container may be stopped)"))
+		return v.CardStyle.Render(doc.String())
+	}
+
+	var cpu, mem float64
+	var rss uint64
+	for _, p := range processes {
+		cpu += p.CPU
+		mem += p.Mem
+		rss += p.RSS
+	}
+	doc.WriteString(v.MetricLabelStyle.Render(fmt.Sprintf("%d processes · CPU %.1f%% · Mem %.1f%% (%s)",
+		len(processes), cpu, mem, utils.FormatBytes(rss))))
+	doc.WriteString("\n\n")
+
+	doc.WriteString(lipgloss.NewStyle().Bold(true).Render(
+		fmt.Sprintf("%-8s %-12s %6s %6s %10s  %s", "PID", "User", "CPU%", "Mem%", "RSS", "Command")))
+	doc.WriteString("\n")
+
+	// Leave room for the tabs, the title and the summary.
+	limit := max(m.getContentHeight()-10, 5)
+	for i, p := range processes {
+		if i == limit {
+			doc.WriteString(v.MetricLabelStyle.Render(fmt.Sprintf("… and %d more", len(processes)-limit)))
+			break
+		}
+		command := p.Cmdline
+		if command == "" {
+			command = p.Command
+		}
+		doc.WriteString(v.MetricValueStyle.Render(fmt.Sprintf("%-8d %-12s %6.1f %6.1f %10s  %s",
+			p.PID, utils.Ellipsis(p.User, 12), p.CPU, p.Mem, utils.FormatBytes(p.RSS), utils.Ellipsis(command, 60))))
+		doc.WriteString("\n")
+	}
+
+	return v.CardStyle.Render(doc.String())
+}
+
+// filesHeaderLines is the number of lines around the files table: the
+// container tabs, the summary and the hint or destination prompt.
+const filesHeaderLines = 3
+
+func (m Model) renderContainerFiles() string {
+	files := m.Monitor.Files
+
+	var status string
+	switch files.View {
+	case model.FilesChanges:
+		summary := model.SummarizeChanges(files.Changes)
+		status = fmt.Sprintf("Changes vs image: %d added · %d changed · %d deleted",
+			summary.Added, summary.Changed, summary.Deleted)
+	case model.FilesBrowse:
+		status = fmt.Sprintf("📁 %s · %d entries", files.Dir.Path, len(files.Dir.Entries))
+		if files.Dir.Truncated {
+			status += " (listing truncated, directory too large)"
+		}
+	}
+	if files.Loading {
+		status += " · ⏳ Loading..."
+	}
+
+	var footer string
+	switch {
+	case files.CopySource != "":
+		footer = fmt.Sprintf("Copy %s: %s", files.CopySource, files.CopyInput.View())
+	case files.Error != "":
+		footer = lipgloss.NewStyle().Foreground(v.ErrorColor).Render("❌ " + files.Error)
+	case files.View == model.FilesChanges:
+		footer = v.MetricLabelStyle.Render("enter: show in directory · c: copy to host · v: browse files · r: reload")
+	default:
+		footer = v.MetricLabelStyle.Render("enter: open · backspace: parent · c: copy to host · v: show changes · r: reload")
+	}
+
+	return v.MetricLabelStyle.Render(status) + "\n" +
+		v.CardTableStyle.Render(files.Table.View()) + "\n" + footer
+}
+
 func (m Model) renderContainerLogs() string {
 	if m.Monitor.SelectedContainer == nil {
 		return v.CardStyle.Render("No container selected")
@@ -296,8 +417,13 @@ func (m Model) renderContainerLogs() string {
 	status := "Loading..."
 	if m.Monitor.ContainerLogsStreaming {
 		status = "🟢 Live"
+		if m.Monitor.LogsTee != nil {
+			status += fmt.Sprintf(", ⏺ recording to %s (%d lines)", filepath.Base(m.Monitor.LogsTee.Path), m.Monitor.LogsTee.Lines)
+		}
 	} else if !m.Monitor.ContainerLogsLoading {
-		if strings.ToLower(m.Monitor.SelectedContainer.Status) == "up" {
+		if m.Monitor.LogsProject != "" {
+			status = "📚 All services, press 'r' to reload"
+		} else if strings.ToLower(m.Monitor.SelectedContainer.Status) == "up" {
 			status = "🟡 Press 's' for live"
 		} else {
 			status = "🔴 Streaming unavailable (container not running)"
@@ -313,15 +439,21 @@ func (m Model) renderContainerLogs() string {
 			m.Monitor.ContainerLogsPagination.CurrentPage,
 			m.Monitor.ContainerLogsPagination.TotalPages)))
 
+	doc.WriteString("\n" + renderLogFilters(m.Monitor.Logs))
+	if m.Monitor.Logs.PromptKind != model.LogPromptNone {
+		doc.WriteString("\n" + m.Monitor.Logs.Prompt.View())
+	}
+
 	if m.Monitor.ContainerLogsLoading {
 		doc.WriteString("\n\n" + v.MetricLabelStyle.Render("Loading logs..."))
 	} else if len(m.Monitor.ContainerLogsPagination.Lines) > 0 {
+		// The filters and the prompt take two lines.
 		logStyle := lipgloss.NewStyle().
 			Foreground(lipgloss.Color("250")).
 			Background(lipgloss.Color("235")).
-			Height(m.getContentHeight() - 4).
+			Height(max(1, m.getContentHeight()-6)).
 			Padding(1).
-			Width(80)
+			Width(max(80, m.Ui.Width-8))
 
 		m.LogsViewport.Style = logStyle
 		doc.WriteString("\n" + m.LogsViewport.View())
@@ -332,6 +464,29 @@ func (m Model) renderContainerLogs() string {
 	return v.CardStyle.Render(doc.String())
 }
 
+// renderLogFilters summarizes the time window, filters and search of the
+// logs view.
+func renderLogFilters(view model.ContainerLogView) string {
+	parts := []string{"Window: " + view.Window.String()}
+	if view.MinLevel != "" {
+		parts = append(parts, "Level ≥ "+view.MinLevel)
+	}
+	if view.Stream != model.LogStreamAll {
+		parts = append(parts, "Only "+view.Stream.String())
+	}
+	if view.Search != nil {
+		search := fmt.Sprintf("/%s/ no matches", view.SearchPattern())
+		if len(view.Matches) > 0 {
+			search = fmt.Sprintf("/%s/ %d/%d", view.SearchPattern(), view.Match+1, len(view.Matches))
+		}
+		parts = append(parts, search)
+	}
+	if len(view.Lines) > 0 {
+		parts = append(parts, fmt.Sprintf("%d/%d lines", len(view.Visible), len(view.Lines)))
+	}
+	return v.MetricLabelStyle.Render(strings.Join(parts, " · "))
+}
+
 func getStatusWithIcon(status string) string {
 	switch status {
 	case "running":
@@ -347,6 +502,168 @@ func getStatusWithIcon(status string) string {
 	}
 }
 
+// renderRunState shows the restart count and how the last run ended,
+// highlighting restart loops and OOM kills.
+func renderRunState(c app.Container, events []app.ContainerEvent) string {
+	alert := lipgloss.NewStyle().Bold(true).Foreground(v.ErrorColor)
+
+	restarts := v.MetricLabelStyle.Render(fmt.Sprintf("Restarts: %d", c.RestartCount))
+	if c.RestartLooping(time.Now(), events) {
+		restarts += " " + alert.Render("⚠ restart loop")
+	}
+
+	exit := v.MetricLabelStyle.Render(fmt.Sprintf("Last Exit Code: %d", c.ExitCode))
+	if c.OOMKilled {
+		exit += " " + alert.Render("⚠ OOM-killed")
+	}
+	return restarts + "\n" + exit
+}
+
+// maxHealthLogEntries bounds the health checks listed in the General tab.
+const maxHealthLogEntries = 5
+
+// renderHealthHistory lists the latest health checks, newest first.
+func renderHealthHistory(health *app.HealthInfo) string {
+	var doc strings.Builder
+	doc.WriteString(lipgloss.NewStyle().Bold(true).Render("Health Checks:"))
+	doc.WriteString(fmt.Sprintf("  %s, failing streak %d", getHealthWithIcon(health.Status), health.FailingStreak))
+	doc.WriteString("\n")
+
+	for i := len(health.Log) - 1; i >= 0 && i >= len(health.Log)-maxHealthLogEntries; i-- {
+		check := health.Log[i]
+		output, _, _ := strings.Cut(check.Output, "\n")
+		if output == "" {
+			output = "-"
+		}
+		line := fmt.Sprintf("  %s  exit %d  %s", check.End.Local().Format("15:04:05"), check.ExitCode, utils.Ellipsis(output, 60))
+		if check.ExitCode != 0 {
+			doc.WriteString(lipgloss.NewStyle().Foreground(v.ErrorColor).Render(line))
+		} else {
+			doc.WriteString(v.MetricValueStyle.Render(line))
+		}
+		doc.WriteString("\n")
+	}
+	return strings.TrimRight(doc.String(), "\n")
+}
+
+func (m Model) renderContainerLimits() string {
+	form := m.Monitor.LimitsForm
+	doc := strings.Builder{}
+	alert := lipgloss.NewStyle().Foreground(v.ErrorColor)
+
+	doc.WriteString(lipgloss.NewStyle().Bold(true).Underline(true).MarginBottom(1).Render(
+		fmt.Sprintf("Limits: %s", form.Name)))
+	doc.WriteString("\n\n")
+
+	if form.Current == nil {
+		if form.Error != "" {
+			doc.WriteString(alert.Render("❌ " + form.Error))
+		} else {
+			doc.WriteString(v.MetricLabelStyle.Render("Loading limits..."))
+		}
+		return v.CardStyle.Render(doc.String())
+	}
+
+	doc.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("  %-16s %-16s %s", "Setting", "Current", "New")))
+	doc.WriteString("\n")
+	current := model.LimitValues(*form.Current)
+	for i, input := range form.Inputs {
+		field := model.LimitField(i)
+		cursor := "  "
+		label := v.MetricLabelStyle.Render(fmt.Sprintf("%-16s", field))
+		if field == form.Focus {
+			cursor = "▶ "
+			label = lipgloss.NewStyle().Bold(true).Foreground(v.AccentColor).Render(fmt.Sprintf("%-16s", field))
+		}
+		doc.WriteString(cursor + label + " ")
+		doc.WriteString(v.MetricValueStyle.Render(fmt.Sprintf("%-16s", current[i])))
+		doc.WriteString(" " + input.View() + "\n")
+	}
+
+	doc.WriteString("\n")
+	doc.WriteString(v.MetricLabelStyle.Render("CPU quota and PIDs 0 lift the limit; memory limits and CPU shares can be changed but not removed (1024 is the default weight); memory + swap -1 allows unlimited swap."))
+	if form.Current.NanoCPUs {
+		doc.WriteString("\n")
+		doc.WriteString(v.MetricLabelStyle.Render("The CPU limit was set with --cpus, so the quota is applied as a number of CPUs."))
+	}
+
+	if form.Error != "" {
+		doc.WriteString("\n\n" + alert.Render("❌ "+form.Error))
+	}
+	if form.Applying {
+		doc.WriteString("\n\n" + v.MetricLabelStyle.Render("⏳ Applying..."))
+	}
+
+	if form.Before != nil {
+		doc.WriteString("\n\n")
+		doc.WriteString(lipgloss.NewStyle().Bold(true).Render("Last update:"))
+		doc.WriteString("\n")
+		changes := model.DiffLimits(*form.Before, *form.Current)
+		if len(changes) == 0 {
+			doc.WriteString(v.MetricValueStyle.Render("  No settings changed"))
+			doc.WriteString("\n")
+		}
+		for _, c := range changes {
+			doc.WriteString(v.MetricValueStyle.Render(fmt.Sprintf("  %s: %s → %s", c.Field, c.Before, c.After)))
+			doc.WriteString("\n")
+		}
+		for _, warning := range form.Warnings {
+			doc.WriteString(alert.Render("  ⚠ " + warning))
+			doc.WriteString("\n")
+		}
+	}
+
+	return v.CardStyle.Render(strings.TrimRight(doc.String(), "\n"))
+}
+
+// execHeaderLines is the number of lines around the output of the Run
+// command view: the title and its margin, the status, the prompt and the hint.
+const execHeaderLines = 5
+
+func (m Model) renderContainerExec() string {
+	exec := m.Monitor.Exec
+	doc := strings.Builder{}
+	alert := lipgloss.NewStyle().Foreground(v.ErrorColor)
+
+	doc.WriteString(lipgloss.NewStyle().Bold(true).Underline(true).MarginBottom(1).Render(
+		fmt.Sprintf("Run command: %s", exec.Name)))
+	doc.WriteString("\n")
+
+	switch {
+	case exec.Error != "":
+		doc.WriteString(alert.Render("❌ " + exec.Error))
+	case exec.Running:
+		doc.WriteString(v.MetricLabelStyle.Render("⏳ Running: " + utils.Ellipsis(exec.Command, 60)))
+	case exec.Result != nil && exec.Result.Err != nil:
+		doc.WriteString(alert.Render(fmt.Sprintf("❌ %s: %v", utils.Ellipsis(exec.Command, 40), exec.Result.Err)))
+	case exec.Result != nil && exec.Result.ExitCode != 0:
+		doc.WriteString(alert.Render(fmt.Sprintf("❌ %s exited with code %d", utils.Ellipsis(exec.Command, 40), exec.Result.ExitCode)))
+	case exec.Result != nil:
+		doc.WriteString(lipgloss.NewStyle().Foreground(v.SuccessColor).Render(
+			fmt.Sprintf("✅ %s exited with code 0", utils.Ellipsis(exec.Command, 40))))
+	default:
+		doc.WriteString(v.MetricLabelStyle.Render(
+			"Commands run without a shell; use sh -c '...' for pipes, redirections and variables."))
+	}
+	doc.WriteString("\n")
+
+	if len(exec.Output) == 0 && exec.Dropped == 0 {
+		doc.WriteString(lipgloss.NewStyle().Height(exec.Viewport.Height).Render(
+			v.MetricLabelStyle.Render("No output")))
+	} else {
+		doc.WriteString(exec.Viewport.View())
+	}
+	doc.WriteString("\n")
+
+	doc.WriteString(exec.Input.View())
+	doc.WriteString("\n")
+	doc.WriteString(v.MetricLabelStyle.Render(fmt.Sprintf(
+		"enter: run · ↑/↓: history (%d) · pgup/pgdn: scroll · ctrl+l: clear · ctrl+x: stop following · esc: back",
+		len(exec.History()))))
+
+	return v.CardStyle.Render(doc.String())
+}
+
 func getHealthWithIcon(health string) string {
 	switch health {
 	case "healthy":
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/System-Pulse/server-pulse/system/performance"
 	"github.com/System-Pulse/server-pulse/widgets/auth"
 	model "github.com/System-Pulse/server-pulse/widgets/model"
 	"github.com/System-Pulse/server-pulse/widgets/vars"
@@ -521,31 +520,9 @@ func (m Model) renderPerformanceAnalysis() string {
 	var currentView string
 	switch m.Diagnostic.Performance.SelectedItem {
 	case model.SystemHealth:
-		var pMetrics *performance.HealthMetrics
-		if m.Diagnostic.Performance.HealthMetrics != nil {
-			pMetrics = &performance.HealthMetrics{
-				IOWait:          m.Diagnostic.Performance.HealthMetrics.IOWait,
-				ContextSwitches: m.Diagnostic.Performance.HealthMetrics.ContextSwitches,
-				Interrupts:      m.Diagnostic.Performance.HealthMetrics.Interrupts,
-				StealTime:       m.Diagnostic.Performance.HealthMetrics.StealTime,
-				MajorFaults:     m.Diagnostic.Performance.HealthMetrics.MajorFaults,
-				MinorFaults:     m.Diagnostic.Performance.HealthMetrics.MinorFaults,
-			}
-		}
-
-		var pScore *performance.HealthScore
-		if m.Diagnostic.Performance.HealthScore != nil {
-			pScore = &performance.HealthScore{
-				Score:           m.Diagnostic.Performance.HealthScore.Score,
-				Issues:          m.Diagnostic.Performance.HealthScore.Issues,
-				Recommendations: m.Diagnostic.Performance.HealthScore.Recommendations,
-				ChecksPerformed: m.Diagnostic.Performance.HealthScore.ChecksPerformed,
-			}
-		}
-
-		currentView = performance.RenderSystemHealthView(m.Diagnostic.Performance.HealthLoading, pMetrics, pScore)
+		currentView = renderSystemHealthView(m.Diagnostic.Performance.HealthLoading, m.Diagnostic.Performance.HealthMetrics, m.Diagnostic.Performance.HealthScore)
 	case model.InputOutput:
-		currentView = performance.RenderInputOutputWithData(m.Diagnostic.Performance.IOMetrics, m.Diagnostic.Performance.IOLoading)
+		currentView = renderInputOutputWithData(m.Diagnostic.Performance.IOMetrics, m.Diagnostic.Performance.IOLoading)
 	case model.CPU:
 		currentView = m.renderCPUPerformance()
 	case model.Memory:
@@ -581,17 +558,17 @@ func (m Model) renderCPUPerformance() string {
 	navBar := renderNav(cpuTabs, model.ContainerTab(m.Diagnostic.Performance.CPUSelectedTab), activeTabStyle)
 
 	// Overview always visible
-	overview := performance.RenderCPUOverview(m.Diagnostic.Performance.CPUMetrics)
+	overview := renderCPUOverview(m.Diagnostic.Performance.CPUMetrics)
 
 	// Current sub-tab content
 	var currentView string
 	switch m.Diagnostic.Performance.CPUSelectedTab {
 	case model.CPUTabStateBreakdown:
-		currentView = performance.RenderCPUStateBreakdown(m.Diagnostic.Performance.CPUMetrics)
+		currentView = renderCPUStateBreakdown(m.Diagnostic.Performance.CPUMetrics)
 	case model.CPUTabPerCore:
-		currentView = performance.RenderCPUPerCore(m.Diagnostic.Performance.CPUMetrics)
+		currentView = renderCPUPerCore(m.Diagnostic.Performance.CPUMetrics)
 	case model.CPUTabSystemActivity:
-		currentView = performance.RenderCPUSystemActivity(m.Diagnostic.Performance.CPUMetrics)
+		currentView = renderCPUSystemActivity(m.Diagnostic.Performance.CPUMetrics)
 	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left,
@@ -634,13 +611,13 @@ func (m Model) renderMemoryPerformance() string {
 	var currentView string
 	switch m.Diagnostic.Performance.MemorySelectedTab {
 	case model.MemoryTabOverview:
-		currentView = performance.RenderMemoryOverview(m.Diagnostic.Performance.MemoryMetrics)
+		currentView = renderMemoryOverview(m.Diagnostic.Performance.MemoryMetrics)
 	case model.MemoryTabUsageBreakdown:
-		currentView = performance.RenderMemoryUsageBreakdown(m.Diagnostic.Performance.MemoryMetrics)
+		currentView = renderMemoryUsageBreakdown(m.Diagnostic.Performance.MemoryMetrics)
 	case model.MemoryTabSwapAnalysis:
-		currentView = performance.RenderMemorySwapAnalysis(m.Diagnostic.Performance.MemoryMetrics)
+		currentView = renderMemorySwapAnalysis(m.Diagnostic.Performance.MemoryMetrics)
 	case model.MemoryTabSystemMemory:
-		currentView = performance.RenderMemorySystemMemory(m.Diagnostic.Performance.MemoryMetrics)
+		currentView = renderMemorySystemMemory(m.Diagnostic.Performance.MemoryMetrics)
 	}
 
 	content := lipgloss.JoinVertical(lipgloss.Left,
@@ -3,8 +3,13 @@ package widgets
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	proc "github.com/System-Pulse/server-pulse/system/process"
+	resource "github.com/System-Pulse/server-pulse/system/resource"
 	"github.com/System-Pulse/server-pulse/utils"
+	model "github.com/System-Pulse/server-pulse/widgets/model"
+	v "github.com/System-Pulse/server-pulse/widgets/vars"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -17,18 +22,205 @@ func (m Model) renderMonitor() string {
 		currentView = m.renderProcesses()
 	case 2:
 		currentView = m.renderContainers()
+	case 3:
+		currentView = m.renderImages()
+	case 4:
+		currentView = m.renderVolumes()
+	case 5:
+		currentView = m.renderDockerNetworks()
+	case 6:
+		currentView = m.renderDockerEvents()
 	}
 	return currentView
 }
 
 func (m Model) renderContainers() string {
 	p := "Search a container..."
-	return m.renderTable(m.Monitor.Container, p)
+	return v.MetricLabelStyle.Render(m.renderContainersStatus()) + "\n" + m.renderTable(m.Monitor.Container, p)
+}
+
+// renderContainersStatus counts running containers, in total or for the
+// project being drilled into.
+func (m Model) renderContainersStatus() string {
+	running, total := 0, 0
+	for _, c := range m.Monitor.Containers {
+		if m.Monitor.ContainerProjectFilter != "" && c.Project != m.Monitor.ContainerProjectFilter {
+			continue
+		}
+		total++
+		if c.State == "running" {
+			running++
+		}
+	}
+
+	switch {
+	case m.Monitor.ContainerProjectFilter != "":
+		return fmt.Sprintf("Project %s · %d/%d running · b: all projects", m.Monitor.ContainerProjectFilter, running, total)
+	case m.Monitor.ContainerGroupByProject:
+		projects := len(model.GroupContainers(m.Monitor.Containers, nil))
+		return fmt.Sprintf("%d projects · %d/%d containers running · g: ungroup", projects, running, total)
+	default:
+		return fmt.Sprintf("%d containers · %d running · g: group by project", total, running)
+	}
+}
+
+func (m Model) renderImages() string {
+	if m.Monitor.App == nil {
+		return v.CardStyle.Render("Docker is not available.")
+	}
+
+	usage := model.SummarizeImages(m.Monitor.Images)
+	status := fmt.Sprintf("%d images · %s · %d unused (%s) · %d dangling (%s)",
+		usage.Images, utils.FormatBytes(uint64(usage.Size)),
+		usage.Unused, utils.FormatBytes(uint64(usage.UnusedSize)),
+		usage.Dangling, utils.FormatBytes(uint64(usage.DanglingSize)))
+	return v.MetricLabelStyle.Render(status) + "\n" + m.renderTable(m.Monitor.ImageTable, "Search an image...")
+}
+
+func (m Model) renderVolumes() string {
+	if m.Monitor.App == nil {
+		return v.CardStyle.Render("Docker is not available.")
+	}
+
+	usage := model.SummarizeVolumes(m.Monitor.Volumes)
+	status := fmt.Sprintf("%d volumes · %s · %d unused (%s)",
+		usage.Volumes, utils.FormatBytes(uint64(usage.Size)),
+		usage.Unused, utils.FormatBytes(uint64(usage.UnusedSize)))
+	return v.MetricLabelStyle.Render(status) + "\n" + m.renderTable(m.Monitor.VolumeTable, "Search a volume...")
+}
+
+// eventAlertLines is the number of repeated failures listed above the
+// events table.
+const eventAlertLines = 3
+
+func (m Model) renderDockerEvents() string {
+	if m.Monitor.App == nil {
+		return v.CardStyle.Render("Docker is not available.")
+	}
+
+	doc := strings.Builder{}
+	status := fmt.Sprintf("%d events · 🟢 Live", len(m.Monitor.Events.Events))
+	if m.Monitor.EventsChan == nil {
+		status = fmt.Sprintf("%d events · 🔴 Disconnected, retrying", len(m.Monitor.Events.Events))
+		if m.Monitor.EventsError != nil {
+			status += ": " + m.Monitor.EventsError.Error()
+		}
+	}
+	doc.WriteString(v.MetricLabelStyle.Render(status))
+	doc.WriteString("\n")
+
+	alerts := model.EventAlerts(m.Monitor.Events.Events, time.Now())
+	for i := range eventAlertLines {
+		if i < len(alerts) {
+			doc.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("208")).Render("⚠ " + alerts[i].Message()))
+		} else if i == 0 {
+			doc.WriteString(v.MetricLabelStyle.Render("No OOM kills, crashes or failed health checks in the last hour"))
+		}
+		doc.WriteString("\n")
+	}
+
+	doc.WriteString(m.renderTable(m.Monitor.EventTable, "Search an event..."))
+	return doc.String()
+}
+
+// networkEndpointLines is the number of attached containers listed below
+// the Networks table.
+const networkEndpointLines = 5
+
+func (m Model) renderDockerNetworks() string {
+	if m.Monitor.App == nil {
+		return v.CardStyle.Render("Docker is not available.")
+	}
+
+	status := fmt.Sprintf("%d networks", len(m.Monitor.DockerNetworks))
+	doc := strings.Builder{}
+	doc.WriteString(v.MetricLabelStyle.Render(status) + "\n")
+	doc.WriteString(m.renderTable(m.Monitor.DockerNetworkTable, "Search a network..."))
+	doc.WriteString("\n")
+
+	n, ok := m.selectedDockerNetwork()
+	if !ok {
+		return doc.String()
+	}
+	doc.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("Containers on %s:", n.Name)))
+	doc.WriteString("\n")
+	if len(n.Endpoints) == 0 {
+		doc.WriteString(v.MetricLabelStyle.Render("  none"))
+	}
+	for i, e := range n.Endpoints {
+		if i == networkEndpointLines-1 && len(n.Endpoints) > networkEndpointLines {
+			doc.WriteString(v.MetricLabelStyle.Render(fmt.Sprintf("  … and %d more", len(n.Endpoints)-i)))
+			break
+		}
+		address := e.IPv4
+		if e.IPv6 != "" {
+			address = strings.TrimSpace(address + " " + e.IPv6)
+		}
+		if address == "" {
+			address = "not running"
+		}
+		doc.WriteString(v.MetricValueStyle.Render(fmt.Sprintf("  %-24s %s", e.Container, address)))
+		doc.WriteString("\n")
+	}
+	return doc.String()
 }
 
 func (m Model) renderProcesses() string {
 	p := "Search a process..."
-	return m.renderTable(m.Monitor.ProcessTable, p)
+	status := m.renderProcessStatus()
+	if m.Monitor.ProcessInputMode != model.ProcessInputNone {
+		status = v.SearchBarStyle.Render(m.Monitor.ProcessInput.View())
+	}
+	return status + "\n" + m.renderTable(m.Monitor.ProcessTable, p)
+}
+
+// renderProcessSignalMenu lists the signals that can be sent to the selected
+// process.
+func (m Model) renderProcessSignalMenu() string {
+	doc := strings.Builder{}
+
+	doc.WriteString("SEND SIGNAL\n")
+	if pid, ok := m.selectedProcessPID(); ok {
+		doc.WriteString(fmt.Sprintf("Process: %d\n", pid))
+	}
+	doc.WriteString("\n")
+
+	for i, signal := range proc.Signals {
+		prefix := "  "
+		if i == m.Monitor.ProcessSignalSelected {
+			prefix = "> "
+		}
+		doc.WriteString(fmt.Sprintf("%sSIG%-5s %s\n", prefix, signal.Name, signal.Description))
+	}
+
+	doc.WriteString("\n")
+	doc.WriteString("Navigation: ↑↓ Navigate • Enter Select • ESC Close\n")
+
+	return v.MenuStyle.Render(doc.String())
+}
+
+// renderProcessStatus summarizes the process list: how many rows match, the
+// sort order and the current page.
+func (m Model) renderProcessStatus() string {
+	rows := len(m.Monitor.ProcessTable.Rows())
+	pageSize := max(m.Monitor.ProcessTable.Height(), 1)
+	pages := max((rows+pageSize-1)/pageSize, 1)
+	page := min(m.Monitor.ProcessTable.Cursor()/pageSize+1, pages)
+
+	order := "↑"
+	if m.Monitor.ProcessSort.Descending() != m.Monitor.ProcessSortReverse {
+		order = "↓"
+	}
+	status := fmt.Sprintf("%d of %d processes · sorted by %s %s · page %d/%d",
+		rows, len(m.Monitor.Processes), m.Monitor.ProcessSort, order, page, pages)
+	switch {
+	case m.showingProcessGroups():
+		status = fmt.Sprintf("%d groups by %s · sorted by %s %s · page %d/%d · enter: show processes",
+			rows, m.Monitor.ProcessGroupBy, m.Monitor.ProcessSort, order, page, pages)
+	case m.Monitor.ProcessGroupFilter != "":
+		status = fmt.Sprintf("%s %s: %s · esc: back to groups", status, m.Monitor.ProcessGroupBy, m.Monitor.ProcessGroupFilter)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("245")).Render(status)
 }
 
 func (m Model) renderSystem() string {
@@ -48,14 +240,143 @@ func (m Model) renderSystem() string {
 	doc.WriteString("\n\n")
 	doc.WriteString(lipgloss.NewStyle().Bold(true).Render("Disks"))
 	doc.WriteString("\n")
+	width := 10
+	for _, disk := range m.Monitor.Disks {
+		width = max(width, min(len(disk.Mountpoint), 24))
+	}
+	detailStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
 	for _, disk := range m.Monitor.Disks {
 		if disk.Total > 0 {
 			if p, ok := m.Monitor.DiskProgress[disk.Mountpoint]; ok {
-				diskInfo := fmt.Sprintf("%-10s %s %.1f%% (%s/%s)", utils.Ellipsis(disk.Mountpoint, 10), p.View(), disk.Usage, utils.FormatBytes(disk.Used), utils.FormatBytes(disk.Total))
+				diskInfo := fmt.Sprintf("%-*s %s %.1f%% (%s/%s)", width, utils.Ellipsis(disk.Mountpoint, width), p.View(), disk.Usage, utils.FormatBytes(disk.Used), utils.FormatBytes(disk.Total))
 				doc.WriteString(diskInfo)
 				doc.WriteString("\n")
+				doc.WriteString(strings.Repeat(" ", width+1))
+				doc.WriteString(detailStyle.Render(diskDetails(disk)))
+				doc.WriteString("\n")
 			}
 		}
 	}
 	return doc.String()
 }
+
+// diskDetails describes where a filesystem comes from and its inode usage.
+func diskDetails(disk resource.DiskInfo) string {
+	inodes := "inodes n/a"
+	if disk.InodesTotal > 0 {
+		inodes = fmt.Sprintf("inodes %.1f%% (%s/%s)", disk.InodesUsage, formatNumber(disk.InodesUsed), formatNumber(disk.InodesTotal))
+	}
+	if disk.InodesCritical() {
+		inodes = lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true).Render("⚠ " + inodes)
+	}
+	return fmt.Sprintf("%s %s %s · %s", disk.Fstype, disk.Device, strings.Join(disk.Options, ","), inodes)
+}
+
+// renderProcessDetails shows what is usually read by hand from /proc/<pid>.
+func (m Model) renderProcessDetails() string {
+	details := m.Monitor.ProcessDetails
+	if details == nil {
+		return "Loading process details..."
+	}
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+	labelStyle := lipgloss.NewStyle().Bold(true).Width(12)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+
+	doc := strings.Builder{}
+	section := func(title string) {
+		doc.WriteString("\n")
+		doc.WriteString(titleStyle.Render(title))
+		doc.WriteString("\n")
+	}
+	field := func(label, value string) {
+		if value == "" {
+			value = dimStyle.Render("n/a")
+		}
+		doc.WriteString(labelStyle.Render(label) + value + "\n")
+	}
+
+	var info proc.ProcessInfo
+	for _, p := range m.Monitor.Processes {
+		if p.PID == details.PID {
+			info = p
+			break
+		}
+	}
+
+	doc.WriteString(titleStyle.Render(fmt.Sprintf("Process %d", details.PID)))
+	doc.WriteString("\n")
+	field("Command", details.Cmdline)
+	field("Executable", details.Exe)
+	field("Cwd", details.Cwd)
+	if info.PID != 0 {
+		field("Parent", fmt.Sprintf("%d", info.PPID))
+		field("User", info.User)
+		field("State", fmt.Sprintf("%s, %d threads", info.State, info.Threads))
+		priority := fmt.Sprintf("nice %d", info.Nice)
+		if details.IOPriority != nil {
+			priority += ", I/O " + details.IOPriority.String()
+		}
+		field("Priority", priority)
+		field("I/O", fmt.Sprintf("%s read, %s written (%s/s read, %s/s written)",
+			utils.FormatBytes(info.ReadBytes), utils.FormatBytes(info.WriteBytes),
+			utils.FormatBytes(uint64(info.ReadRate)), utils.FormatBytes(uint64(info.WriteRate))))
+	}
+	field("Cgroup", details.Cgroup)
+	if details.ContainerID != "" {
+		container := utils.Ellipsis(details.ContainerID, 12)
+		if m.Monitor.ProcessContainerName != "" {
+			container = fmt.Sprintf("%s (%s)", m.Monitor.ProcessContainerName, container)
+		}
+		field("Container", container)
+	}
+
+	if mem := details.Memory; mem != nil {
+		section("Memory")
+		field("RSS", utils.FormatBytes(mem.Rss))
+		field("PSS", utils.FormatBytes(mem.Pss))
+		field("Shared", fmt.Sprintf("%s clean, %s dirty", utils.FormatBytes(mem.SharedClean), utils.FormatBytes(mem.SharedDirty)))
+		field("Private", fmt.Sprintf("%s clean, %s dirty", utils.FormatBytes(mem.PrivateClean), utils.FormatBytes(mem.PrivateDirty)))
+		field("Swap", fmt.Sprintf("%s (PSS %s)", utils.FormatBytes(mem.Swap), utils.FormatBytes(mem.SwapPss)))
+	}
+
+	section(fmt.Sprintf("Sockets (%d)", len(details.Sockets)))
+	for _, s := range details.Sockets {
+		remote := s.Remote
+		if remote == "" {
+			remote = "*"
+		}
+		doc.WriteString(fmt.Sprintf("%-5s %-6s %-28s %-28s %s\n", fmt.Sprintf("%d", s.FD), s.Proto, s.Local, remote, s.State))
+	}
+
+	section(fmt.Sprintf("Open files (%d)", len(details.Files)))
+	for _, f := range details.Files {
+		doc.WriteString(fmt.Sprintf("%-5d %s\n", f.FD, f.Target))
+	}
+
+	if len(details.Limits) > 0 {
+		section("Limits")
+		doc.WriteString(dimStyle.Render(fmt.Sprintf("%-26s %-20s %-20s %s", "Limit", "Soft", "Hard", "Units")))
+		doc.WriteString("\n")
+		for _, l := range details.Limits {
+			doc.WriteString(fmt.Sprintf("%-26s %-20s %-20s %s\n", l.Name, l.Soft, l.Hard, l.Units))
+		}
+	}
+
+	section(fmt.Sprintf("Environment (%d)", len(details.Environ)))
+	for _, env := range details.Environ {
+		doc.WriteString(env)
+		doc.WriteString("\n")
+	}
+
+	if len(details.Warnings) > 0 {
+		section("Unavailable")
+		for _, w := range details.Warnings {
+			doc.WriteString(warnStyle.Render("⚠ " + w))
+			doc.WriteString("\n")
+		}
+	}
+
+	return doc.String()
+}
@@ -0,0 +1,808 @@
+package widgets
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/System-Pulse/server-pulse/system/performance"
+	"github.com/System-Pulse/server-pulse/utils"
+	"github.com/System-Pulse/server-pulse/widgets/vars"
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func renderSystemHealthView(healthLoading bool, healthMetrics *performance.HealthMetrics, healthScore *performance.HealthScore) string {
+	if healthLoading {
+		return vars.CardStyle.Render("⏳ Loading System Health...")
+	}
+
+	if healthMetrics == nil || healthScore == nil {
+		return vars.CardStyle.Render("Press 'r' to load system health metrics.")
+	}
+
+	var b strings.Builder
+
+	// Title
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(vars.AccentColor).MarginBottom(1)
+	b.WriteString(titleStyle.Render("─ SYSTEM HEALTH ANALYSIS ─"))
+	b.WriteString("\n")
+
+	// Health Score
+	prog := progress.New(progress.WithDefaultGradient())
+	prog.Width = 20
+	prog.Full = '█'
+	prog.Empty = '░'
+
+	scoreStr := fmt.Sprintf(" %d/100", healthScore.Score)
+	healthStatus := " [Good]"
+	if healthScore.Score < 50 {
+		healthStatus = " [Poor]"
+	} else if healthScore.Score < 80 {
+		healthStatus = " [Fair]"
+	}
+	b.WriteString(fmt.Sprintf("│ Health Score: %s%s%s \n\n", prog.ViewAs(float64(healthScore.Score)/100.0), scoreStr, healthStatus))
+
+	// Detected Issues
+	if len(healthScore.Issues) > 0 {
+		b.WriteString(lipgloss.NewStyle().Foreground(vars.ErrorColor).Render("⚠️ DETECTED ISSUES"))
+		b.WriteString("\n")
+		for _, issue := range healthScore.Issues {
+			b.WriteString(fmt.Sprintf("- %s \n", issue))
+		}
+		b.WriteString("\n")
+	}
+
+	// Recommendations
+	if len(healthScore.Recommendations) > 0 {
+		b.WriteString(lipgloss.NewStyle().Foreground(vars.SuccessColor).Render("💡 RECOMMENDATIONS"))
+		b.WriteString("\n")
+		for _, rec := range healthScore.Recommendations {
+			b.WriteString(fmt.Sprintf("- %s \n", rec))
+		}
+		b.WriteString("\n")
+	}
+
+	// Checks Performed
+	if len(healthScore.ChecksPerformed) > 0 {
+		b.WriteString(lipgloss.NewStyle().Bold(true).Render("CHECKS PERFORMED"))
+		b.WriteString("\n")
+		for _, check := range healthScore.ChecksPerformed {
+			b.WriteString(fmt.Sprintf("- %s \n", check))
+		}
+		b.WriteString("\n")
+	}
+
+	return vars.CardStyle.Render(b.String())
+}
+
+func renderInputOutputWithData(metrics *performance.IOMetrics, loading bool) string {
+	if loading {
+		return vars.CardStyle.Render("⏳ Loading I/O Performance Metrics...")
+	}
+
+	if metrics == nil {
+		return vars.CardStyle.Render("⏳ Loading I/O Performance Metrics...")
+	}
+
+	var b strings.Builder
+
+	// Title
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(vars.AccentColor).MarginBottom(1)
+	b.WriteString(titleStyle.Render("─ I/O PERFORMANCE ANALYSIS ─"))
+	b.WriteString("\n")
+
+	// Summary section
+	b.WriteString(lipgloss.NewStyle().Bold(true).Render("📊 I/O SUMMARY"))
+	b.WriteString("\n\n")
+
+	// Average latency with color coding
+	latencyColor := lipgloss.Color("46") // Green by default
+	latencyIcon := "✅"
+	if metrics.AverageLatency > 20 {
+		latencyColor = lipgloss.Color("196") // Red for high latency
+		latencyIcon = "🔴"
+	} else if metrics.AverageLatency > 10 {
+		latencyColor = lipgloss.Color("214") // Orange for medium latency
+		latencyIcon = "🟡"
+	}
+
+	latencyText := lipgloss.NewStyle().Foreground(latencyColor).Bold(true).Render(
+		fmt.Sprintf("%s %.2f ms", latencyIcon, metrics.AverageLatency),
+	)
+	b.WriteString(fmt.Sprintf("│ Average Latency: %s     ", latencyText))
+
+	// Last update
+	lastUpdateText := lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render(
+		metrics.LastUpdate.Format("15:04:05"),
+	)
+	b.WriteString(fmt.Sprintf("│    Last Update:     %s\n", lastUpdateText))
+	b.WriteString("\n")
+
+	// Create summary table
+	summaryColumns := []table.Column{
+		{Title: "Total Read IOPS", Width: 16},
+		{Title: "Total Write IOPS", Width: 17},
+		{Title: "Total Read", Width: 15},
+		{Title: "Total Write", Width: 16},
+	}
+
+	summaryRows := []table.Row{
+		{
+			formatNumber(metrics.TotalReadIOPS),
+			formatNumber(metrics.TotalWriteIOPS),
+			utils.FormatBytes(metrics.TotalReadBytes),
+			utils.FormatBytes(metrics.TotalWriteBytes),
+		},
+	}
+
+	summaryTable := table.New(
+		table.WithColumns(summaryColumns),
+		table.WithRows(summaryRows),
+		table.WithFocused(false),
+		table.WithHeight(2),
+	)
+
+	// Style the summary table
+	summaryStyle := table.DefaultStyles()
+	summaryStyle.Header = summaryStyle.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderBottom(true).
+		Bold(true).
+		Foreground(lipgloss.Color("39"))
+
+	summaryStyle.Cell = summaryStyle.Cell.
+		Foreground(lipgloss.Color("255"))
+
+	summaryTable.SetStyles(summaryStyle)
+
+	b.WriteString(summaryTable.View())
+	b.WriteString("\n\n")
+
+	// Disk I/O table
+	if len(metrics.Disks) > 0 {
+		b.WriteString(lipgloss.NewStyle().Bold(true).Render("💾 DISK I/O PERFORMANCE"))
+		b.WriteString("\n\n")
+
+		// Create table
+		columns := []table.Column{
+			{Title: "Device", Width: 8},
+			{Title: "Read IOPS", Width: 10},
+			{Title: "Write IOPS", Width: 11},
+			{Title: "Read MB/s", Width: 10},
+			{Title: "Write MB/s", Width: 11},
+			{Title: "Util%", Width: 6},
+			{Title: "Queue", Width: 6},
+		}
+
+		var rows []table.Row
+		for _, disk := range metrics.Disks {
+			readMBps := float64(disk.ReadBytes) / (1024 * 1024)
+			writeMBps := float64(disk.WriteBytes) / (1024 * 1024)
+
+			rows = append(rows, table.Row{
+				disk.Device,
+				formatNumber(disk.ReadIOPS),
+				formatNumber(disk.WriteIOPS),
+				fmt.Sprintf("%.1f", readMBps),
+				fmt.Sprintf("%.1f", writeMBps),
+				fmt.Sprintf("%.1f", disk.Utilization),
+				formatNumber(disk.QueueDepth),
+			})
+		}
+
+		t := table.New(
+			table.WithColumns(columns),
+			table.WithRows(rows),
+			table.WithFocused(false),
+			table.WithHeight(min(8, len(rows)+1)),
+		)
+
+		s := table.DefaultStyles()
+		s.Header = s.Header.
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderBottom(true).
+			Bold(false).
+			Foreground(lipgloss.Color("39"))
+
+		s.Cell = s.Cell.
+			Foreground(lipgloss.Color("255"))
+		t.SetStyles(s)
+
+		b.WriteString(t.View())
+		b.WriteString("\n\n")
+	}
+
+	// Top I/O Processes
+	if len(metrics.TopProcesses) > 0 {
+		b.WriteString(lipgloss.NewStyle().Bold(true).Render("🔥 TOP I/O PROCESSES"))
+		b.WriteString("\n\n")
+
+		columns := []table.Column{
+			{Title: "PID", Width: 6},
+			{Title: "Command", Width: 20},
+			{Title: "Read IOPS", Width: 10},
+			{Title: "Write IOPS", Width: 11},
+			{Title: "Read MB", Width: 10},
+			{Title: "Write MB", Width: 11},
+		}
+
+		var rows []table.Row
+		for _, proc := range metrics.TopProcesses {
+			readMB := float64(proc.ReadBytes) / (1024 * 1024)
+			writeMB := float64(proc.WriteBytes) / (1024 * 1024)
+
+			rows = append(rows, table.Row{
+				fmt.Sprintf("%d", proc.PID),
+				utils.Ellipsis(proc.Command, 18),
+				formatNumber(proc.ReadIOPS),
+				formatNumber(proc.WriteIOPS),
+				fmt.Sprintf("%.1f", readMB),
+				fmt.Sprintf("%.1f", writeMB),
+			})
+		}
+
+		t := table.New(
+			table.WithColumns(columns),
+			table.WithRows(rows),
+			table.WithFocused(false),
+			table.WithHeight(min(6, len(rows)+1)),
+		)
+
+		s := table.DefaultStyles()
+		s.Header = s.Header.
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderBottom(true).
+			Bold(false).
+			Foreground(lipgloss.Color("39"))
+
+		s.Cell = s.Cell.
+			Foreground(lipgloss.Color("255"))
+
+		t.SetStyles(s)
+
+		b.WriteString(t.View())
+		b.WriteString("\n\n")
+	}
+
+	return vars.CardStyle.Render(b.String())
+}
+
+// renderCPUOverview renders the CPU overview section (always visible at top)
+func renderCPUOverview(metrics *performance.CPUMetrics) string {
+	var b strings.Builder
+
+	// Overall CPU Usage section
+	b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51")).Render("⚡ CPU OVERVIEW"))
+	b.WriteString("\n")
+	b.WriteString(strings.Repeat("─", 70))
+	b.WriteString("\n\n")
+
+	// CPU Usage with visual indicator
+	usageColor := lipgloss.Color("46") // Green by default
+	usageIcon := "●"
+	usageStatus := "Normal"
+	if metrics.OverallUsage > 80 {
+		usageColor = lipgloss.Color("196") // Red for high usage
+		usageIcon = "●"
+		usageStatus = "High"
+	} else if metrics.OverallUsage > 60 {
+		usageColor = lipgloss.Color("214") // Orange for medium usage
+		usageIcon = "●"
+		usageStatus = "Medium"
+	}
+
+	usageText := lipgloss.NewStyle().Foreground(usageColor).Bold(true).Render(
+		fmt.Sprintf("%s %.1f%% [%s]", usageIcon, metrics.OverallUsage, usageStatus),
+	)
+
+	prog := progress.New(progress.WithDefaultGradient())
+	prog.Width = 40
+	prog.Full = '█'
+	prog.Empty = '░'
+	usageBar := prog.ViewAs(metrics.OverallUsage / 100.0)
+
+	b.WriteString(fmt.Sprintf("  Overall CPU Usage:  %s\n", usageText))
+	b.WriteString(fmt.Sprintf("  %s\n\n", usageBar))
+
+	// Last update and load averages in columns
+	lastUpdateText := lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render(
+		fmt.Sprintf("Last Update: %s", metrics.LastUpdate.Format("15:04:05")),
+	)
+	loadAvgText := lipgloss.NewStyle().Foreground(lipgloss.Color("117")).Render(
+		fmt.Sprintf("Load Avg: %.2f, %.2f, %.2f", metrics.LoadAverage[0], metrics.LoadAverage[1], metrics.LoadAverage[2]),
+	)
+	b.WriteString(fmt.Sprintf("  %s    %s\n", lastUpdateText, loadAvgText))
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// renderCPUStateBreakdown renders detailed CPU state breakdown
+func renderCPUStateBreakdown(metrics *performance.CPUMetrics) string {
+	var b strings.Builder
+
+	// CPU State Breakdown
+	b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51")).Render("📊 CPU STATE BREAKDOWN"))
+	b.WriteString("\n")
+	b.WriteString(strings.Repeat("─", 70))
+	b.WriteString("\n\n")
+
+	states := []struct {
+		name  string
+		value float64
+		icon  string
+		desc  string
+	}{
+		{"User", metrics.StateBreakdown.User, "👤", "User space processes"},
+		{"System", metrics.StateBreakdown.System, "⚙️ ", "Kernel space processes"},
+		{"Idle", metrics.StateBreakdown.Idle, "💤", "CPU idle time"},
+		{"IOWait", metrics.StateBreakdown.IOWait, "⏳", "Waiting for I/O operations"},
+		{"IRQ", metrics.StateBreakdown.IRQ, "⚡", "Hardware interrupts"},
+		{"SoftIRQ", metrics.StateBreakdown.SoftIRQ, "📡", "Software interrupts"},
+		{"Steal", metrics.StateBreakdown.Steal, "🔒", "Stolen by hypervisor"},
+		{"Nice", metrics.StateBreakdown.Nice, "✨", "Nice priority processes"},
+	}
+
+	for _, state := range states {
+		stateColor := lipgloss.Color("46")
+		if state.value > 20 {
+			stateColor = lipgloss.Color("214")
+		}
+		if state.value > 40 {
+			stateColor = lipgloss.Color("196")
+		}
+
+		stateProg := progress.New(progress.WithDefaultGradient())
+		stateProg.Width = 25
+		stateProg.Full = '█'
+		stateProg.Empty = '░'
+		bar := stateProg.ViewAs(state.value / 100.0)
+
+		nameStyle := lipgloss.NewStyle().Width(10).Foreground(lipgloss.Color("255"))
+		valueStyle := lipgloss.NewStyle().Width(8).Foreground(stateColor).Bold(true)
+
+		b.WriteString(fmt.Sprintf("  %s %s %s  %s\n",
+			state.icon,
+			nameStyle.Render(state.name),
+			valueStyle.Render(fmt.Sprintf("%.1f%%", state.value)),
+			bar,
+		))
+	}
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// renderCPUPerCore renders per-core CPU performance
+func renderCPUPerCore(metrics *performance.CPUMetrics) string {
+	var b strings.Builder
+
+	// Per-Core Performance
+	if len(metrics.Cores) > 0 {
+		b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51")).Render("🔥 PER-CORE PERFORMANCE"))
+		b.WriteString("\n")
+		b.WriteString(strings.Repeat("─", 70))
+		b.WriteString("\n\n")
+
+		// Display cores in rows of 2
+		for i := 0; i < len(metrics.Cores); i += 2 {
+			// First core
+			core1 := metrics.Cores[i]
+			coreColor1 := lipgloss.Color("46")
+			if core1.Usage > 80 {
+				coreColor1 = lipgloss.Color("196")
+			} else if core1.Usage > 60 {
+				coreColor1 = lipgloss.Color("214")
+			}
+
+			coreProg1 := progress.New(progress.WithDefaultGradient())
+			coreProg1.Width = 15
+			coreProg1.Full = '█'
+			coreProg1.Empty = '░'
+			bar1 := coreProg1.ViewAs(core1.Usage / 100.0)
+
+			coreLabel1 := lipgloss.NewStyle().Width(8).Render(fmt.Sprintf("Core %2d", core1.CoreID))
+			coreValue1 := lipgloss.NewStyle().Width(7).Foreground(coreColor1).Bold(true).Render(fmt.Sprintf("%5.1f%%", core1.Usage))
+
+			line := fmt.Sprintf("  %s %s %s", coreLabel1, coreValue1, bar1)
+
+			// Second core (if exists)
+			if i+1 < len(metrics.Cores) {
+				core2 := metrics.Cores[i+1]
+				coreColor2 := lipgloss.Color("46")
+				if core2.Usage > 80 {
+					coreColor2 = lipgloss.Color("196")
+				} else if core2.Usage > 60 {
+					coreColor2 = lipgloss.Color("214")
+				}
+
+				coreProg2 := progress.New(progress.WithDefaultGradient())
+				coreProg2.Width = 15
+				coreProg2.Full = '█'
+				coreProg2.Empty = '░'
+				bar2 := coreProg2.ViewAs(core2.Usage / 100.0)
+
+				coreLabel2 := lipgloss.NewStyle().Width(8).Render(fmt.Sprintf("Core %2d", core2.CoreID))
+				coreValue2 := lipgloss.NewStyle().Width(7).Foreground(coreColor2).Bold(true).Render(fmt.Sprintf("%5.1f%%", core2.Usage))
+
+				line += fmt.Sprintf("    %s %s %s", coreLabel2, coreValue2, bar2)
+			}
+
+			b.WriteString(line + "\n")
+		}
+		b.WriteString("\n")
+	} else {
+		b.WriteString("  No core data available\n\n")
+	}
+
+	return b.String()
+}
+
+// renderCPUSystemActivity renders system activity metrics
+func renderCPUSystemActivity(metrics *performance.CPUMetrics) string {
+	var b strings.Builder
+
+	// System Activity Metrics
+	b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("51")).Render("📈 SYSTEM ACTIVITY METRICS"))
+	b.WriteString("\n")
+	b.WriteString(strings.Repeat("─", 70))
+	b.WriteString("\n\n")
+
+	// Display in two columns
+	leftCol := []struct {
+		label string
+		value string
+		icon  string
+	}{
+		{"Context Switches", formatNumber(metrics.ContextSwitches), "🔄"},
+		{"Interrupts", formatNumber(metrics.Interrupts), "⚡"},
+		{"Processes", fmt.Sprintf("%d", metrics.ProcessCount), "📋"},
+		{"Threads", fmt.Sprintf("%d", metrics.ThreadCount), "🧵"},
+	}
+
+	for _, item := range leftCol {
+		labelStyle := lipgloss.NewStyle().Width(20).Foreground(lipgloss.Color("255"))
+		valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("117")).Bold(true)
+		b.WriteString(fmt.Sprintf("  %s %s %s\n",
+			item.icon,
+			labelStyle.Render(item.label+":"),
+			valueStyle.Render(item.value),
+		))
+	}
+
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+func createProgressBar(value float64, color lipgloss.Color) string {
+	prog := progress.New(progress.WithDefaultGradient())
+	prog.Width = 15
+	prog.Full = '█'
+	prog.Empty = '░'
+
+	return prog.ViewAs(value / 100.0)
+}
+
+// renderMemoryOverview renders the always-visible memory overview section
+func renderMemoryOverview(metrics *performance.MemoryMetrics) string {
+	if metrics == nil {
+		return vars.CardStyle.Render("Loading memory data...")
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("39")).
+		MarginBottom(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Width(20).
+		Foreground(lipgloss.Color("245"))
+
+	valueStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("250"))
+
+	progressStyle := lipgloss.NewStyle().
+		Width(50)
+
+	// Create progress bar for memory usage
+	usageBar := createMemoryProgressBar(metrics.UsedPercent, 50)
+
+	overview := titleStyle.Render("MEMORY OVERVIEW") + "\n\n"
+
+	overview += labelStyle.Render("Total Memory:") + " " +
+		valueStyle.Render(formatBytes(metrics.Total)) + "\n"
+
+	overview += labelStyle.Render("Used:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.Used), metrics.UsedPercent)) + "\n"
+
+	overview += labelStyle.Render("Available:") + " " +
+		valueStyle.Render(formatBytes(metrics.Available)) + "\n"
+
+	overview += labelStyle.Render("Free:") + " " +
+		valueStyle.Render(formatBytes(metrics.Free)) + "\n\n"
+
+	overview += progressStyle.Render(usageBar) + "\n"
+
+	return vars.CardStyle.Render(overview)
+}
+
+// renderMemoryUsageBreakdown renders the Usage Breakdown sub-tab
+func renderMemoryUsageBreakdown(metrics *performance.MemoryMetrics) string {
+	if metrics == nil {
+		return vars.CardStyle.Render("Loading memory usage data...")
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("39")).
+		MarginBottom(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Width(25).
+		Foreground(lipgloss.Color("245"))
+
+	valueStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("250"))
+
+	descStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("241")).
+		Italic(true)
+
+	content := titleStyle.Render("USAGE BREAKDOWN") + "\n\n"
+
+	// Application Memory
+	appMemPercent := float64(metrics.ApplicationMem) / float64(metrics.Total) * 100
+	content += labelStyle.Render("Application Memory:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.ApplicationMem), appMemPercent)) + "\n"
+	content += "  " + descStyle.Render("Memory actively used by applications") + "\n\n"
+
+	// Buffers
+	buffersPercent := float64(metrics.Buffers) / float64(metrics.Total) * 100
+	content += labelStyle.Render("Buffers:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.Buffers), buffersPercent)) + "\n"
+	content += "  " + descStyle.Render("I/O buffers for disk operations") + "\n\n"
+
+	// Cached
+	cachedPercent := float64(metrics.Cached) / float64(metrics.Total) * 100
+	content += labelStyle.Render("Cached:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.Cached), cachedPercent)) + "\n"
+	content += "  " + descStyle.Render("File system cache (can be freed if needed)") + "\n\n"
+
+	// Available
+	availablePercent := float64(metrics.Available) / float64(metrics.Total) * 100
+	content += labelStyle.Render("Available:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.Available), availablePercent)) + "\n"
+	content += "  " + descStyle.Render("Memory available for applications") + "\n\n"
+
+	// Shared
+	sharedPercent := float64(metrics.Shared) / float64(metrics.Total) * 100
+	content += labelStyle.Render("Shared:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.Shared), sharedPercent)) + "\n"
+	content += "  " + descStyle.Render("Memory shared between processes") + "\n"
+
+	return vars.CardStyle.Render(content)
+}
+
+// renderMemorySwapAnalysis renders the Swap Analysis sub-tab
+func renderMemorySwapAnalysis(metrics *performance.MemoryMetrics) string {
+	if metrics == nil {
+		return vars.CardStyle.Render("Loading swap data...")
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("39")).
+		MarginBottom(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Width(25).
+		Foreground(lipgloss.Color("245"))
+
+	valueStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("250"))
+
+	progressStyle := lipgloss.NewStyle().
+		Width(50)
+
+	content := titleStyle.Render("SWAP ANALYSIS") + "\n\n"
+
+	// Swap Overview
+	if metrics.SwapTotal == 0 {
+		content += labelStyle.Render("Swap Status:") + " " +
+			lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Render("No swap configured") + "\n"
+	} else {
+		content += labelStyle.Render("Swap Total:") + " " +
+			valueStyle.Render(formatBytes(metrics.SwapTotal)) + "\n"
+
+		content += labelStyle.Render("Swap Used:") + " " +
+			valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+				formatBytes(metrics.SwapUsed), metrics.SwapUsedPercent)) + "\n"
+
+		content += labelStyle.Render("Swap Free:") + " " +
+			valueStyle.Render(formatBytes(metrics.SwapFree)) + "\n\n"
+
+		// Swap progress bar
+		swapBar := createMemoryProgressBar(metrics.SwapUsedPercent, 50)
+		content += progressStyle.Render(swapBar) + "\n\n"
+
+		// SwapCached
+		content += labelStyle.Render("Swap Cached:") + " " +
+			valueStyle.Render(formatBytes(metrics.SwapCached)) + "\n"
+		content += "  " + lipgloss.NewStyle().Foreground(lipgloss.Color("241")).Italic(true).
+			Render("Swap memory also present in RAM") + "\n\n"
+
+		// Health Status
+		content += titleStyle.Render("STATUS") + "\n\n"
+		status := getSwapHealthStatus(metrics)
+		content += status
+	}
+
+	return vars.CardStyle.Render(content)
+}
+
+// renderMemorySystemMemory renders the System Memory sub-tab
+func renderMemorySystemMemory(metrics *performance.MemoryMetrics) string {
+	if metrics == nil {
+		return vars.CardStyle.Render("Loading system memory data...")
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("39")).
+		MarginBottom(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Width(25).
+		Foreground(lipgloss.Color("245"))
+
+	valueStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("250"))
+
+	descStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("241")).
+		Italic(true)
+
+	content := titleStyle.Render("SYSTEM MEMORY") + "\n\n"
+
+	// Dirty Pages
+	dirtyPercent := float64(metrics.Dirty) / float64(metrics.Total) * 100
+	content += labelStyle.Render("Dirty Pages:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.Dirty), dirtyPercent)) + "\n"
+	content += "  " + descStyle.Render("Modified data waiting to be written to disk") + "\n\n"
+
+	// WriteBack
+	writebackPercent := float64(metrics.WriteBack) / float64(metrics.Total) * 100
+	content += labelStyle.Render("WriteBack:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.WriteBack), writebackPercent)) + "\n"
+	content += "  " + descStyle.Render("Data currently being written to disk") + "\n\n"
+
+	// Slab
+	slabPercent := float64(metrics.Slab) / float64(metrics.Total) * 100
+	content += labelStyle.Render("Slab:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.Slab), slabPercent)) + "\n"
+	content += "  " + descStyle.Render("Kernel data structure cache") + "\n\n"
+
+	// PageTables
+	pageTablesPercent := float64(metrics.PageTables) / float64(metrics.Total) * 100
+	content += labelStyle.Render("Page Tables:") + " " +
+		valueStyle.Render(fmt.Sprintf("%s (%.1f%%)",
+			formatBytes(metrics.PageTables), pageTablesPercent)) + "\n"
+	content += "  " + descStyle.Render("Memory used for page table management") + "\n\n"
+
+	// Diagnostics
+	content += titleStyle.Render("DIAGNOSTICS") + "\n\n"
+
+	if metrics.Dirty > 1024*1024*1024 { // > 1GB
+		content += lipgloss.NewStyle().Foreground(lipgloss.Color("220")).Render("⚠ ") +
+			"High dirty pages - data waiting to be written\n"
+	} else {
+		content += lipgloss.NewStyle().Foreground(lipgloss.Color("82")).Render("✓ ") +
+			"Dirty pages within normal range\n"
+	}
+
+	if metrics.WriteBack > 1024*1024*100 { // > 100MB
+		content += lipgloss.NewStyle().Foreground(lipgloss.Color("220")).Render("⚠ ") +
+			"Active writeback - I/O operations in progress\n"
+	} else {
+		content += lipgloss.NewStyle().Foreground(lipgloss.Color("82")).Render("✓ ") +
+			"Minimal writeback activity\n"
+	}
+
+	return vars.CardStyle.Render(content)
+}
+
+// Helper function to get swap health status
+func getSwapHealthStatus(metrics *performance.MemoryMetrics) string {
+	goodStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("82")).Bold(true)
+	warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("220")).Bold(true)
+	criticalStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true)
+
+	// No swap used - good
+	if metrics.SwapUsed == 0 {
+		return goodStyle.Render("✓ No swap used") + " - System has sufficient RAM\n"
+	}
+
+	// Swap used but memory available - configuration issue
+	availablePercent := float64(metrics.Available) / float64(metrics.Total) * 100
+	if metrics.SwapUsed > 0 && availablePercent > 20 {
+		return warningStyle.Render("⚠ Swap used with available RAM") +
+			" - Check swappiness setting\n" +
+			"  Recommendation: Reduce vm.swappiness value\n"
+	}
+
+	// Swap used and memory low - critical
+	if metrics.SwapUsedPercent > 50 {
+		return criticalStyle.Render("✗ High swap usage") +
+			" - System under memory pressure\n" +
+			"  Recommendation: Add more RAM or reduce memory usage\n"
+	}
+
+	// Moderate swap usage
+	return warningStyle.Render("⚠ Moderate swap usage") +
+		" - Monitor for performance issues\n"
+}
+
+// Helper function to create a memory progress bar
+func createMemoryProgressBar(percent float64, width int) string {
+	filled := int(percent / 100.0 * float64(width))
+	if filled > width {
+		filled = width
+	}
+
+	var color string
+	if percent < 70 {
+		color = "82" // Green
+	} else if percent < 85 {
+		color = "220" // Yellow
+	} else {
+		color = "196" // Red
+	}
+
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+	styledBar := lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(bar)
+
+	return fmt.Sprintf("%s %.1f%%", styledBar, percent)
+}
+
+// Helper function to format bytes to human readable format
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+func formatNumber(n uint64) string {
+	s := strconv.FormatUint(n, 10)
+	if len(s) < 4 {
+		return s
+	}
+	var result []string
+	for i := len(s); i > 0; i -= 3 {
+		start := max(i-3, 0)
+		result = append([]string{s[start:i]}, result...)
+	}
+	return strings.Join(result, ",")
+}
@@ -100,6 +100,10 @@ func (m Model) renderSavedReports() string {
 	content.WriteString(actionStyle.Render("Saved Reports:"))
 	content.WriteString("\n\n")
 
+	if m.Reporting.SaveNotification != "" {
+		content.WriteString(m.renderSaveNotification())
+	}
+
 	m.Reporting.RefreshSavedReports()
 	if len(m.Reporting.SavedReports) > 0 {
 		for i, report := range m.Reporting.SavedReports {
@@ -116,13 +120,19 @@ func (m Model) renderSavedReports() string {
 					Foreground(lipgloss.Color("229"))
 			}
 
-			content.WriteString(fmt.Sprintf("  %s %s\n",
+			marker := "   "
+			if m.Reporting.IsSelectedForCompare(report) {
+				marker = "[c]"
+			}
+
+			content.WriteString(fmt.Sprintf("  %s %s %s\n",
 				numberStyle.Render(fmt.Sprintf("%d.", i+1)),
+				marker,
 				reportStyle.Render(report)))
 		}
 
 		content.WriteString("\n")
-		content.WriteString(instructionsStyle().Render("Press ENTER to view selected report, 'd' to delete, 'b' to go back"))
+		content.WriteString(instructionsStyle().Render("Press ENTER to view selected report, 'c' to mark two JSON reports for comparison, 'd' to delete, 'b' to go back"))
 	} else {
 		content.WriteString(instructionsStyle().Render("No saved reports found. Generate a report first."))
 		content.WriteString("\n\n")
@@ -196,7 +206,7 @@ func (m Model) renderViewingReport() string {
 		Foreground(lipgloss.Color("228")).
 		Italic(true)
 
-	content.WriteString(navStyle.Render("Use arrow keys to scroll, 'q' to return to report menu, 's' to save"))
+	content.WriteString(navStyle.Render("Use arrow keys to scroll, 'q' to return to report menu, 's' to save, 'e' to export as JSON"))
 	content.WriteString("\n\n")
 
 	// Report content in viewport
@@ -23,7 +23,7 @@ const (
 
 var (
 	dashboard = []string{"Monitor", "Diagnostic", "Network", "Reporting"}
-	monitor   = []string{"System", "Process", "Containers"}
+	monitor   = []string{"System", "Process", "Containers", "Images", "Volumes", "Networks", "Events"}
 	Menu      = model.Menu{
 		DashBoard: dashboard,
 		Monitor:   monitor,
@@ -40,12 +40,14 @@ var (
 		{Key: "s", Label: "Stop/Start", Description: "Toggle container state", Action: "toggle_start"},
 		{Key: "p", Label: "Pause/Resume", Description: "Toggle pause state", Action: "toggle_pause"},
 		{Key: "e", Label: "Exec shell", Description: "Open interactive shell", Action: "exec"},
-		// {Key: "t", Label: "Top/Stats", Description: "View real-time metrics", Action: "stats"},
+		{Key: "!", Label: "Run command", Description: "Run a command and show its output", Action: "run"},
+		{Key: "t", Label: "Processes", Description: "List processes in the container", Action: "processes"},
+		{Key: "m", Label: "Edit limits", Description: "Change resource limits and restart policy", Action: "limits"},
 		// {Key: "i", Label: "Inspect", Description: "Show container configuration", Action: "inspect"},
 		// {Key: "c", Label: "Commit", Description: "Create image from container", Action: "commit"},
 	}
 
-	ContainerTabs = []string{"General", "CPU", "MEM", "NET", "ENV"} // disk remove
+	ContainerTabs = []string{"General", "CPU", "MEM", "NET", "ENV", "PROC", "Files"} // disk remove
 
 	Spinners = []spinner.Spinner{
 		spinner.Line,
@@ -1,6 +1,7 @@
 package widgets
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -8,7 +9,10 @@ import (
 
 	"github.com/System-Pulse/server-pulse/system/app"
 	"github.com/System-Pulse/server-pulse/system/logs"
+	proc "github.com/System-Pulse/server-pulse/system/process"
+	"github.com/System-Pulse/server-pulse/system/schedule"
 	"github.com/System-Pulse/server-pulse/utils"
+	"github.com/System-Pulse/server-pulse/widgets/commands"
 	model "github.com/System-Pulse/server-pulse/widgets/model"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
@@ -102,6 +106,28 @@ func (m *Model) loadContainerDetails(containerID string) tea.Cmd {
 	)
 }
 
+// loadProcessDetails reads the detail view of pid and, when Docker is
+// available, the name of the container the process runs in.
+func loadProcessDetails(dm *app.DockerManager, pid int32) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		details, err := proc.CollectProcessDetails(ctx, pid)
+		if err != nil {
+			return utils.ErrMsg(err)
+		}
+
+		msg := proc.ProcessDetailsMsg{Details: details}
+		if details.ContainerID != "" && dm != nil {
+			if names, err := dm.ContainerNames(ctx); err == nil {
+				msg.ContainerName = names[details.ContainerID]
+			}
+		}
+		return msg
+	}
+}
+
 func (m *Model) stopContainerStats() {
 	if m.Monitor.StatsCancelFunc != nil {
 		m.Monitor.StatsCancelFunc()
@@ -210,12 +236,103 @@ func (m *Model) updateChartsWithStats(stats app.ContainerStatsMsg) {
 	m.LastChartUpdate = now
 }
 
-func tick() tea.Cmd {
-	return tea.Tick(time.Second*2, func(t time.Time) tea.Msg {
+func tick(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
 		return utils.TickMsg(t)
 	})
 }
 
+// containerResyncInterval is how often the container list is fully
+// refreshed while the events stream keeps it up to date.
+const containerResyncInterval = time.Minute
+
+// collectorVisible reports whether the data of a collector is on screen.
+// CPU, memory and network feed the history charts and system info feeds the
+// header, so those keep running everywhere.
+func (m Model) collectorVisible(c schedule.Collector) bool {
+	switch c {
+	case schedule.CollectorDisk:
+		return m.Ui.State == model.StateSystem || m.isReportingState()
+	case schedule.CollectorProcesses:
+		return m.Ui.State == model.StateProcess || m.Ui.State == model.StateProcessDetails || m.isReportingState() ||
+			(m.Ui.State == model.StateContainer && m.ContainerTab == model.ContainerTabProcesses)
+	case schedule.CollectorContainers:
+		switch m.Ui.State {
+		case model.StateContainers, model.StateContainer, model.StateContainerLogs,
+			model.StateContainerLimits, model.StateContainerExec, model.StateDockerEvents:
+			return true
+		}
+		return false
+	case schedule.CollectorImages:
+		return m.Ui.State == model.StateImages
+	case schedule.CollectorVolumes:
+		return m.Ui.State == model.StateVolumes
+	case schedule.CollectorDockerNetworks:
+		return m.Ui.State == model.StateDockerNetworks
+	}
+	return true
+}
+
+func (m Model) isReportingState() bool {
+	switch m.Ui.State {
+	case model.StateReporting, model.StateGeneratingReport, model.StateViewingReport, model.StateSavingReport:
+		return true
+	}
+	return false
+}
+
+// scheduledCmds returns the collectors that are due and visible at now.
+func (m Model) scheduledCmds(now time.Time) []tea.Cmd {
+	var cmds []tea.Cmd
+	for _, c := range schedule.Collectors {
+		if !m.Schedule.Due(c, now, m.collectorVisible(c)) {
+			continue
+		}
+		switch c {
+		case schedule.CollectorSystem:
+			cmds = append(cmds, commands.UpdateSystemInfo())
+		case schedule.CollectorCPU:
+			cmds = append(cmds, commands.UpdateCPUInfo())
+		case schedule.CollectorMemory:
+			cmds = append(cmds, commands.UpdateMemoryInfo())
+		case schedule.CollectorDisk:
+			cmds = append(cmds, commands.UpdateDiskInfo())
+		case schedule.CollectorNetwork:
+			cmds = append(cmds, commands.UpdateNetworkInfo())
+		case schedule.CollectorProcesses:
+			cmds = append(cmds, commands.UpdateProcesses())
+			if m.Monitor.App != nil {
+				cmds = append(cmds, commands.ContainerNamesCmd(m.Monitor.App))
+			}
+		case schedule.CollectorContainers:
+			if m.Monitor.App != nil {
+				// While the events stream is up, containers are updated as
+				// they change and the full refresh only resyncs.
+				if m.Monitor.EventsChan == nil || now.Sub(m.Monitor.ContainersUpdatedAt) >= containerResyncInterval {
+					cmds = append(cmds, commands.UpdateApp(m.Monitor.App))
+				}
+				if m.Monitor.ContainerGroupByProject {
+					cmds = append(cmds, commands.ContainerStatsSampleCmd(m.Monitor.App, m.runningContainerIDs()))
+				}
+			}
+		case schedule.CollectorImages:
+			if m.Monitor.App != nil {
+				cmds = append(cmds, commands.UpdateImagesCmd(m.Monitor.App))
+			}
+		case schedule.CollectorVolumes:
+			if m.Monitor.App != nil {
+				// Sizes are measured on the first load and on demand only.
+				cmds = append(cmds, commands.UpdateVolumesCmd(m.Monitor.App, m.Monitor.Volumes == nil))
+			}
+		case schedule.CollectorDockerNetworks:
+			if m.Monitor.App != nil {
+				cmds = append(cmds, commands.UpdateNetworksCmd(m.Monitor.App))
+			}
+		}
+	}
+	return cmds
+}
+
 func (m *Model) updateSecurityTable() tea.Cmd {
 	var rows []table.Row
 
@@ -393,7 +510,7 @@ func (m Model) loadLogs() tea.Cmd {
 	m.Diagnostic.LogManager.CanUseSudo = m.CanRunSudo
 	m.Diagnostic.LogManager.SudoPassword = m.Diagnostic.SecurityManager.SudoPassword
 
-	return m.Diagnostic.LogManager.GetSystemLogs(m.Diagnostic.LogFilters)
+	return commands.GetSystemLogs(m.Diagnostic.LogManager, m.Diagnostic.LogFilters)
 }
 
 // applyTimeRangeSelection updates the time range filter based on selection
@@ -0,0 +1,38 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/System-Pulse/server-pulse/system/schedule"
+	model "github.com/System-Pulse/server-pulse/widgets/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectorVisible(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		state     model.AppState
+		collector schedule.Collector
+		expected  bool
+	}{
+		{"Processes in the process list", model.StateProcess, schedule.CollectorProcesses, true},
+		{"Processes in process details", model.StateProcessDetails, schedule.CollectorProcesses, true},
+		{"Processes in images", model.StateImages, schedule.CollectorProcesses, false},
+		{"Containers in the container list", model.StateContainers, schedule.CollectorContainers, true},
+		{"Containers in limits", model.StateContainerLimits, schedule.CollectorContainers, true},
+		{"Containers in exec", model.StateContainerExec, schedule.CollectorContainers, true},
+		{"Containers in events", model.StateDockerEvents, schedule.CollectorContainers, true},
+		{"Containers in the process list", model.StateProcess, schedule.CollectorContainers, false},
+		{"CPU everywhere", model.StateImages, schedule.CollectorCPU, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := InitialModelWithManager(nil)
+			m.Ui.State = tt.state
+			assert.Equal(t, tt.expected, m.collectorVisible(tt.collector))
+		})
+	}
+}
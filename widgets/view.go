@@ -27,6 +27,8 @@ func (m Model) View() string {
 	footer := m.renderFooter()
 	if m.Monitor.ContainerMenuState == v.ContainerMenuVisible {
 		mainContent = m.renderContainerMenu()
+	} else if m.Monitor.ProcessSignalMenu {
+		mainContent = m.renderProcessSignalMenu()
 	} else if m.ConfirmationVisible {
 		mainContent = m.renderConfirmationDialog()
 	}
@@ -2,8 +2,10 @@ package widgets
 
 import (
 	"fmt"
-	"sort"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	system "github.com/System-Pulse/server-pulse/system/app"
 	info "github.com/System-Pulse/server-pulse/system/informations"
@@ -38,6 +40,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.handleKeyMsg(msg)
 	case model.ReportGenerationMsg:
 		m.Reporting.CurrentReport = msg.Report
+		m.Reporting.CurrentDocument = msg.Document
 		m.Reporting.IsGenerating = false
 		// Initialize viewport for the report
 		m.Ui.Viewport.SetContent(msg.Report)
@@ -50,10 +53,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.MouseMsg:
 		return m.handleMouseMsg(msg)
-	case info.SystemMsg, resource.CpuMsg, resource.MemoryMsg, resource.DiskMsg, resource.NetworkMsg, proc.ProcessMsg, performance.HealthMetricsMsg, performance.IOMetricsMsg, performance.CPUMetricsMsg, performance.MemoryMetricsMsg:
+	case info.SystemMsg, resource.CpuMsg, resource.MemoryMsg, resource.DiskMsg, resource.NetworkMsg, proc.ProcessMsg, proc.ProcessDetailsMsg, proc.ProcessOperationMsg, performance.HealthMetricsMsg, performance.IOMetricsMsg, performance.CPUMetricsMsg, performance.MemoryMetricsMsg:
 		return m.handleResourceAndProcessMsgs(msg)
-	case system.ContainerMsg, system.ContainerDetailsMsg, system.ContainerLogsMsg, system.ContainerOperationMsg,
-		system.ExecShellMsg, system.ContainerStatsChanMsg:
+	case system.ContainerMsg, system.ContainerNamesMsg, system.ContainerDetailsMsg, system.ContainerLogsMsg, system.ContainerOperationMsg,
+		system.ContainerLimitsMsg, system.ContainerLimitsUpdatedMsg,
+		system.ContainerDiffMsg, system.ContainerDirMsg, system.ContainerCopyMsg, system.LogsExportedMsg,
+		system.ExecStartedMsg, system.ExecOutputMsg, system.ExecDoneMsg,
+		system.ExecShellMsg, system.ContainerStatsChanMsg, system.ContainerStatsSampleMsg, system.ContainerUpdateMsg,
+		system.EventsStreamMsg, system.ContainerEventMsg, system.EventsStopMsg, system.ImagesMsg, system.VolumesMsg, system.NetworksMsg, system.ResourceOperationMsg:
 		return m.handleContainerRelatedMsgs(msg)
 	case security.SecurityMsg:
 		return m.handleSecurityCheckMsgs(msg)
@@ -82,7 +89,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case utils.ErrMsg:
 		m.Err = msg
 	case utils.TickMsg:
-		return m.handleTickMsg()
+		return m.handleTickMsg(time.Time(msg))
 	case progress.FrameMsg:
 		return m.handleProgressFrame(msg)
 	case model.ForceRefreshMsg:
@@ -101,6 +108,26 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.Monitor.Container, cmd = m.Monitor.Container.Update(msg)
 			cmds = append(cmds, cmd)
 		}
+	case model.StateImages:
+		if !m.Ui.SearchMode {
+			m.Monitor.ImageTable, cmd = m.Monitor.ImageTable.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	case model.StateVolumes:
+		if !m.Ui.SearchMode {
+			m.Monitor.VolumeTable, cmd = m.Monitor.VolumeTable.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	case model.StateDockerNetworks:
+		if !m.Ui.SearchMode {
+			m.Monitor.DockerNetworkTable, cmd = m.Monitor.DockerNetworkTable.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	case model.StateDockerEvents:
+		if !m.Ui.SearchMode {
+			m.Monitor.EventTable, cmd = m.Monitor.EventTable.Update(msg)
+			cmds = append(cmds, cmd)
+		}
 	case model.StateContainerLogs:
 		m.LogsViewport, cmd = m.LogsViewport.Update(msg)
 		cmds = append(cmds, cmd)
@@ -140,37 +167,244 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *Model) applySortPreference() {
-	switch m.Monitor.ProcessSort {
-	case model.ProcessSortByMem:
-		sort.Slice(m.Monitor.Processes, func(i, j int) bool { return m.Monitor.Processes[i].Mem > m.Monitor.Processes[j].Mem })
-	default:
-		sort.Slice(m.Monitor.Processes, func(i, j int) bool { return m.Monitor.Processes[i].CPU > m.Monitor.Processes[j].CPU })
+	model.SortProcesses(m.Monitor.Processes, m.Monitor.ProcessSort, m.Monitor.ProcessSortReverse)
+}
+
+// processColumns builds the process table header, marking the sort column
+// with the sort direction.
+func processColumns(field model.ProcessSortField, reverse bool) []table.Column {
+	widths := map[model.ProcessSortField]int{
+		model.ProcessSortByPID:     8,
+		model.ProcessSortByUser:    12,
+		model.ProcessSortByCPU:     7,
+		model.ProcessSortByMem:     7,
+		model.ProcessSortByRSS:     10,
+		model.ProcessSortByStart:   8,
+		model.ProcessSortByState:   9,
+		model.ProcessSortByCommand: 30,
+	}
+
+	columns := make([]table.Column, 0, len(model.ProcessSortColumns))
+	for _, f := range model.ProcessSortColumns {
+		title := f.String()
+		if f == field {
+			if f.Descending() != reverse {
+				title += " ↓"
+			} else {
+				title += " ↑"
+			}
+		}
+		if f == model.ProcessSortByCommand {
+			// The container column is not sortable; it sits before the
+			// command so that the wide column stays last.
+			columns = append(columns, table.Column{Title: "Container", Width: 16})
+		}
+		columns = append(columns, table.Column{Title: title, Width: widths[f]})
+	}
+	return columns
+}
+
+// processContainerName labels the container p runs in: its name once the
+// names have been looked up, its short ID until then, and nothing for host
+// processes.
+func (m Model) processContainerName(p proc.ProcessInfo) string {
+	if p.ContainerID == "" {
+		return ""
 	}
+	if name, ok := m.Monitor.ContainerNames[p.ContainerID]; ok {
+		return name
+	}
+	return p.ContainerID[:min(12, len(p.ContainerID))]
+}
+
+// processTreeColumns is the header of the tree mode, which trades the RSS,
+// start and state columns for subtree totals and a wider command.
+func processTreeColumns() []table.Column {
+	return []table.Column{
+		{Title: "PID", Width: 8},
+		{Title: "User", Width: 12},
+		{Title: "CPU%", Width: 6},
+		{Title: "Mem%", Width: 6},
+		{Title: "ΣCPU%", Width: 7},
+		{Title: "ΣMem%", Width: 7},
+		{Title: "Thr", Width: 5},
+		{Title: "Command", Width: 40},
+	}
+}
+
+func matchesProcessSearch(p proc.ProcessInfo, searchTerm string) bool {
+	return searchTerm == "" || strings.Contains(strings.ToLower(p.Command), searchTerm) ||
+		strings.Contains(strings.ToLower(p.User), searchTerm) ||
+		strings.Contains(strings.ToLower(p.Cmdline), searchTerm) ||
+		strings.Contains(fmt.Sprintf("%d", p.PID), searchTerm)
 }
 
 func (m *Model) updateProcessTable() tea.Cmd {
 	var rows []table.Row
 	searchTerm := strings.ToLower(m.Ui.SearchInput.Value())
 
-	for _, p := range m.Monitor.Processes {
-		if searchTerm != "" && !strings.Contains(strings.ToLower(p.Command), searchTerm) &&
-			!strings.Contains(strings.ToLower(p.User), searchTerm) &&
-			!strings.Contains(fmt.Sprintf("%d", p.PID), searchTerm) {
-			continue
+	// Keep the cursor on the same process when the order changes.
+	selectedPID := ""
+	if row := m.Monitor.ProcessTable.SelectedRow(); len(row) > 0 {
+		selectedPID = row[0]
+	}
+	cursor := -1
+	// The modes have different columns and the table panics when rendering
+	// rows wider than its columns, so drop the old rows before switching.
+	m.Monitor.ProcessTable.SetRows(nil)
+
+	if m.showingProcessGroups() {
+		groups := model.GroupProcesses(m.Monitor.Processes, m.Monitor.ProcessGroupBy)
+		model.SortProcessGroups(groups, m.Monitor.ProcessSort, m.Monitor.ProcessSortReverse)
+		for _, g := range groups {
+			if searchTerm != "" && !strings.Contains(strings.ToLower(g.Name), searchTerm) {
+				continue
+			}
+			if g.Name == selectedPID {
+				cursor = len(rows)
+			}
+			rows = append(rows, table.Row{
+				g.Name,
+				fmt.Sprintf("%d", g.Processes),
+				fmt.Sprintf("%d", g.Threads),
+				fmt.Sprintf("%.1f", g.CPU),
+				fmt.Sprintf("%.1f", g.Mem),
+				utils.FormatBytes(g.RSS),
+				utils.FormatBytes(uint64(g.ReadRate)) + "/s",
+				utils.FormatBytes(uint64(g.WriteRate)) + "/s",
+			})
 		}
+		m.Monitor.ProcessTable.SetColumns(processGroupColumns(m.Monitor.ProcessGroupBy))
+	} else if m.Monitor.ProcessTreeMode {
+		tree := model.BuildProcessTree(m.Monitor.Processes, m.Monitor.ProcessSort, m.Monitor.ProcessSortReverse, m.Monitor.ProcessCollapsed)
+		for _, node := range tree {
+			p := node.Process
+			if !matchesProcessSearch(p, searchTerm) || !m.inProcessGroupFilter(p) {
+				continue
+			}
 
-		rows = append(rows, table.Row{
-			fmt.Sprintf("%d", p.PID),
-			p.User,
-			fmt.Sprintf("%.1f", p.CPU),
-			fmt.Sprintf("%.1f", p.Mem),
-			utils.Ellipsis(p.Command, 30),
-		})
+			pid := fmt.Sprintf("%d", p.PID)
+			if pid == selectedPID {
+				cursor = len(rows)
+			}
+			marker := "  "
+			if node.HasChildren && node.Collapsed {
+				marker = fmt.Sprintf("▸ (+%d) ", node.Descendants)
+			} else if node.HasChildren {
+				marker = "▾ "
+			}
+			command := p.Cmdline
+			if command == "" {
+				command = "[" + p.Command + "]"
+			}
+			rows = append(rows, table.Row{
+				pid,
+				p.User,
+				fmt.Sprintf("%.1f", p.CPU),
+				fmt.Sprintf("%.1f", p.Mem),
+				fmt.Sprintf("%.1f", node.SubtreeCPU),
+				fmt.Sprintf("%.1f", node.SubtreeMem),
+				fmt.Sprintf("%d", p.Threads),
+				// The table truncates by display width; Ellipsis would cut
+				// through the multi-byte tree characters.
+				node.Prefix + marker + command,
+			})
+		}
+		m.Monitor.ProcessTable.SetColumns(processTreeColumns())
+	} else {
+		now := time.Now()
+		for _, p := range m.Monitor.Processes {
+			if !matchesProcessSearch(p, searchTerm) || !m.inProcessGroupFilter(p) {
+				continue
+			}
+
+			pid := fmt.Sprintf("%d", p.PID)
+			if pid == selectedPID {
+				cursor = len(rows)
+			}
+			rows = append(rows, table.Row{
+				pid,
+				p.User,
+				fmt.Sprintf("%.1f", p.CPU),
+				fmt.Sprintf("%.1f", p.Mem),
+				utils.FormatBytes(p.RSS),
+				formatProcessStart(p.CreateTime, now),
+				p.State,
+				utils.Ellipsis(m.processContainerName(p), 16),
+				utils.Ellipsis(p.Command, 30),
+			})
+		}
+		m.Monitor.ProcessTable.SetColumns(processColumns(m.Monitor.ProcessSort, m.Monitor.ProcessSortReverse))
 	}
+
 	m.Monitor.ProcessTable.SetRows(rows)
+	if cursor >= 0 {
+		m.Monitor.ProcessTable.SetCursor(cursor)
+	} else if m.Monitor.ProcessTable.Cursor() >= len(rows) {
+		m.Monitor.ProcessTable.SetCursor(max(len(rows)-1, 0))
+	}
 	return nil
 }
 
+// processGroupColumns is the header of the grouped mode.
+func processGroupColumns(by model.ProcessGroupBy) []table.Column {
+	name := "User"
+	if by == model.ProcessGroupCommand {
+		name = "Command"
+	}
+	return []table.Column{
+		{Title: name, Width: 20},
+		{Title: "Procs", Width: 6},
+		{Title: "Threads", Width: 8},
+		{Title: "CPU%", Width: 7},
+		{Title: "Mem%", Width: 7},
+		{Title: "RSS", Width: 10},
+		{Title: "Read", Width: 11},
+		{Title: "Write", Width: 11},
+	}
+}
+
+// showingProcessGroups reports whether the process table lists groups
+// rather than processes.
+func (m Model) showingProcessGroups() bool {
+	return m.Monitor.ProcessGroupBy != model.ProcessGroupNone && m.Monitor.ProcessGroupFilter == ""
+}
+
+// inProcessGroupFilter reports whether p belongs to the group being drilled
+// into, if any.
+func (m Model) inProcessGroupFilter(p proc.ProcessInfo) bool {
+	return m.Monitor.ProcessGroupFilter == "" || m.Monitor.ProcessGroupBy.Key(p) == m.Monitor.ProcessGroupFilter
+}
+
+// selectedProcessPID returns the PID on the highlighted process row.
+func (m Model) selectedProcessPID() (int32, bool) {
+	if m.showingProcessGroups() {
+		return 0, false
+	}
+	row := m.Monitor.ProcessTable.SelectedRow()
+	if len(row) == 0 {
+		return 0, false
+	}
+	pid, err := strconv.ParseInt(row[0], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(pid), true
+}
+
+// formatProcessStart shows the time of day for processes started today and
+// the date otherwise, like ps(1).
+func formatProcessStart(createTime int64, now time.Time) string {
+	if createTime <= 0 {
+		return "-"
+	}
+	started := time.UnixMilli(createTime)
+	if y, m, d := started.Date(); y == now.Year() && m == now.Month() && d == now.Day() {
+		return started.Format("15:04")
+	}
+	return started.Format("Jan02")
+}
+
 func (m *Model) updateNetworkTable() tea.Cmd {
 	var rows []table.Row
 
@@ -202,35 +436,379 @@ func (m *Model) updateNetworkTable() tea.Cmd {
 	return nil
 }
 
-func (m *Model) updateContainerTable(containers []system.Container) tea.Cmd {
+func (m *Model) updateContainerTable() tea.Cmd {
 	var rows []table.Row
 	searchTerm := strings.ToLower(m.Ui.SearchInput.Value())
+	// The grouped mode has fewer columns; see updateProcessTable.
+	m.Monitor.Container.SetRows(nil)
+
+	if m.showingContainerProjects() {
+		for _, p := range model.GroupContainers(m.Monitor.Containers, m.Monitor.ContainerStats) {
+			names := make([]string, len(p.Containers))
+			for i, c := range p.Containers {
+				names[i] = c.Name
+			}
+			services := strings.Join(names, ", ")
+			if searchTerm != "" && !strings.Contains(strings.ToLower(p.Name), searchTerm) &&
+				!strings.Contains(strings.ToLower(services), searchTerm) {
+				continue
+			}
+
+			rows = append(rows, table.Row{
+				p.Name,
+				fmt.Sprintf("%d/%d", p.Running, len(p.Containers)),
+				fmt.Sprintf("%.1f", p.CPU),
+				utils.FormatBytes(p.Memory),
+				utils.Ellipsis(services, 40),
+			})
+		}
+		m.Monitor.Container.SetColumns(containerProjectColumns())
+	} else {
+		for _, c := range m.Monitor.Containers {
+			if m.Monitor.ContainerProjectFilter != "" && c.Project != m.Monitor.ContainerProjectFilter {
+				continue
+			}
+			if searchTerm != "" && !strings.Contains(strings.ToLower(c.Image), searchTerm) &&
+				!strings.Contains(strings.ToLower(c.Name), searchTerm) &&
+				!strings.Contains(strings.ToLower(c.ID), searchTerm) &&
+				!strings.Contains(strings.ToLower(c.Status), searchTerm) &&
+				!strings.Contains(strings.ToLower(c.Project), searchTerm) {
+				continue
+			}
 
-	for _, c := range containers {
-		if searchTerm != "" && !strings.Contains(strings.ToLower(c.Image), searchTerm) &&
-			!strings.Contains(strings.ToLower(c.Name), searchTerm) &&
-			!strings.Contains(strings.ToLower(c.ID), searchTerm) &&
-			!strings.Contains(strings.ToLower(c.Status), searchTerm) &&
-			!strings.Contains(strings.ToLower(c.Project), searchTerm) {
+			statusWithIcon, health := m.getStatusWithIconForTable(c.Status, c.Health)
+
+			rows = append(rows, table.Row{
+				c.ID,
+				utils.Ellipsis(c.Image, 12),
+				utils.Ellipsis(c.Name, 16),
+				statusWithIcon,
+				health,
+				c.Project,
+				utils.Ellipsis(c.PortsStr, 20),
+			})
+		}
+		m.Monitor.Container.SetColumns(containerColumns())
+	}
+
+	m.Monitor.Container.SetRows(rows)
+	if m.Monitor.Container.Cursor() >= len(rows) {
+		m.Monitor.Container.SetCursor(max(len(rows)-1, 0))
+	}
+	return nil
+}
+
+func containerColumns() []table.Column {
+	return []table.Column{
+		{Title: "ID", Width: 12},
+		{Title: "Image", Width: 12},
+		{Title: "Name", Width: 16},
+		{Title: "Status", Width: 12},
+		{Title: "Health", Width: 12},
+		{Title: "Project", Width: 20},
+		{Title: "Ports", Width: 20},
+	}
+}
+
+// containerProjectColumns is the header of the grouped mode.
+func containerProjectColumns() []table.Column {
+	return []table.Column{
+		{Title: "Project", Width: 20},
+		{Title: "Running", Width: 8},
+		{Title: "CPU%", Width: 7},
+		{Title: "Memory", Width: 10},
+		{Title: "Containers", Width: 40},
+	}
+}
+
+// showingContainerProjects reports whether the containers table lists
+// Compose projects rather than containers.
+func (m Model) showingContainerProjects() bool {
+	return m.Monitor.ContainerGroupByProject && m.Monitor.ContainerProjectFilter == ""
+}
+
+// selectedContainerProject returns the project under the cursor in the
+// grouped mode, or the project being drilled into.
+func (m Model) selectedContainerProject() (model.ContainerProject, bool) {
+	name := m.Monitor.ContainerProjectFilter
+	if m.showingContainerProjects() {
+		row := m.Monitor.Container.SelectedRow()
+		if len(row) == 0 {
+			return model.ContainerProject{}, false
+		}
+		name = row[0]
+	}
+	if name == "" {
+		return model.ContainerProject{}, false
+	}
+	for _, p := range model.GroupContainers(m.Monitor.Containers, m.Monitor.ContainerStats) {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return model.ContainerProject{}, false
+}
+
+// runningContainerIDs lists the containers whose stats feed the project
+// totals.
+func (m Model) runningContainerIDs() []string {
+	var ids []string
+	for _, c := range m.Monitor.Containers {
+		if c.State == "running" {
+			ids = append(ids, c.ID)
+		}
+	}
+	return ids
+}
+
+func (m *Model) updateImageTable() tea.Cmd {
+	var rows []table.Row
+	searchTerm := strings.ToLower(m.Ui.SearchInput.Value())
+
+	for _, img := range m.Monitor.Images {
+		usedBy := strings.Join(img.Containers, ", ")
+		if searchTerm != "" && !strings.Contains(strings.ToLower(img.Repository), searchTerm) &&
+			!strings.Contains(strings.ToLower(img.Tag), searchTerm) &&
+			!strings.Contains(img.ID, searchTerm) &&
+			!strings.Contains(strings.ToLower(usedBy), searchTerm) {
 			continue
 		}
 
-		statusWithIcon, health := m.getStatusWithIconForTable(c.Status, c.Health)
+		if usedBy == "" {
+			usedBy = "-"
+		}
+		rows = append(rows, table.Row{
+			img.Repository,
+			img.Tag,
+			img.ID,
+			utils.FormatBytes(uint64(img.Size)),
+			img.Created.Format("2006-01-02"),
+			utils.Ellipsis(usedBy, 24),
+		})
+	}
+	m.Monitor.ImageTable.SetRows(rows)
+	if m.Monitor.ImageTable.Cursor() >= len(rows) {
+		m.Monitor.ImageTable.SetCursor(max(len(rows)-1, 0))
+	}
+	return nil
+}
+
+func fileChangeColumns() []table.Column {
+	return []table.Column{
+		{Title: "Change", Width: 10},
+		{Title: "Path", Width: 70},
+	}
+}
+
+func fileEntryColumns() []table.Column {
+	return []table.Column{
+		{Title: "Name", Width: 36},
+		{Title: "Size", Width: 10},
+		{Title: "Mode", Width: 11},
+		{Title: "Modified", Width: 16},
+	}
+}
+
+// updateFilesTable shows the changes or the current directory of the Files
+// tab, keeping the cursor when the rows stay the same.
+func (m *Model) updateFilesTable() tea.Cmd {
+	files := &m.Monitor.Files
+	var rows []table.Row
+	columns := fileChangeColumns()
+	switch files.View {
+	case model.FilesChanges:
+		for _, c := range files.Changes {
+			rows = append(rows, table.Row{changeWithIcon(c.Kind), c.Path})
+		}
+	case model.FilesBrowse:
+		columns = fileEntryColumns()
+		for _, e := range files.Dir.Entries {
+			name, size := e.Name, utils.FormatBytes(uint64(e.Size))
+			switch {
+			case e.IsDir():
+				name, size = name+"/", "-"
+			case e.LinkTarget != "":
+				name = name + " → " + e.LinkTarget
+			}
+			rows = append(rows, table.Row{
+				utils.Ellipsis(name, 36),
+				size,
+				e.Mode.String(),
+				e.ModTime.Local().Format("2006-01-02 15:04"),
+			})
+		}
+	}
+
+	// The table panics when rows have more cells than there are columns.
+	files.Table.SetRows(nil)
+	files.Table.SetColumns(columns)
+	files.Table.SetRows(rows)
+	if files.Table.Cursor() >= len(rows) {
+		files.Table.SetCursor(max(len(rows)-1, 0))
+	}
+	return nil
+}
+
+func changeWithIcon(kind string) string {
+	switch kind {
+	case "added":
+		return "+ added"
+	case "deleted":
+		return "- deleted"
+	default:
+		return "~ changed"
+	}
+}
+
+func (m *Model) updateEventTable() tea.Cmd {
+	var rows []table.Row
+	searchTerm := strings.ToLower(m.Ui.SearchInput.Value())
+
+	events := m.Monitor.Events.Events
+	for i := len(events) - 1; i >= 0; i-- {
+		e := events[i]
+		if searchTerm != "" && !strings.Contains(strings.ToLower(e.Name), searchTerm) &&
+			!strings.Contains(strings.ToLower(e.Project), searchTerm) &&
+			!strings.Contains(e.ContainerID, searchTerm) &&
+			!strings.Contains(e.Action, searchTerm) {
+			continue
+		}
 
 		rows = append(rows, table.Row{
-			c.ID,
-			utils.Ellipsis(c.Image, 12),
-			utils.Ellipsis(c.Name, 16),
-			statusWithIcon,
-			health,
-			c.Project,
-			utils.Ellipsis(c.PortsStr, 20),
+			e.Time.Format("15:04:05"),
+			utils.Ellipsis(e.Name, 20),
+			utils.Ellipsis(e.Project, 14),
+			eventWithIcon(e),
+			utils.Ellipsis(e.Detail(), 24),
 		})
 	}
-	m.Monitor.Container.SetRows(rows)
+	m.Monitor.EventTable.SetRows(rows)
+	if m.Monitor.EventTable.Cursor() >= len(rows) {
+		m.Monitor.EventTable.SetCursor(max(len(rows)-1, 0))
+	}
+	return nil
+}
+
+func eventWithIcon(e system.ContainerEvent) string {
+	switch {
+	case e.Action == "oom", e.Crashed(), e.Health == "unhealthy":
+		return "⚠ " + e.Action
+	case e.Action == "start", e.Health == "healthy":
+		return "▶ " + e.Action
+	case e.Action == "die", e.Action == "stop":
+		return "⏹ " + e.Action
+	default:
+		return e.Action
+	}
+}
+
+// selectedImage returns the image under the cursor of the Images view.
+func (m Model) selectedImage() (system.Image, bool) {
+	row := m.Monitor.ImageTable.SelectedRow()
+	if len(row) < 3 {
+		return system.Image{}, false
+	}
+	for _, img := range m.Monitor.Images {
+		if img.Repository == row[0] && img.Tag == row[1] && img.ID == row[2] {
+			return img, true
+		}
+	}
+	return system.Image{}, false
+}
+
+func (m *Model) updateVolumeTable() tea.Cmd {
+	var rows []table.Row
+	searchTerm := strings.ToLower(m.Ui.SearchInput.Value())
+
+	for _, vol := range m.Monitor.Volumes {
+		usedBy := strings.Join(vol.Containers, ", ")
+		if searchTerm != "" && !strings.Contains(strings.ToLower(vol.Name), searchTerm) &&
+			!strings.Contains(strings.ToLower(vol.Driver), searchTerm) &&
+			!strings.Contains(strings.ToLower(usedBy), searchTerm) {
+			continue
+		}
+
+		size := "?"
+		if vol.Size >= 0 {
+			size = utils.FormatBytes(uint64(vol.Size))
+		}
+		if usedBy == "" {
+			usedBy = "-"
+		}
+		rows = append(rows, table.Row{
+			vol.Name,
+			vol.Driver,
+			size,
+			utils.Ellipsis(usedBy, 24),
+			vol.Mountpoint,
+		})
+	}
+	m.Monitor.VolumeTable.SetRows(rows)
+	if m.Monitor.VolumeTable.Cursor() >= len(rows) {
+		m.Monitor.VolumeTable.SetCursor(max(len(rows)-1, 0))
+	}
 	return nil
 }
 
+// selectedVolume returns the volume under the cursor of the Volumes view.
+func (m Model) selectedVolume() (system.Volume, bool) {
+	row := m.Monitor.VolumeTable.SelectedRow()
+	if len(row) == 0 {
+		return system.Volume{}, false
+	}
+	for _, vol := range m.Monitor.Volumes {
+		if vol.Name == row[0] {
+			return vol, true
+		}
+	}
+	return system.Volume{}, false
+}
+
+func (m *Model) updateDockerNetworkTable() tea.Cmd {
+	var rows []table.Row
+	searchTerm := strings.ToLower(m.Ui.SearchInput.Value())
+
+	for _, n := range m.Monitor.DockerNetworks {
+		if searchTerm != "" && !strings.Contains(strings.ToLower(n.Name), searchTerm) &&
+			!strings.Contains(n.ID, searchTerm) &&
+			!strings.Contains(strings.ToLower(n.Driver), searchTerm) &&
+			!slices.ContainsFunc(n.Endpoints, func(e system.NetworkEndpoint) bool {
+				return strings.Contains(strings.ToLower(e.Container), searchTerm) || strings.Contains(e.IPv4, searchTerm)
+			}) {
+			continue
+		}
+
+		rows = append(rows, table.Row{
+			n.Name,
+			n.ID,
+			n.Driver,
+			strings.Join(n.Subnets, ", "),
+			strings.Join(n.Gateways, ", "),
+			fmt.Sprintf("%d", len(n.Endpoints)),
+		})
+	}
+	m.Monitor.DockerNetworkTable.SetRows(rows)
+	if m.Monitor.DockerNetworkTable.Cursor() >= len(rows) {
+		m.Monitor.DockerNetworkTable.SetCursor(max(len(rows)-1, 0))
+	}
+	return nil
+}
+
+// selectedDockerNetwork returns the network under the cursor of the
+// Networks view.
+func (m Model) selectedDockerNetwork() (system.Network, bool) {
+	row := m.Monitor.DockerNetworkTable.SelectedRow()
+	if len(row) < 2 {
+		return system.Network{}, false
+	}
+	for _, n := range m.Monitor.DockerNetworks {
+		if n.ID == row[1] {
+			return n, true
+		}
+	}
+	return system.Network{}, false
+}
+
 func (m *Model) updateConnectionsTable() tea.Cmd {
 	var rows []table.Row
 